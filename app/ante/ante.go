@@ -13,12 +13,15 @@ import (
 	blobante "github.com/celestiaorg/celestia-app/v4/x/blob/ante"
 	blob "github.com/celestiaorg/celestia-app/v4/x/blob/keeper"
 	minfeekeeper "github.com/celestiaorg/celestia-app/v4/x/minfee/keeper"
+	nsregistryante "github.com/celestiaorg/celestia-app/v4/x/nsregistry/ante"
+	nsregistrykeeper "github.com/celestiaorg/celestia-app/v4/x/nsregistry/keeper"
 )
 
 func NewAnteHandler(
 	accountKeeper ante.AccountKeeper,
 	bankKeeper authtypes.BankKeeper,
 	blobKeeper blob.Keeper,
+	nsRegistryKeeper *nsregistrykeeper.Keeper,
 	feegrantKeeper ante.FeegrantKeeper,
 	signModeHandler *txsigning.HandlerMap,
 	sigGasConsumer ante.SignatureVerificationGasConsumer,
@@ -26,6 +29,40 @@ func NewAnteHandler(
 	minfeeKeeper *minfeekeeper.Keeper,
 	circuitkeeper *circuitkeeper.Keeper,
 	paramFilters map[string]ParamFilter,
+) sdk.AnteHandler {
+	return NewAnteHandlerWithSignatureCache(
+		accountKeeper,
+		bankKeeper,
+		blobKeeper,
+		nsRegistryKeeper,
+		feegrantKeeper,
+		signModeHandler,
+		sigGasConsumer,
+		channelKeeper,
+		minfeeKeeper,
+		circuitkeeper,
+		paramFilters,
+		nil,
+	)
+}
+
+// NewAnteHandlerWithSignatureCache returns the same ante handler as
+// NewAnteHandler except that signatures which were already verified and
+// recorded in the provided cache are not verified again. A nil cache verifies
+// every signature.
+func NewAnteHandlerWithSignatureCache(
+	accountKeeper ante.AccountKeeper,
+	bankKeeper authtypes.BankKeeper,
+	blobKeeper blob.Keeper,
+	nsRegistryKeeper *nsregistrykeeper.Keeper,
+	feegrantKeeper ante.FeegrantKeeper,
+	signModeHandler *txsigning.HandlerMap,
+	sigGasConsumer ante.SignatureVerificationGasConsumer,
+	channelKeeper *ibckeeper.Keeper,
+	minfeeKeeper *minfeekeeper.Keeper,
+	circuitkeeper *circuitkeeper.Keeper,
+	paramFilters map[string]ParamFilter,
+	sigCache *SignatureCache,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		// Wraps the panic with the string format of the transaction
@@ -62,7 +99,7 @@ func NewAnteHandler(
 		// that the signature's sequence number (a.k.a nonce) matches the
 		// account sequence number of the signer.
 		// Note: does not consume gas from the gas meter.
-		ante.NewSigVerificationDecorator(accountKeeper, signModeHandler),
+		NewSigVerificationDecorator(accountKeeper, signModeHandler, sigCache),
 		// Ensure that the tx does not contain a MsgExec with a nested MsgExec
 		// or MsgPayForBlobs.
 		NewMsgExecDecorator(),
@@ -70,6 +107,8 @@ func NewAnteHandler(
 		// Contract: must be called after all decorators that consume gas.
 		// Note: does not consume gas from the gas meter.
 		blobante.NewMinGasPFBDecorator(blobKeeper),
+		// Ensure that every blob of the tx is <= the MaxBlobSize param.
+		blobante.NewMaxBlobSizeDecorator(blobKeeper),
 		// Ensure that the tx's total blob size is <= the max blob size.
 		// Only applies to app version == 1.
 		blobante.NewMaxTotalBlobSizeDecorator(blobKeeper),
@@ -77,6 +116,9 @@ func NewAnteHandler(
 		// available to blob data in a data square. Only applies to app version
 		// >= 2.
 		blobante.NewBlobShareDecorator(blobKeeper),
+		// Ensure that the signer of a PFB is allowed to pay for blobs in the
+		// namespaces registered with an allow-list of signers.
+		nsregistryante.NewNamespaceSignerDecorator(nsRegistryKeeper),
 		// Ensure that txs with MsgSubmitProposal/MsgExec have at least one message and param filters are applied.
 		NewParamFilterDecorator(paramFilters),
 		// Side effect: increment the nonce for all tx signers.
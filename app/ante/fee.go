@@ -8,6 +8,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
 	minfeekeeper "github.com/celestiaorg/celestia-app/v4/x/minfee/keeper"
 )
 
@@ -49,17 +50,37 @@ func ValidateTxFee(ctx sdk.Context, tx sdk.Tx, minfeeKeeper *minfeekeeper.Keeper
 		}
 	}
 
-	networkMinGasPrice := minfeeKeeper.GetParams(ctx).NetworkMinGasPrice
+	params := minfeeKeeper.GetParams(ctx)
 
-	err := verifyMinFee(fee, gas, networkMinGasPrice, "insufficient gas price for the network")
+	err := verifyMinFee(fee, gas, params.NetworkMinGasPrice, "insufficient gas price for the network")
 	if err != nil {
 		return nil, 0, err
 	}
 
+	// Ensure that the transactions paying for blobs meet the blob base fee.
+	if params.DynamicBlobBaseFee && hasPayForBlobs(tx) {
+		err := verifyMinFee(fee, gas, minfeeKeeper.GetBlobBaseFee(ctx), "insufficient gas price for the blob base fee")
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
 	priority := getTxPriority(feeTx.GetFee(), int64(gas))
 	return feeTx.GetFee(), priority, nil
 }
 
+// hasPayForBlobs returns true if tx contains a MsgPayForBlobs. PFBs nested in
+// a MsgExec are not inspected because they are rejected by the
+// MsgExecDecorator.
+func hasPayForBlobs(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		if _, ok := msg.(*blobtypes.MsgPayForBlobs); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // verifyMinFee validates that the provided transaction fee is sufficient given the provided minimum gas price.
 func verifyMinFee(fee math.Int, gas uint64, minGasPrice math.LegacyDec, errMsg string) error {
 	// Determine the required fee by multiplying required minimum gas
@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramkeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -25,6 +26,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v4/app/encoding"
 	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v4/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
 	minfeekeeper "github.com/celestiaorg/celestia-app/v4/x/minfee/keeper"
 	minfeetypes "github.com/celestiaorg/celestia-app/v4/x/minfee/types"
 )
@@ -145,6 +147,51 @@ func TestValidateTxFee(t *testing.T) {
 	}
 }
 
+func TestValidateTxFeeBlobBaseFee(t *testing.T) {
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	_, minFeeKeeper, stateStore := setUp(t)
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+
+	params := minfeetypes.DefaultParams()
+	params.DynamicBlobBaseFee = true
+	minFeeKeeper.SetParams(ctx, params)
+	minFeeKeeper.SetBlobBaseFee(ctx, sdkmath.LegacyMustNewDecFromStr("0.01"))
+
+	sendMsg := banktypes.NewMsgSend(
+		testnode.RandomAddress().(sdk.AccAddress),
+		testnode.RandomAddress().(sdk.AccAddress),
+		sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10)),
+	)
+	pfbMsg := &blobtypes.MsgPayForBlobs{Signer: testnode.RandomAddress().String()}
+	gasLimit := uint64(100_000)
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		fee    int64
+		expErr bool
+	}{
+		{"pfb with fee equal to the blob base fee", pfbMsg, 1000, false},
+		{"pfb with fee below the blob base fee", pfbMsg, 999, true},
+		{"non pfb with fee below the blob base fee", sendMsg, 999, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := enc.TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(tc.msg))
+			builder.SetGasLimit(gasLimit)
+			builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, tc.fee)))
+
+			_, _, err := ante.ValidateTxFee(ctx, builder.GetTx(), minFeeKeeper)
+			if tc.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
 func setUp(t *testing.T) (paramkeeper.Keeper, *minfeekeeper.Keeper, storetypes.CommitMultiStore) {
 	storeKey := storetypes.NewKVStoreKey(paramtypes.StoreKey)
 	mfStoreKey := storetypes.NewKVStoreKey(minfeetypes.StoreKey)
@@ -0,0 +1,259 @@
+package ante
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// verifiedSignature describes the signer data that a signature was
+// successfully verified against.
+type verifiedSignature struct {
+	pubKey        cryptotypes.PubKey
+	accountNumber uint64
+	sequence      uint64
+	chainID       string
+}
+
+// SignatureCache stores the results of signature verification that was
+// performed ahead of the ante handler. It allows the stateless and expensive
+// part of signature verification to happen concurrently, while the ante
+// handler still runs sequentially and only skips the cryptographic check when
+// the signer data in state matches the data the signature was verified
+// against.
+type SignatureCache struct {
+	mtx      sync.RWMutex
+	verified map[[sha256.Size]byte][]*verifiedSignature
+}
+
+func NewSignatureCache() *SignatureCache {
+	return &SignatureCache{
+		verified: make(map[[sha256.Size]byte][]*verifiedSignature),
+	}
+}
+
+// GetSignerAccounts returns the accounts of the signers of tx as they are
+// stored in ctx. The account is nil for signers that do not exist yet.
+func GetSignerAccounts(ctx sdk.Context, ak ante.AccountKeeper, tx sdk.Tx) ([]sdk.AccountI, error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+	accounts := make([]sdk.AccountI, len(signers))
+	for i, signer := range signers {
+		accounts[i] = ak.GetAccount(ctx, signer)
+	}
+	return accounts, nil
+}
+
+// Verify verifies the signatures of tx against the provided signer accounts
+// and records the ones that are valid. Only single signatures using
+// SIGN_MODE_DIRECT from accounts with a known public key are verified, the
+// remaining signatures are left to the ante handler. Verify does not read from
+// state so it is safe to call concurrently.
+func (c *SignatureCache) Verify(ctx sdk.Context, handler *txsigning.HandlerMap, txBytes []byte, tx sdk.Tx, accounts []sdk.AccountI) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return
+	}
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil || len(sigs) != len(accounts) {
+		return
+	}
+
+	txData := adaptableTx.GetSigningTxData()
+	verified := make([]*verifiedSignature, len(sigs))
+	for i, sig := range sigs {
+		acc := accounts[i]
+		if acc == nil || acc.GetPubKey() == nil {
+			continue
+		}
+		data, ok := sig.Data.(*signing.SingleSignatureData)
+		if !ok || data.SignMode != signing.SignMode_SIGN_MODE_DIRECT {
+			continue
+		}
+
+		pubKey := acc.GetPubKey()
+		var accNum uint64
+		if ctx.BlockHeight() != 0 {
+			accNum = acc.GetAccountNumber()
+		}
+		signerData, err := newSignerData(acc, pubKey, ctx.ChainID(), accNum, sig.Sequence)
+		if err != nil {
+			continue
+		}
+		if err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, handler, txData); err != nil {
+			continue
+		}
+		verified[i] = &verifiedSignature{
+			pubKey:        pubKey,
+			accountNumber: accNum,
+			sequence:      sig.Sequence,
+			chainID:       ctx.ChainID(),
+		}
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.verified[sha256.Sum256(txBytes)] = verified
+}
+
+// isVerified returns true if the signature at index i of the tx with the
+// provided bytes was already verified against the given signer data.
+func (c *SignatureCache) isVerified(txBytes []byte, i int, pubKey cryptotypes.PubKey, accountNumber, sequence uint64, chainID string) bool {
+	if c == nil {
+		return false
+	}
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	verified, ok := c.verified[sha256.Sum256(txBytes)]
+	if !ok || i >= len(verified) || verified[i] == nil {
+		return false
+	}
+	v := verified[i]
+	return v.pubKey.Equals(pubKey) &&
+		v.accountNumber == accountNumber &&
+		v.sequence == sequence &&
+		v.chainID == chainID
+}
+
+// SigVerificationDecorator verifies all signatures for a tx and returns an
+// error if any are invalid. Signatures that are found in the SignatureCache
+// with matching signer data are not verified again.
+//
+// The code was copied from the cosmos-sdk:
+// https://github.com/cosmos/cosmos-sdk/blob/v0.50.12/x/auth/ante/sigverify.go
+// With a nil SignatureCache it behaves exactly like the cosmos-sdk decorator.
+type SigVerificationDecorator struct {
+	ak              ante.AccountKeeper
+	signModeHandler *txsigning.HandlerMap
+	cache           *SignatureCache
+}
+
+func NewSigVerificationDecorator(ak ante.AccountKeeper, signModeHandler *txsigning.HandlerMap, cache *SignatureCache) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		ak:              ak,
+		signModeHandler: signModeHandler,
+		cache:           cache,
+	}
+}
+
+func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	// stdSigs contains the sequence number, account number, and signatures.
+	// When simulating, this would just be a 0-length slice.
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	// check that signer length and signature length are the same
+	if len(sigs) != len(signers) {
+		return ctx, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	for i, sig := range sigs {
+		acc, err := ante.GetSignerAcc(ctx, svd.ak, signers[i])
+		if err != nil {
+			return ctx, err
+		}
+
+		// retrieve pubkey
+		pubKey := acc.GetPubKey()
+		if !simulate && pubKey == nil {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "pubkey on account is not set")
+		}
+
+		// Check account sequence number.
+		if sig.Sequence != acc.GetSequence() {
+			return ctx, errorsmod.Wrapf(
+				sdkerrors.ErrWrongSequence,
+				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+			)
+		}
+
+		// retrieve signer data
+		genesis := ctx.BlockHeight() == 0
+		chainID := ctx.ChainID()
+		var accNum uint64
+		if !genesis {
+			accNum = acc.GetAccountNumber()
+		}
+
+		// no need to verify signatures on recheck tx
+		if !simulate && !ctx.IsReCheckTx() && ctx.IsSigverifyTx() {
+			if svd.cache.isVerified(ctx.TxBytes(), i, pubKey, accNum, acc.GetSequence(), chainID) {
+				continue
+			}
+
+			signerData, err := newSignerData(acc, pubKey, chainID, accNum, acc.GetSequence())
+			if err != nil {
+				return ctx, err
+			}
+			adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+			if !ok {
+				return ctx, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+			}
+			txData := adaptableTx.GetSigningTxData()
+			err = authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, txData)
+			if err != nil {
+				var errMsg string
+				if ante.OnlyLegacyAminoSigners(sig.Data) {
+					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
+					// and therefore communicate sequence number as a potential cause of error.
+					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+				} else {
+					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s): (%s)", accNum, chainID, err.Error())
+				}
+				return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, errMsg)
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func newSignerData(acc sdk.AccountI, pubKey cryptotypes.PubKey, chainID string, accNum, sequence uint64) (txsigning.SignerData, error) {
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return txsigning.SignerData{}, err
+	}
+	return txsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       chainID,
+		AccountNumber: accNum,
+		Sequence:      sequence,
+		PubKey: &anypb.Any{
+			TypeUrl: anyPk.TypeUrl,
+			Value:   anyPk.Value,
+		},
+	}, nil
+}
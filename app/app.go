@@ -1,6 +1,7 @@
 package app
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -9,6 +10,7 @@ import (
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"cosmossdk.io/x/circuit"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
@@ -52,6 +54,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	txconfig "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -103,14 +106,19 @@ import (
 	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
 	"github.com/spf13/cast"
 
+	"github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/share"
 
 	"github.com/celestiaorg/celestia-app/v4/app/ante"
 	"github.com/celestiaorg/celestia-app/v4/app/encoding"
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/blobindex"
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/blobproof"
 	"github.com/celestiaorg/celestia-app/v4/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/proposal"
 	celestiatx "github.com/celestiaorg/celestia-app/v4/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
 	appv4 "github.com/celestiaorg/celestia-app/v4/pkg/appconsts/v4"
+	"github.com/celestiaorg/celestia-app/v4/pkg/da"
 	"github.com/celestiaorg/celestia-app/v4/pkg/proof"
 	"github.com/celestiaorg/celestia-app/v4/x/blob"
 	blobkeeper "github.com/celestiaorg/celestia-app/v4/x/blob/keeper"
@@ -121,6 +129,9 @@ import (
 	"github.com/celestiaorg/celestia-app/v4/x/mint"
 	mintkeeper "github.com/celestiaorg/celestia-app/v4/x/mint/keeper"
 	minttypes "github.com/celestiaorg/celestia-app/v4/x/mint/types"
+	"github.com/celestiaorg/celestia-app/v4/x/nsregistry"
+	nsregistrykeeper "github.com/celestiaorg/celestia-app/v4/x/nsregistry/keeper"
+	nsregistrytypes "github.com/celestiaorg/celestia-app/v4/x/nsregistry/types"
 	"github.com/celestiaorg/celestia-app/v4/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v4/x/signal/types"
 	"github.com/celestiaorg/celestia-app/v4/x/tokenfilter"
@@ -137,6 +148,7 @@ var maccPerms = map[string][]string{
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 	icatypes.ModuleName:            nil,
+	nsregistrytypes.ModuleName:     nil,
 }
 
 const (
@@ -156,6 +168,26 @@ type App struct {
 
 	encodingConfig encoding.Config
 
+	// edsCache holds the extended data squares of recent blocks so that they
+	// don't have to be erasure coded again. It is nil if disabled.
+	edsCache *da.EDSCache
+
+	// rejectedProposals keeps the most recent proposals rejected in
+	// ProcessProposal.
+	rejectedProposals *proposal.RejectionLog
+
+	// blockGasPrices keeps the gas prices of the most recently committed
+	// blocks for gas price estimation.
+	blockGasPrices *gasestimation.BlockGasPrices
+
+	// blobIndexer indexes the blobs of the committed blocks by namespace. It
+	// is nil if the blob index is disabled.
+	blobIndexer *blobindex.Indexer
+
+	// pendingNonces indexes the transactions that passed CheckTx by signer
+	// and sequence so that they can be replaced by fee.
+	pendingNonces *pendingNonces
+
 	// keys to access the substores
 	keys    map[string]*storetypes.KVStoreKey
 	tkeys   map[string]*storetypes.TransientStoreKey
@@ -175,6 +207,7 @@ type App struct {
 	UpgradeKeeper       *upgradekeeper.Keeper // Upgrades are set in endblock when signaled
 	SignalKeeper        signal.Keeper
 	MinFeeKeeper        *minfeekeeper.Keeper
+	NsRegistryKeeper    *nsregistrykeeper.Keeper
 	ParamsKeeper        paramskeeper.Keeper
 	IBCKeeper           *ibckeeper.Keeper // IBCKeeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper      evidencekeeper.Keeper
@@ -224,11 +257,15 @@ func New(
 	govModuleAddr := authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
 	app := &App{
-		BaseApp:       baseApp,
-		keys:          keys,
-		tkeys:         tkeys,
-		memKeys:       memKeys,
-		timeoutCommit: timeoutCommit,
+		BaseApp:           baseApp,
+		keys:              keys,
+		tkeys:             tkeys,
+		memKeys:           memKeys,
+		timeoutCommit:     timeoutCommit,
+		edsCache:          da.NewEDSCache(edsCacheMaxBytes(appOpts)),
+		rejectedProposals: proposal.NewRejectionLog(proposal.DefaultRejectionLogSize),
+		blockGasPrices:    gasestimation.NewBlockGasPrices(gasestimation.DefaultBlockWindowSize),
+		pendingNonces:     newPendingNonces(),
 	}
 
 	// needed for migration from x/params -> module's ownership of own params
@@ -256,6 +293,14 @@ func New(
 		logger,
 	)
 
+	// SIGN_MODE_TEXTUAL renders coins using their denom metadata which is
+	// read from the bank keeper.
+	txConfig, err := encoding.EnableTextualSignMode(encodingConfig, txconfig.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper))
+	if err != nil {
+		panic(err)
+	}
+	encodingConfig.TxConfig = txConfig
+
 	app.AuthzKeeper = authzkeeper.NewKeeper(runtime.NewKVStoreService(keys[authzkeeper.StoreKey]), encodingConfig.Codec, app.MsgServiceRouter(), app.AccountKeeper)
 
 	app.StakingKeeper = stakingkeeper.NewKeeper(
@@ -283,6 +328,10 @@ func New(
 		skipUpgradeHeights[int64(h)] = true
 	}
 	homePath := cast.ToString(appOpts.Get(flags.FlagHome))
+	app.blobIndexer, err = openBlobIndexer(appOpts, homePath, logger)
+	if err != nil {
+		panic(err)
+	}
 	app.UpgradeKeeper = upgradekeeper.NewKeeper(skipUpgradeHeights, runtime.NewKVStoreService(keys[upgradetypes.StoreKey]), encodingConfig.Codec, homePath, app.BaseApp, govModuleAddr)
 
 	// Register the staking hooks. NOTE: stakingKeeper is passed by reference
@@ -375,6 +424,7 @@ func New(
 	)
 
 	app.MinFeeKeeper = minfeekeeper.NewKeeper(encodingConfig.Codec, keys[minfeetypes.StoreKey], app.ParamsKeeper, app.GetSubspace(minfeetypes.ModuleName), authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.NsRegistryKeeper = nsregistrykeeper.NewKeeper(encodingConfig.Codec, keys[nsregistrytypes.StoreKey], app.BankKeeper, govModuleAddr)
 
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 	ibcRouter := ibcporttypes.NewRouter()                                                   // Create static IBC router
@@ -424,6 +474,7 @@ func New(
 		blob.NewAppModule(encodingConfig.Codec, app.BlobKeeper),
 		signal.NewAppModule(app.SignalKeeper),
 		minfee.NewAppModule(encodingConfig.Codec, app.MinFeeKeeper),
+		nsregistry.NewAppModule(encodingConfig.Codec, app.NsRegistryKeeper),
 		packetforward.NewAppModule(app.PacketForwardKeeper, app.GetSubspace(packetforwardtypes.ModuleName)),
 		// ensure the light client module types are registered.
 		ibctm.NewAppModule(),
@@ -449,7 +500,7 @@ func New(
 	app.setModuleOrder()
 
 	app.CustomQueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
-	app.CustomQueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	app.CustomQueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.NewQueryShareInclusionProof(app.edsCache))
 
 	app.configurator = module.NewConfigurator(encodingConfig.Codec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	if err := app.ModuleManager.RegisterServices(app.configurator); err != nil {
@@ -475,6 +526,7 @@ func New(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.BlobKeeper,
+		app.NsRegistryKeeper,
 		app.FeeGrantKeeper,
 		encodingConfig.TxConfig.SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -524,7 +576,10 @@ func (app *App) Info(req *abci.RequestInfo) (*abci.ResponseInfo, error) {
 }
 
 // PreBlocker application updates every pre block
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	app.recordBlockGasPrices(ctx, req.Txs)
+	app.indexBlobs(ctx, req.Txs)
+	app.recordSquareUtilization(ctx, req.Txs)
 	return app.ModuleManager.PreBlock(ctx)
 }
 
@@ -665,6 +720,12 @@ func (app *App) AppCodec() codec.Codec {
 	return app.encodingConfig.Codec
 }
 
+// RejectedProposals returns the log of the proposals most recently rejected
+// in ProcessProposal.
+func (app *App) RejectedProposals() *proposal.RejectionLog {
+	return app.rejectedProposals
+}
+
 // GetEncodingConfig returns the app encoding config.
 func (app *App) GetEncodingConfig() encoding.Config {
 	return app.encodingConfig
@@ -711,6 +772,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register new celestia routes from grpc-gateway.
 	celestiatx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	proposal.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	blobindex.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	blobproof.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	// Register grpc-gateway routes for all modules.
 	app.BasicManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
@@ -718,8 +782,11 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.encodingConfig.InterfaceRegistry)
-	celestiatx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.InterfaceRegistry)
-	gasestimation.RegisterGasEstimationService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), app.getGovMaxSquareBytes, app.Simulate)
+	celestiatx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.InterfaceRegistry, app.DryRunBlock)
+	gasestimation.RegisterGasEstimationService(app.GRPCQueryRouter(), clientCtx, app.encodingConfig.TxConfig.TxDecoder(), app.getGovMaxSquareBytes, app.getBlobBaseFee, app.Simulate, app.blockGasPrices)
+	proposal.RegisterProposalService(app.GRPCQueryRouter(), app.rejectedProposals)
+	blobindex.RegisterBlobIndexService(app.GRPCQueryRouter(), app.blobIndexer)
+	blobproof.RegisterBlobProofService(app.GRPCQueryRouter(), clientCtx)
 }
 
 func (app *App) getGovMaxSquareBytes() (uint64, error) {
@@ -731,6 +798,91 @@ func (app *App) getGovMaxSquareBytes() (uint64, error) {
 	return maxSquareSize * maxSquareSize * share.ShareSize, nil
 }
 
+// getBlobBaseFee returns the blob base fee that the transactions paying for
+// blobs of the next block must pay, or zero if it is disabled.
+func (app *App) getBlobBaseFee() (float64, error) {
+	ctx, err := app.CreateQueryContext(app.LastBlockHeight(), false)
+	if err != nil {
+		return 0, err
+	}
+	if !app.MinFeeKeeper.GetParams(ctx).DynamicBlobBaseFee {
+		return 0, nil
+	}
+	return app.MinFeeKeeper.GetBlobBaseFee(ctx).Float64()
+}
+
+// recordBlockGasPrices adds the gas prices of the transactions of the block
+// being finalized and its fullness to the window used for gas price
+// estimation.
+func (app *App) recordBlockGasPrices(ctx sdk.Context, txs [][]byte) {
+	maxSquareSize := app.BlobKeeper.GetParams(ctx).GovMaxSquareSize
+	maxSquareBytes := maxSquareSize * maxSquareSize * share.ShareSize
+	if maxSquareBytes == 0 {
+		return
+	}
+	blockBytes := 0
+	for _, tx := range txs {
+		blockBytes += len(tx)
+	}
+	gasPrices := gasestimation.ExtractGasPrices(app.encodingConfig.TxConfig.TxDecoder(), txs)
+	app.blockGasPrices.AddBlock(ctx.BlockHeight(), gasPrices, float64(blockBytes)/float64(maxSquareBytes))
+}
+
+// recordSquareUtilization records the fraction of the max square used by the
+// block being finalized for the adjustment of the blob base fee. The square is
+// built from the transactions of the block the same way ProcessProposal does
+// and all its shares but the tail padding are counted, including the padding
+// aligning the blobs.
+func (app *App) recordSquareUtilization(ctx sdk.Context, txs [][]byte) {
+	if !app.MinFeeKeeper.GetParams(ctx).DynamicBlobBaseFee {
+		return
+	}
+	maxSquareSize := app.MaxEffectiveSquareSize(ctx)
+	dataSquare, err := square.Construct(txs, maxSquareSize, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		// the square of a block accepted by ProcessProposal can always be
+		// built. Failing to build it is deterministic so skipping the update
+		// keeps the nodes in agreement.
+		app.Logger().Error("failed to build the square to record its utilization", "height", ctx.BlockHeight(), "err", err)
+		return
+	}
+	utilization := math.LegacyNewDec(int64(usedShares(dataSquare))).QuoInt64(int64(maxSquareSize * maxSquareSize))
+	app.MinFeeKeeper.SetSquareUtilization(ctx, math.LegacyMinDec(utilization, math.LegacyOneDec()))
+}
+
+// usedShares returns the number of shares of the square that are not tail
+// padding.
+func usedShares(dataSquare square.Square) int {
+	used := 0
+	for _, sh := range dataSquare {
+		if !sh.Namespace().IsTailPadding() {
+			used++
+		}
+	}
+	return used
+}
+
+// indexBlobs enqueues the block being finalized to be added to the blob index
+// in the background if it is enabled, keeping the indexing off the critical
+// path of the block. The index is node-local so failing to index a block
+// doesn't halt the node: the failure is logged and the height is reported as
+// missing by the queries.
+func (app *App) indexBlobs(ctx sdk.Context, txs [][]byte) {
+	if app.blobIndexer == nil {
+		return
+	}
+	app.blobIndexer.Enqueue(ctx.BlockHeight(), txs, app.MaxEffectiveSquareSize(ctx), app.encodingConfig.TxConfig.TxDecoder())
+}
+
+// Close closes the blob index in addition to the databases of the BaseApp.
+func (app *App) Close() error {
+	err := app.BaseApp.Close()
+	if app.blobIndexer != nil {
+		err = errors.Join(err, app.blobIndexer.Close())
+	}
+	return err
+}
+
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
 func (app *App) RegisterTendermintService(clientCtx client.Context) {
 	tmservice.RegisterTendermintService(clientCtx, app.GRPCQueryRouter(), app.encodingConfig.InterfaceRegistry, app.Query)
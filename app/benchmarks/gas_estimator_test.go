@@ -71,7 +71,9 @@ func BenchmarkGasPriceEstimation(b *testing.B) {
 				mempool,
 				encfg.TxConfig.TxDecoder(),
 				func() (uint64, error) { return 128 * 128 * share.ContinuationSparseShareContentSize, nil },
+				nil,
 				func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error) { return sdk.GasInfo{}, nil, nil },
+				nil,
 			)
 			for i := 0; i < b.N; i++ {
 				_, err := gasEstimationServer.EstimateGasPrice(context.Background(), &gasestimation.EstimateGasPriceRequest{})
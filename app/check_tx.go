@@ -46,7 +46,7 @@ func (app *App) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error)
 			return responseCheckTxWithEvents(blobtypes.ErrNoBlobs, 0, 0, []abci.Event{}, false), nil
 		}
 		// don't do anything special if we have a normal transaction
-		return app.BaseApp.CheckTx(req)
+		return app.checkTxWithReplacement(req, tx)
 	}
 
 	switch req.Type {
@@ -61,11 +61,8 @@ func (app *App) CheckTx(req *abci.RequestCheckTx) (*abci.ResponseCheckTx, error)
 		panic(fmt.Sprintf("unknown RequestCheckTx type: %s", req.Type))
 	}
 
-	// NOTE: we recreate the reqCheckTx such that we do not mutate the original req.Tx value
-	return app.BaseApp.CheckTx(&abci.RequestCheckTx{
-		Tx:   btx.Tx,
-		Type: req.GetType(),
-	})
+	// NOTE: only the sdk tx is checked such that we do not mutate the original req.Tx value
+	return app.checkTxWithReplacement(req, btx.Tx)
 }
 
 func responseCheckTxWithEvents(err error, gw, gu uint64, events []abci.Event, debug bool) *abci.ResponseCheckTx {
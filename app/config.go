@@ -0,0 +1,106 @@
+package app
+
+import (
+	"path/filepath"
+
+	"cosmossdk.io/log"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/server"
+	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/blobindex"
+	"github.com/celestiaorg/celestia-app/v4/pkg/da"
+)
+
+// FlagEDSCacheMaxBytes is the app.toml key for the memory cap of the
+// extended data square cache.
+const FlagEDSCacheMaxBytes = "eds-cache.max-bytes"
+
+// FlagBlobIndexEnabled is the app.toml key enabling the blob index.
+const FlagBlobIndexEnabled = "blob-index.enabled"
+
+// EDSCacheConfig configures the cache of recently built extended data
+// squares.
+type EDSCacheConfig struct {
+	// MaxBytes is the maximum amount of memory used by the cache. Zero
+	// disables the cache.
+	MaxBytes uint64 `mapstructure:"max-bytes"`
+}
+
+// BlobIndexConfig configures the node-local index of the blobs of the
+// committed blocks.
+type BlobIndexConfig struct {
+	// Enabled indexes the blobs of the blocks committed by the node and
+	// serves the celestia.blob.v1.BlobIndex query service.
+	Enabled bool `mapstructure:"enabled"`
+}
+
+// CustomAppConfig extends the cosmos-sdk server config with celestia-app
+// specific options.
+type CustomAppConfig struct {
+	serverconfig.Config `mapstructure:",squash"`
+
+	EDSCache  EDSCacheConfig  `mapstructure:"eds-cache"`
+	BlobIndex BlobIndexConfig `mapstructure:"blob-index"`
+}
+
+// CustomAppConfigTemplate is the app.toml template for CustomAppConfig.
+const CustomAppConfigTemplate = serverconfig.DefaultConfigTemplate + `
+###############################################################################
+###                        EDS Cache Configuration                          ###
+###############################################################################
+
+[eds-cache]
+
+# The maximum amount of memory, in bytes, used to cache the extended data
+# squares of recent blocks. The cache is used to avoid erasure coding the same
+# square in ProcessProposal and in share inclusion proof queries. Zero disables
+# the cache.
+max-bytes = {{ .EDSCache.MaxBytes }}
+
+###############################################################################
+###                        Blob Index Configuration                         ###
+###############################################################################
+
+[blob-index]
+
+# Index the namespace, height, share range, commitment and signer of the blobs
+# of the blocks committed by the node in data/blob_index.db and serve them
+# through the celestia.blob.v1.BlobIndex query service. The index is not part
+# of consensus and only covers the blocks committed while it is enabled.
+enabled = {{ .BlobIndex.Enabled }}
+`
+
+// DefaultCustomAppConfig returns the default app.toml config.
+func DefaultCustomAppConfig() *CustomAppConfig {
+	return &CustomAppConfig{
+		Config: *DefaultAppConfig(),
+		EDSCache: EDSCacheConfig{
+			MaxBytes: da.DefaultEDSCacheMaxBytes,
+		},
+	}
+}
+
+// edsCacheMaxBytes returns the configured memory cap of the extended data
+// square cache or the default if it isn't set.
+func edsCacheMaxBytes(appOpts servertypes.AppOptions) uint64 {
+	if v := appOpts.Get(FlagEDSCacheMaxBytes); v != nil {
+		return cast.ToUint64(v)
+	}
+	return da.DefaultEDSCacheMaxBytes
+}
+
+// openBlobIndexer opens the blob index database in the data directory of the
+// node. It returns nil if the blob index is disabled.
+func openBlobIndexer(appOpts servertypes.AppOptions, homePath string, logger log.Logger) (*blobindex.Indexer, error) {
+	if !cast.ToBool(appOpts.Get(FlagBlobIndexEnabled)) {
+		return nil, nil
+	}
+	db, err := dbm.NewDB("blob_index", server.GetAppDBBackend(appOpts), filepath.Join(homePath, "data"))
+	if err != nil {
+		return nil, err
+	}
+	return blobindex.NewIndexer(db, logger), nil
+}
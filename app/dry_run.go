@@ -0,0 +1,173 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	coretypes "github.com/cometbft/cometbft/types"
+
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+
+	"github.com/celestiaorg/celestia-app/v4/app/ante"
+	celestiatx "github.com/celestiaorg/celestia-app/v4/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/pkg/da"
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+// DryRunBlock builds a block from txs on a branch of the latest committed
+// state using the same filtering and square construction as
+// PrepareProposalHandler. Nothing is committed. Unlike PrepareProposal, txs
+// are not assumed to have passed CheckTx so the stateless CheckTx validation
+// is applied first.
+func (app *App) DryRunBlock(txs [][]byte) (*celestiatx.DryRunBlockResponse, error) {
+	height := app.LastBlockHeight()
+	ctx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+	ctx = ctx.WithIsCheckTx(false).
+		WithBlockHeight(height + 1).
+		WithBlockGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	resp := &celestiatx.DryRunBlockResponse{}
+	candidates := make([][]byte, 0, len(txs))
+	// candidateIndexes maps the index of a candidate to its index in txs.
+	candidateIndexes := make([]int, 0, len(txs))
+	for idx, rawTx := range txs {
+		if reason := app.validateDryRunTx(rawTx); reason != "" {
+			resp.DroppedTxs = append(resp.DroppedTxs, &celestiatx.DroppedTx{
+				Index:  uint32(idx),
+				Hash:   dryRunTxHash(rawTx),
+				Reason: reason,
+			})
+			continue
+		}
+		candidates = append(candidates, rawTx)
+		candidateIndexes = append(candidateIndexes, idx)
+	}
+
+	handler := ante.NewAnteHandler(
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.BlobKeeper,
+		app.NsRegistryKeeper,
+		app.FeeGrantKeeper,
+		app.GetTxConfig().SignModeHandler(),
+		ante.DefaultSigVerificationGasConsumer,
+		app.IBCKeeper,
+		app.MinFeeKeeper,
+		&app.CircuitKeeper,
+		app.GovParamFilters(),
+	)
+	maxSquareSize := app.MaxEffectiveSquareSize(ctx)
+	included, dropped := filterTxs(app.Logger(), ctx, handler, app.encodingConfig.TxConfig, candidates, maxSquareSize)
+	for _, d := range dropped {
+		resp.DroppedTxs = append(resp.DroppedTxs, &celestiatx.DroppedTx{
+			Index:  uint32(candidateIndexes[d.index]),
+			Hash:   d.hash,
+			Reason: d.reason,
+		})
+	}
+	sort.Slice(resp.DroppedTxs, func(i, j int) bool {
+		return resp.DroppedTxs[i].Index < resp.DroppedTxs[j].Index
+	})
+
+	// Build the square the same way square.Build does while keeping the
+	// builder around to look up the share ranges of the blobs.
+	builder, err := square.NewBuilder(maxSquareSize, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	for _, ptx := range included {
+		var fits bool
+		if ptx.blobTx != nil {
+			fits = builder.AppendBlobTx(ptx.blobTx)
+		} else {
+			fits = builder.AppendTx(ptx.rawTx)
+		}
+		if !fits {
+			return nil, fmt.Errorf("filtered tx %X does not fit in the square", ptx.hash())
+		}
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, err
+	}
+
+	for txIndex, ptx := range included {
+		includedTx := &celestiatx.IncludedTx{
+			Index: uint32(candidateIndexes[ptx.index]),
+			Hash:  ptx.hash(),
+		}
+		if ptx.blobTx != nil {
+			for blobIndex, blob := range ptx.blobTx.Blobs {
+				start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+				if err != nil {
+					return nil, err
+				}
+				length, err := builder.BlobShareLength(txIndex, blobIndex)
+				if err != nil {
+					return nil, err
+				}
+				includedTx.Blobs = append(includedTx.Blobs, &celestiatx.BlobShareRange{
+					Namespace: blob.Namespace().Bytes(),
+					Start:     uint32(start),
+					End:       uint32(start + length),
+				})
+			}
+		}
+		resp.IncludedTxs = append(resp.IncludedTxs, includedTx)
+	}
+
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	if err != nil {
+		return nil, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+	resp.SquareSize = uint64(dataSquare.Size())
+	resp.DataRoot = dah.Hash()
+	return resp, nil
+}
+
+// validateDryRunTx performs the stateless checks of CheckTx and returns the
+// reason the transaction is invalid or an empty string if it is valid.
+func (app *App) validateDryRunTx(rawTx []byte) string {
+	btx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+	if isBlob && err != nil {
+		return "malformed blob tx: " + err.Error()
+	}
+	if !isBlob {
+		sdkTx, err := app.encodingConfig.TxConfig.TxDecoder()(rawTx)
+		if err != nil {
+			return "tx is not decodable: " + err.Error()
+		}
+		if _, has := hasPFB(sdkTx.GetMsgs()); has {
+			return "tx has PFB but is not a blob tx"
+		}
+		return ""
+	}
+	if err := blobtypes.ValidateBlobTx(app.encodingConfig.TxConfig, btx, appconsts.SubtreeRootThreshold, appconsts.LatestVersion); err != nil {
+		return "invalid blob tx: " + err.Error()
+	}
+	return ""
+}
+
+// dryRunTxHash returns the hash of the sdk transaction, excluding the blobs
+// of a BlobTx.
+func dryRunTxHash(rawTx []byte) []byte {
+	if btx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx); isBlob {
+		if err != nil {
+			return nil
+		}
+		return coretypes.Tx(btx.Tx).Hash()
+	}
+	return coretypes.Tx(rawTx).Hash()
+}
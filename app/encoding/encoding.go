@@ -3,6 +3,7 @@ package encoding
 import (
 	addresscodec "cosmossdk.io/core/address"
 	"cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/textual"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/address"
@@ -12,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -52,17 +54,7 @@ func MakeConfig(...sdkmodule.AppModuleBasic) Config {
 	std.RegisterLegacyAminoCodec(amino)
 
 	protoCodec := codec.NewProtoCodec(interfaceRegistry)
-	txDecoder := authtx.DefaultTxDecoder(protoCodec)
-	txDecoder = indexWrapperDecoder(txDecoder)
-
-	txConfig, err := authtx.NewTxConfigWithOptions(protoCodec, authtx.ConfigOptions{
-		EnabledSignModes: authtx.DefaultSignModes,
-		SigningOptions: &signing.Options{
-			AddressCodec:          addressCodec,
-			ValidatorAddressCodec: validatorAddressCodec,
-		},
-		ProtoDecoder: txDecoder,
-	})
+	txConfig, err := newTxConfig(protoCodec, addressCodec, validatorAddressCodec, authtx.DefaultSignModes, nil)
 	if err != nil {
 		panic(err)
 	}
@@ -79,6 +71,38 @@ func MakeConfig(...sdkmodule.AppModuleBasic) Config {
 	}
 }
 
+// EnableTextualSignMode returns a TxConfig for cfg that supports
+// SIGN_MODE_TEXTUAL in addition to the default sign modes. Textual sign bytes
+// render coins using their denom metadata, which is queried with
+// metadataQueryFn: the app queries its bank keeper while clients query a node,
+// for example with the GRPC query function of the x/auth/tx/config package.
+func EnableTextualSignMode(cfg Config, metadataQueryFn textual.CoinMetadataQueryFn) (client.TxConfig, error) {
+	signModes := append(append([]signingtypes.SignMode{}, authtx.DefaultSignModes...), signingtypes.SignMode_SIGN_MODE_TEXTUAL)
+	return newTxConfig(cfg.Codec, cfg.AddressCodec, cfg.ValidatorAddressCodec, signModes, metadataQueryFn)
+}
+
+// newTxConfig returns a TxConfig supporting the provided sign modes whose
+// decoder also decodes index wrappers.
+func newTxConfig(
+	cdc codec.Codec,
+	addressCodec, validatorAddressCodec addresscodec.Codec,
+	signModes []signingtypes.SignMode,
+	metadataQueryFn textual.CoinMetadataQueryFn,
+) (client.TxConfig, error) {
+	txDecoder := authtx.DefaultTxDecoder(cdc)
+	txDecoder = indexWrapperDecoder(txDecoder)
+
+	return authtx.NewTxConfigWithOptions(cdc, authtx.ConfigOptions{
+		EnabledSignModes: signModes,
+		SigningOptions: &signing.Options{
+			AddressCodec:          addressCodec,
+			ValidatorAddressCodec: validatorAddressCodec,
+		},
+		TextualCoinMetadataQueryFn: metadataQueryFn,
+		ProtoDecoder:               txDecoder,
+	})
+}
+
 func MakeTestConfig(moduleBasics ...sdkmodule.AppModuleBasic) Config {
 	codecOpts := codectestutil.CodecOptions{AccAddressPrefix: params.Bech32PrefixAccAddr, ValAddressPrefix: params.Bech32PrefixValAddr}
 	enc := moduletestutil.MakeTestEncodingConfigWithOpts(codecOpts, moduleBasics...)
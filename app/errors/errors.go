@@ -12,4 +12,7 @@ const AppErrorsCodespace = "app"
 var (
 	// ErrTxExceedsMaxSize is returned when a transaction size exceeds the maximum allowed limit
 	ErrTxExceedsMaxSize = errors.Register(AppErrorsCodespace, 11142, "transaction size exceeds maximum allowed limit")
+	// ErrReplacementUnderpriced is returned when a transaction reuses the
+	// sequence of a pending transaction without a strictly higher priority
+	ErrReplacementUnderpriced = errors.Register(AppErrorsCodespace, 11143, "replacement transaction underpriced")
 )
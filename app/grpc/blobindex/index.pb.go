@@ -0,0 +1,1490 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/blob/v1/index.proto
+
+package blobindex
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IndexedBlob locates a blob in a committed block.
+type IndexedBlob struct {
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Height    int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// tx_index is the index of the blob transaction in the block.
+	TxIndex uint32 `protobuf:"varint,3,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// blob_index is the index of the blob in the blob transaction.
+	BlobIndex uint32 `protobuf:"varint,4,opt,name=blob_index,json=blobIndex,proto3" json:"blob_index,omitempty"`
+	// share_start and share_end are the range of shares, end exclusive, the
+	// blob occupies in the original data square.
+	ShareStart uint32 `protobuf:"varint,5,opt,name=share_start,json=shareStart,proto3" json:"share_start,omitempty"`
+	ShareEnd   uint32 `protobuf:"varint,6,opt,name=share_end,json=shareEnd,proto3" json:"share_end,omitempty"`
+	Commitment []byte `protobuf:"bytes,7,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// signer is the address of the signer of the MsgPayForBlobs.
+	Signer string `protobuf:"bytes,8,opt,name=signer,proto3" json:"signer,omitempty"`
+	// tx_hash is the hash of the transaction paying for the blob.
+	TxHash []byte `protobuf:"bytes,9,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+}
+
+func (m *IndexedBlob) Reset()         { *m = IndexedBlob{} }
+func (m *IndexedBlob) String() string { return proto.CompactTextString(m) }
+func (*IndexedBlob) ProtoMessage()    {}
+func (*IndexedBlob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_149381761385fd4c, []int{0}
+}
+func (m *IndexedBlob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IndexedBlob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IndexedBlob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IndexedBlob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexedBlob.Merge(m, src)
+}
+func (m *IndexedBlob) XXX_Size() int {
+	return m.Size()
+}
+func (m *IndexedBlob) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexedBlob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IndexedBlob proto.InternalMessageInfo
+
+func (m *IndexedBlob) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *IndexedBlob) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetTxIndex() uint32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetBlobIndex() uint32 {
+	if m != nil {
+		return m.BlobIndex
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetShareStart() uint32 {
+	if m != nil {
+		return m.ShareStart
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetShareEnd() uint32 {
+	if m != nil {
+		return m.ShareEnd
+	}
+	return 0
+}
+
+func (m *IndexedBlob) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *IndexedBlob) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *IndexedBlob) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+// BlobsByNamespaceRequest the request to list the blobs of a namespace.
+type BlobsByNamespaceRequest struct {
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// start_height and end_height are the inclusive bounds of the height range.
+	// A zero end_height doesn't bound the range.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   int64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// limit is the max number of blobs returned. Zero returns the default page
+	// size.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	// page_key is the next_page_key of the previous page.
+	PageKey []byte `protobuf:"bytes,5,opt,name=page_key,json=pageKey,proto3" json:"page_key,omitempty"`
+}
+
+func (m *BlobsByNamespaceRequest) Reset()         { *m = BlobsByNamespaceRequest{} }
+func (m *BlobsByNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*BlobsByNamespaceRequest) ProtoMessage()    {}
+func (*BlobsByNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_149381761385fd4c, []int{1}
+}
+func (m *BlobsByNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobsByNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobsByNamespaceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobsByNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobsByNamespaceRequest.Merge(m, src)
+}
+func (m *BlobsByNamespaceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobsByNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobsByNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobsByNamespaceRequest proto.InternalMessageInfo
+
+func (m *BlobsByNamespaceRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *BlobsByNamespaceRequest) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *BlobsByNamespaceRequest) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *BlobsByNamespaceRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *BlobsByNamespaceRequest) GetPageKey() []byte {
+	if m != nil {
+		return m.PageKey
+	}
+	return nil
+}
+
+// BlobsByNamespaceResponse the response listing the blobs of a namespace.
+type BlobsByNamespaceResponse struct {
+	Blobs []*IndexedBlob `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	// next_page_key is set if more blobs match the request.
+	NextPageKey []byte `protobuf:"bytes,2,opt,name=next_page_key,json=nextPageKey,proto3" json:"next_page_key,omitempty"`
+	// indexed_height is the last height indexed by the node.
+	IndexedHeight int64 `protobuf:"varint,3,opt,name=indexed_height,json=indexedHeight,proto3" json:"indexed_height,omitempty"`
+	// missing_heights are the heights in the requested range that the node
+	// failed to index. Their blobs are missing from the response.
+	MissingHeights []int64 `protobuf:"varint,4,rep,packed,name=missing_heights,json=missingHeights,proto3" json:"missing_heights,omitempty"`
+}
+
+func (m *BlobsByNamespaceResponse) Reset()         { *m = BlobsByNamespaceResponse{} }
+func (m *BlobsByNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*BlobsByNamespaceResponse) ProtoMessage()    {}
+func (*BlobsByNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_149381761385fd4c, []int{2}
+}
+func (m *BlobsByNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobsByNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobsByNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobsByNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobsByNamespaceResponse.Merge(m, src)
+}
+func (m *BlobsByNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobsByNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobsByNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobsByNamespaceResponse proto.InternalMessageInfo
+
+func (m *BlobsByNamespaceResponse) GetBlobs() []*IndexedBlob {
+	if m != nil {
+		return m.Blobs
+	}
+	return nil
+}
+
+func (m *BlobsByNamespaceResponse) GetNextPageKey() []byte {
+	if m != nil {
+		return m.NextPageKey
+	}
+	return nil
+}
+
+func (m *BlobsByNamespaceResponse) GetIndexedHeight() int64 {
+	if m != nil {
+		return m.IndexedHeight
+	}
+	return 0
+}
+
+func (m *BlobsByNamespaceResponse) GetMissingHeights() []int64 {
+	if m != nil {
+		return m.MissingHeights
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*IndexedBlob)(nil), "celestia.blob.v1.IndexedBlob")
+	proto.RegisterType((*BlobsByNamespaceRequest)(nil), "celestia.blob.v1.BlobsByNamespaceRequest")
+	proto.RegisterType((*BlobsByNamespaceResponse)(nil), "celestia.blob.v1.BlobsByNamespaceResponse")
+}
+
+func init() { proto.RegisterFile("celestia/blob/v1/index.proto", fileDescriptor_149381761385fd4c) }
+
+var fileDescriptor_149381761385fd4c = []byte{
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6b, 0xd4, 0x40,
+	0x1c, 0xed, 0x6c, 0xda, 0xdd, 0xe6, 0xb7, 0xdd, 0x5a, 0x06, 0xb1, 0xb1, 0xee, 0xa6, 0x71, 0xa1,
+	0x18, 0x05, 0x13, 0xda, 0x82, 0x1f, 0x60, 0x41, 0xa8, 0x08, 0x52, 0xe2, 0xcd, 0x4b, 0x98, 0xdd,
+	0x0c, 0xc9, 0xe0, 0x66, 0x26, 0x66, 0xa6, 0x25, 0x7b, 0xf5, 0xec, 0xa1, 0xe0, 0xdd, 0x0f, 0xe0,
+	0xd7, 0xf0, 0xe2, 0xb1, 0xe0, 0xc5, 0xa3, 0xec, 0xfa, 0x41, 0x64, 0x26, 0xd9, 0xb5, 0x7f, 0x14,
+	0x3d, 0x04, 0xf2, 0x7b, 0xef, 0xcd, 0x6f, 0xde, 0xef, 0xcd, 0x0c, 0xf4, 0x27, 0x74, 0x4a, 0xa5,
+	0x62, 0x24, 0x1c, 0x4f, 0xc5, 0x38, 0x3c, 0x3f, 0x0c, 0x19, 0x4f, 0x68, 0x15, 0x14, 0xa5, 0x50,
+	0x02, 0xef, 0x2c, 0xd9, 0x40, 0xb3, 0xc1, 0xf9, 0xe1, 0x5e, 0x3f, 0x15, 0x22, 0x9d, 0xd2, 0x90,
+	0x14, 0x2c, 0x24, 0x9c, 0x0b, 0x45, 0x14, 0x13, 0x5c, 0xd6, 0xfa, 0xe1, 0x87, 0x16, 0x74, 0x5f,
+	0xe8, 0xf5, 0x34, 0x19, 0x4d, 0xc5, 0x18, 0xf7, 0xc1, 0xe6, 0x24, 0xa7, 0xb2, 0x20, 0x13, 0xea,
+	0x20, 0x0f, 0xf9, 0x5b, 0xd1, 0x6f, 0x00, 0xdf, 0x83, 0x76, 0x46, 0x59, 0x9a, 0x29, 0xa7, 0xe5,
+	0x21, 0xdf, 0x8a, 0x9a, 0x0a, 0xdf, 0x87, 0x4d, 0x55, 0xc5, 0xc6, 0x87, 0x63, 0x79, 0xc8, 0xef,
+	0x45, 0x1d, 0x55, 0x99, 0xb6, 0x78, 0x00, 0xa0, 0x9d, 0x34, 0xe4, 0xba, 0x21, 0x6d, 0x8d, 0xd4,
+	0xf4, 0x3e, 0x74, 0x65, 0x46, 0x4a, 0x1a, 0x4b, 0x45, 0x4a, 0xe5, 0x6c, 0x18, 0x1e, 0x0c, 0xf4,
+	0x5a, 0x23, 0xf8, 0x01, 0xd8, 0xb5, 0x80, 0xf2, 0xc4, 0x69, 0x1b, 0x7a, 0xd3, 0x00, 0xcf, 0x79,
+	0x82, 0x5d, 0x80, 0x89, 0xc8, 0x73, 0xa6, 0x72, 0xca, 0x95, 0xd3, 0x31, 0x76, 0xaf, 0x20, 0xda,
+	0xaf, 0x64, 0x29, 0xa7, 0xa5, 0xb3, 0xe9, 0x21, 0xdf, 0x8e, 0x9a, 0x0a, 0xef, 0x42, 0x47, 0x55,
+	0x71, 0x46, 0x64, 0xe6, 0xd8, 0x66, 0x51, 0x5b, 0x55, 0x27, 0x44, 0x66, 0xc3, 0xcf, 0x08, 0x76,
+	0x75, 0x0e, 0x72, 0x34, 0x7b, 0xb5, 0x9c, 0x3a, 0xa2, 0xef, 0xce, 0xa8, 0x54, 0xff, 0x88, 0xe6,
+	0x21, 0x6c, 0x99, 0x11, 0xe2, 0x6b, 0x01, 0x75, 0x0d, 0x76, 0x52, 0xa7, 0x34, 0x00, 0xa0, 0x3c,
+	0x59, 0x0a, 0x2c, 0x23, 0xb0, 0x29, 0x4f, 0x1a, 0xfa, 0x2e, 0x6c, 0x4c, 0x59, 0xce, 0x54, 0x13,
+	0x52, 0x5d, 0xe8, 0x68, 0x0b, 0x92, 0xd2, 0xf8, 0x2d, 0x9d, 0x99, 0x74, 0xb6, 0xa2, 0x8e, 0xae,
+	0x5f, 0xd2, 0xd9, 0xf0, 0x0b, 0x02, 0xe7, 0xb6, 0x59, 0x59, 0x08, 0x2e, 0x29, 0x3e, 0x86, 0x0d,
+	0x9d, 0xb2, 0x74, 0x90, 0x67, 0xf9, 0xdd, 0xa3, 0x41, 0x70, 0xf3, 0x62, 0x04, 0x57, 0x8e, 0x3d,
+	0xaa, 0xb5, 0x78, 0x08, 0x3d, 0x4e, 0x2b, 0x15, 0xaf, 0x76, 0x6c, 0x99, 0x1d, 0xbb, 0x1a, 0x3c,
+	0xad, 0x77, 0xc5, 0x07, 0xb0, 0xcd, 0xea, 0x95, 0xd7, 0x27, 0xe9, 0x35, 0x68, 0x33, 0xcd, 0x23,
+	0xb8, 0x93, 0x33, 0x29, 0x19, 0x4f, 0x1b, 0x99, 0x74, 0xd6, 0x3d, 0xcb, 0xb7, 0xa2, 0xed, 0x06,
+	0xae, 0x75, 0xf2, 0xe8, 0x13, 0x02, 0x7b, 0xb4, 0xba, 0x0f, 0x17, 0x08, 0x76, 0x6e, 0xce, 0x84,
+	0x1f, 0xdf, 0x36, 0xff, 0x97, 0x43, 0xda, 0x7b, 0xf2, 0x3f, 0xd2, 0x3a, 0xa2, 0xe1, 0xc1, 0xfb,
+	0x6f, 0x3f, 0x3f, 0xb6, 0xf6, 0xf1, 0x20, 0xfc, 0xf3, 0x93, 0x32, 0x95, 0x1c, 0x9d, 0x7e, 0x9d,
+	0xbb, 0xe8, 0x72, 0xee, 0xa2, 0x1f, 0x73, 0x17, 0x5d, 0x2c, 0xdc, 0xb5, 0xcb, 0x85, 0xbb, 0xf6,
+	0x7d, 0xe1, 0xae, 0xbd, 0x79, 0x96, 0x32, 0x95, 0x9d, 0x8d, 0x83, 0x89, 0xc8, 0x57, 0x2d, 0x44,
+	0x99, 0xae, 0xfe, 0x9f, 0x92, 0xa2, 0x08, 0xf5, 0x97, 0x96, 0xc5, 0xc4, 0x74, 0x33, 0x7d, 0xc7,
+	0x6d, 0xf3, 0xf6, 0x8e, 0x7f, 0x0d, 0x00, 0xdf, 0xa1, 0xdb, 0xbd, 0xcb, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlobIndexClient is the client API for BlobIndex service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlobIndexClient interface {
+	// BlobsByNamespace returns the indexed blobs of a namespace within a height
+	// range, ordered by height, transaction index and blob index.
+	BlobsByNamespace(ctx context.Context, in *BlobsByNamespaceRequest, opts ...grpc.CallOption) (*BlobsByNamespaceResponse, error)
+}
+
+type blobIndexClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlobIndexClient(cc grpc1.ClientConn) BlobIndexClient {
+	return &blobIndexClient{cc}
+}
+
+func (c *blobIndexClient) BlobsByNamespace(ctx context.Context, in *BlobsByNamespaceRequest, opts ...grpc.CallOption) (*BlobsByNamespaceResponse, error) {
+	out := new(BlobsByNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.blob.v1.BlobIndex/BlobsByNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlobIndexServer is the server API for BlobIndex service.
+type BlobIndexServer interface {
+	// BlobsByNamespace returns the indexed blobs of a namespace within a height
+	// range, ordered by height, transaction index and blob index.
+	BlobsByNamespace(context.Context, *BlobsByNamespaceRequest) (*BlobsByNamespaceResponse, error)
+}
+
+// UnimplementedBlobIndexServer can be embedded to have forward compatible implementations.
+type UnimplementedBlobIndexServer struct {
+}
+
+func (*UnimplementedBlobIndexServer) BlobsByNamespace(ctx context.Context, req *BlobsByNamespaceRequest) (*BlobsByNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobsByNamespace not implemented")
+}
+
+func RegisterBlobIndexServer(s grpc1.Server, srv BlobIndexServer) {
+	s.RegisterService(&_BlobIndex_serviceDesc, srv)
+}
+
+func _BlobIndex_BlobsByNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobsByNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobIndexServer).BlobsByNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.blob.v1.BlobIndex/BlobsByNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobIndexServer).BlobsByNamespace(ctx, req.(*BlobsByNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var BlobIndex_serviceDesc = _BlobIndex_serviceDesc
+var _BlobIndex_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.blob.v1.BlobIndex",
+	HandlerType: (*BlobIndexServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobsByNamespace",
+			Handler:    _BlobIndex_BlobsByNamespace_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/blob/v1/index.proto",
+}
+
+func (m *IndexedBlob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedBlob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedBlob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ShareEnd != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.ShareEnd))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ShareStart != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.ShareStart))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BlobIndex != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.BlobIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobsByNamespaceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobsByNamespaceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobsByNamespaceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PageKey) > 0 {
+		i -= len(m.PageKey)
+		copy(dAtA[i:], m.PageKey)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.PageKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Limit != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobsByNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobsByNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobsByNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingHeights) > 0 {
+		dAtA2 := make([]byte, len(m.MissingHeights)*10)
+		var j1 int
+		for _, num1 := range m.MissingHeights {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintIndex(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IndexedHeight != 0 {
+		i = encodeVarintIndex(dAtA, i, uint64(m.IndexedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NextPageKey) > 0 {
+		i -= len(m.NextPageKey)
+		copy(dAtA[i:], m.NextPageKey)
+		i = encodeVarintIndex(dAtA, i, uint64(len(m.NextPageKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIndex(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIndex(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIndex(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IndexedBlob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovIndex(uint64(m.Height))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovIndex(uint64(m.TxIndex))
+	}
+	if m.BlobIndex != 0 {
+		n += 1 + sovIndex(uint64(m.BlobIndex))
+	}
+	if m.ShareStart != 0 {
+		n += 1 + sovIndex(uint64(m.ShareStart))
+	}
+	if m.ShareEnd != 0 {
+		n += 1 + sovIndex(uint64(m.ShareEnd))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobsByNamespaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovIndex(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovIndex(uint64(m.EndHeight))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovIndex(uint64(m.Limit))
+	}
+	l = len(m.PageKey)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobsByNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovIndex(uint64(l))
+		}
+	}
+	l = len(m.NextPageKey)
+	if l > 0 {
+		n += 1 + l + sovIndex(uint64(l))
+	}
+	if m.IndexedHeight != 0 {
+		n += 1 + sovIndex(uint64(m.IndexedHeight))
+	}
+	if len(m.MissingHeights) > 0 {
+		l = 0
+		for _, e := range m.MissingHeights {
+			l += sovIndex(uint64(e))
+		}
+		n += 1 + sovIndex(uint64(l)) + l
+	}
+	return n
+}
+
+func sovIndex(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIndex(x uint64) (n int) {
+	return sovIndex(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IndexedBlob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedBlob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedBlob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobIndex", wireType)
+			}
+			m.BlobIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlobIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareStart", wireType)
+			}
+			m.ShareStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareStart |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareEnd", wireType)
+			}
+			m.ShareEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareEnd |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobsByNamespaceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobsByNamespaceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobsByNamespaceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageKey = append(m.PageKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PageKey == nil {
+				m.PageKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobsByNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIndex
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobsByNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobsByNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &IndexedBlob{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIndex
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageKey = append(m.NextPageKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextPageKey == nil {
+				m.NextPageKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexedHeight", wireType)
+			}
+			m.IndexedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIndex
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.MissingHeights = append(m.MissingHeights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIndex
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthIndex
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthIndex
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.MissingHeights) == 0 {
+					m.MissingHeights = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIndex
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.MissingHeights = append(m.MissingHeights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingHeights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIndex(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIndex
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIndex(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIndex
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIndex
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIndex
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIndex
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIndex
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIndex        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIndex          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIndex = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,330 @@
+package blobindex
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	coretypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+const (
+	// DefaultLimit is the number of blobs returned by a query that doesn't
+	// set a limit.
+	DefaultLimit = 100
+	// MaxLimit is the max number of blobs returned by a query.
+	MaxLimit = 1000
+	// QueueSize is the number of blocks that can wait to be indexed in the
+	// background. The blocks enqueued while the queue is full are recorded
+	// as missing.
+	QueueSize = 100
+)
+
+var (
+	// blobPrefix prefixes the keys of the indexed blobs. The keys are
+	// blobPrefix | namespace | height | tx index | blob index so that the
+	// blobs of a namespace are ordered by height.
+	blobPrefix = []byte{0x00}
+	// indexedHeightKey is the key of the last indexed height.
+	indexedHeightKey = []byte{0x01}
+	// gapPrefix prefixes the keys of the heights that failed to be indexed.
+	// The keys are gapPrefix | height.
+	gapPrefix = []byte{0x02}
+
+	// ErrInvalidPageKey is returned when a page key doesn't belong to the
+	// queried namespace.
+	ErrInvalidPageKey = errors.New("page key doesn't match the namespace")
+)
+
+// Indexer records where the blobs of the committed blocks are located,
+// indexed by namespace. The index is node-local: it is not part of the state
+// and only covers the blocks finalized while it was enabled. It is safe for
+// concurrent use.
+type Indexer struct {
+	db     dbm.DB
+	logger log.Logger
+
+	// queue holds the blocks waiting to be indexed by the goroutine started
+	// by the first Enqueue, which closes done once the queue is closed and
+	// drained.
+	mtx     sync.Mutex
+	closed  bool
+	started bool
+	queue   chan queuedBlock
+	done    chan struct{}
+}
+
+// queuedBlock is a block waiting to be indexed.
+type queuedBlock struct {
+	height        int64
+	txs           [][]byte
+	maxSquareSize int
+	decoder       sdk.TxDecoder
+}
+
+// NewIndexer returns an Indexer that stores the index in db. logger reports
+// the blocks that failed to be indexed in the background.
+func NewIndexer(db dbm.DB, logger log.Logger) *Indexer {
+	return &Indexer{
+		db:     db,
+		logger: logger,
+		queue:  make(chan queuedBlock, QueueSize),
+		done:   make(chan struct{}),
+	}
+}
+
+// Close waits for the enqueued blocks to be indexed and closes the database
+// of the index.
+func (i *Indexer) Close() error {
+	i.mtx.Lock()
+	started := i.started
+	if !i.closed {
+		i.closed = true
+		close(i.queue)
+	}
+	i.mtx.Unlock()
+	if started {
+		<-i.done
+	}
+	return i.db.Close()
+}
+
+// Enqueue schedules the block at height to be indexed in the background so
+// that indexing doesn't delay the finalization of the block. The arguments
+// are the ones of IndexBlock. If the queue is full, the height is recorded as
+// missing instead.
+func (i *Indexer) Enqueue(height int64, txs [][]byte, maxSquareSize int, decoder sdk.TxDecoder) {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+	if i.closed {
+		return
+	}
+	if !i.started {
+		i.started = true
+		go i.run()
+	}
+	select {
+	case i.queue <- queuedBlock{height: height, txs: txs, maxSquareSize: maxSquareSize, decoder: decoder}:
+	default:
+		i.logger.Error("blob index queue is full", "height", height)
+		if err := i.db.Set(heightKey(gapPrefix, height), []byte{}); err != nil {
+			i.logger.Error("failed to record missing height", "height", height, "err", err)
+		}
+	}
+}
+
+// run indexes the enqueued blocks until the queue is closed.
+func (i *Indexer) run() {
+	defer close(i.done)
+	for block := range i.queue {
+		if err := i.IndexBlock(block.height, block.txs, block.maxSquareSize, block.decoder); err != nil {
+			i.logger.Error("failed to index blobs", "height", block.height, "err", err)
+		}
+	}
+}
+
+// IndexBlock indexes the blobs of the block at height. txs are the
+// transactions of the block in order and maxSquareSize is the max square
+// size the block was built with, which is needed to lay out the square and
+// locate the shares of the blobs. If the block can't be indexed, its height is
+// recorded as missing so that the queries covering it report the gap instead
+// of silently omitting its blobs.
+func (i *Indexer) IndexBlock(height int64, txs [][]byte, maxSquareSize int, decoder sdk.TxDecoder) error {
+	err := i.indexBlock(height, txs, maxSquareSize, decoder)
+	if err == nil {
+		return nil
+	}
+	if gapErr := i.db.SetSync(heightKey(gapPrefix, height), []byte{}); gapErr != nil {
+		return errors.Join(err, fmt.Errorf("recording missing height: %w", gapErr))
+	}
+	return err
+}
+
+func (i *Indexer) indexBlock(height int64, txs [][]byte, maxSquareSize int, decoder sdk.TxDecoder) error {
+	builder, err := square.NewBuilder(maxSquareSize, appconsts.SubtreeRootThreshold, txs...)
+	if err != nil {
+		return fmt.Errorf("building square: %w", err)
+	}
+
+	batch := i.db.NewBatch()
+	defer batch.Close()
+	for txIndex, rawTx := range txs {
+		btx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlob || err != nil {
+			continue
+		}
+		pfb, err := payForBlobs(decoder, btx.Tx)
+		if err != nil {
+			return fmt.Errorf("tx %d: %w", txIndex, err)
+		}
+		txHash := coretypes.Tx(btx.Tx).Hash()
+		for blobIndex, blob := range btx.Blobs {
+			start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+			if err != nil {
+				return fmt.Errorf("tx %d blob %d: %w", txIndex, blobIndex, err)
+			}
+			length, err := builder.BlobShareLength(txIndex, blobIndex)
+			if err != nil {
+				return fmt.Errorf("tx %d blob %d: %w", txIndex, blobIndex, err)
+			}
+			indexed := &IndexedBlob{
+				Namespace:  blob.Namespace().Bytes(),
+				Height:     height,
+				TxIndex:    uint32(txIndex),
+				BlobIndex:  uint32(blobIndex),
+				ShareStart: uint32(start),
+				ShareEnd:   uint32(start + length),
+				Signer:     pfb.Signer,
+				TxHash:     txHash,
+			}
+			if blobIndex < len(pfb.ShareCommitments) {
+				indexed.Commitment = pfb.ShareCommitments[blobIndex]
+			}
+			value, err := indexed.Marshal()
+			if err != nil {
+				return err
+			}
+			if err := batch.Set(blobKey(indexed.Namespace, height, indexed.TxIndex, indexed.BlobIndex), value); err != nil {
+				return err
+			}
+		}
+	}
+
+	// blocks may be finalized again when the node replays them on restart,
+	// which fills the gap left by a block that failed to be indexed.
+	if err := batch.Delete(heightKey(gapPrefix, height)); err != nil {
+		return err
+	}
+	indexedHeight, err := i.IndexedHeight()
+	if err != nil {
+		return err
+	}
+	if height > indexedHeight {
+		if err := batch.Set(indexedHeightKey, sdk.Uint64ToBigEndian(uint64(height))); err != nil {
+			return err
+		}
+	}
+	return batch.Write()
+}
+
+// IndexedHeight returns the last height indexed or zero if no block was
+// indexed.
+func (i *Indexer) IndexedHeight() (int64, error) {
+	value, err := i.db.Get(indexedHeightKey)
+	if err != nil || value == nil {
+		return 0, err
+	}
+	return int64(sdk.BigEndianToUint64(value)), nil
+}
+
+// MissingHeights returns the heights between startHeight and endHeight, both
+// inclusive, that failed to be indexed. A zero endHeight doesn't bound the
+// range.
+func (i *Indexer) MissingHeights(startHeight, endHeight int64) ([]int64, error) {
+	end := prefixEnd(gapPrefix)
+	if endHeight > 0 {
+		end = heightKey(gapPrefix, endHeight+1)
+	}
+	it, err := i.db.Iterator(heightKey(gapPrefix, startHeight), end)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	heights := make([]int64, 0)
+	for ; it.Valid(); it.Next() {
+		heights = append(heights, int64(sdk.BigEndianToUint64(it.Key()[len(gapPrefix):])))
+	}
+	return heights, it.Error()
+}
+
+// BlobsByNamespace returns up to limit blobs of the namespace included
+// between startHeight and endHeight, both inclusive. A zero endHeight doesn't
+// bound the range. pageKey resumes the iteration from the key returned by a
+// previous call. The returned key is nil if there are no more blobs.
+func (i *Indexer) BlobsByNamespace(namespace share.Namespace, startHeight, endHeight int64, limit int, pageKey []byte) ([]*IndexedBlob, []byte, error) {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	limit = min(limit, MaxLimit)
+
+	nsPrefix := append(append([]byte{}, blobPrefix...), namespace.Bytes()...)
+	start := heightKey(nsPrefix, startHeight)
+	if pageKey != nil {
+		if len(pageKey) != len(start)+8 || string(pageKey[:len(nsPrefix)]) != string(nsPrefix) {
+			return nil, nil, ErrInvalidPageKey
+		}
+		start = pageKey
+	}
+	end := prefixEnd(nsPrefix)
+	if endHeight > 0 {
+		end = heightKey(nsPrefix, endHeight+1)
+	}
+
+	it, err := i.db.Iterator(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	blobs := make([]*IndexedBlob, 0)
+	for ; it.Valid(); it.Next() {
+		if len(blobs) == limit {
+			return blobs, append([]byte{}, it.Key()...), nil
+		}
+		blob := &IndexedBlob{}
+		if err := blob.Unmarshal(it.Value()); err != nil {
+			return nil, nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil, it.Error()
+}
+
+// payForBlobs returns the MsgPayForBlobs of the transaction of a blob tx.
+func payForBlobs(decoder sdk.TxDecoder, rawTx []byte) (*blobtypes.MsgPayForBlobs, error) {
+	sdkTx, err := decoder(rawTx)
+	if err != nil {
+		return nil, err
+	}
+	for _, msg := range sdkTx.GetMsgs() {
+		if pfb, ok := msg.(*blobtypes.MsgPayForBlobs); ok {
+			return pfb, nil
+		}
+	}
+	return nil, errors.New("blob tx doesn't contain a MsgPayForBlobs")
+}
+
+func blobKey(namespace []byte, height int64, txIndex, blobIndex uint32) []byte {
+	key := heightKey(append(append([]byte{}, blobPrefix...), namespace...), height)
+	key = binary.BigEndian.AppendUint32(key, txIndex)
+	return binary.BigEndian.AppendUint32(key, blobIndex)
+}
+
+func heightKey(nsPrefix []byte, height int64) []byte {
+	key := append(make([]byte, 0, len(nsPrefix)+16), nsPrefix...)
+	return binary.BigEndian.AppendUint64(key, uint64(max(height, 0)))
+}
+
+// prefixEnd returns the smallest key greater than all the keys with the
+// prefix.
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return end[:i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package blobindex_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	coretypes "github.com/cometbft/cometbft/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v4/app"
+	"github.com/celestiaorg/celestia-app/v4/app/encoding"
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/blobindex"
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v4/test/util/random"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testnode"
+)
+
+func TestIndexer(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	decoder := enc.TxConfig.TxDecoder()
+
+	ns1 := share.RandomBlobNamespace()
+	ns2 := share.RandomBlobNamespace()
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns2, ns1}, []int{1000, 2000, 3000})
+	txs := append(coretypes.Txs{blobfactory.GenerateRandomRawSendTx(random.New(), signer)}, blobTxs...).ToSliceOfBytes()
+	maxSquareSize := appconsts.DefaultSquareSizeUpperBound
+
+	indexer := blobindex.NewIndexer(dbm.NewMemDB(), log.NewNopLogger())
+	require.NoError(t, indexer.IndexBlock(5, txs, maxSquareSize, decoder))
+	require.NoError(t, indexer.IndexBlock(6, txs[:2], maxSquareSize, decoder))
+	indexedHeight, err := indexer.IndexedHeight()
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), indexedHeight)
+
+	dataSquare, err := square.Construct(txs, maxSquareSize, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+
+	blobs, next, err := indexer.BlobsByNamespace(ns1, 0, 0, 0, nil)
+	require.NoError(t, err)
+	require.Nil(t, next)
+	require.Len(t, blobs, 3)
+	for i, want := range []struct {
+		height  int64
+		txIndex uint32
+	}{{5, 1}, {5, 3}, {6, 1}} {
+		assert.Equal(t, want.height, blobs[i].Height)
+		assert.Equal(t, want.txIndex, blobs[i].TxIndex)
+		assert.Equal(t, signer.Accounts()[0].Address().String(), blobs[i].Signer)
+		assert.NotEmpty(t, blobs[i].Commitment)
+		// the indexed share range holds the blob
+		shares := dataSquare[blobs[i].ShareStart:blobs[i].ShareEnd]
+		assert.Equal(t, ns1, shares[0].Namespace())
+		assert.True(t, shares[0].IsSequenceStart())
+	}
+
+	// the height range bounds the results
+	blobs, _, err = indexer.BlobsByNamespace(ns1, 6, 6, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	blobs, _, err = indexer.BlobsByNamespace(ns2, 0, 5, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, blobs, 1)
+	assert.Equal(t, uint32(2), blobs[0].TxIndex)
+
+	// paging resumes after the last returned blob
+	blobs, next, err = indexer.BlobsByNamespace(ns1, 0, 0, 2, nil)
+	require.NoError(t, err)
+	require.Len(t, blobs, 2)
+	require.NotNil(t, next)
+	blobs, next, err = indexer.BlobsByNamespace(ns1, 0, 0, 2, next)
+	require.NoError(t, err)
+	require.Nil(t, next)
+	require.Len(t, blobs, 1)
+	assert.Equal(t, int64(6), blobs[0].Height)
+
+	_, _, err = indexer.BlobsByNamespace(ns2, 0, 0, 2, []byte{0x00})
+	require.ErrorIs(t, err, blobindex.ErrInvalidPageKey)
+
+	// a block that can't be indexed is recorded as missing until it is
+	// indexed again
+	require.Error(t, indexer.IndexBlock(7, txs, 1, decoder))
+	missing, err := indexer.MissingHeights(0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7}, missing)
+	missing, err = indexer.MissingHeights(0, 6)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+	require.NoError(t, indexer.IndexBlock(7, txs, maxSquareSize, decoder))
+	missing, err = indexer.MissingHeights(0, 0)
+	require.NoError(t, err)
+	assert.Empty(t, missing)
+
+	// the enqueued blocks are indexed in the background and closing the
+	// indexer waits for them
+	indexer.Enqueue(8, txs, maxSquareSize, decoder)
+	indexer.Enqueue(9, txs, 1, decoder)
+	require.NoError(t, indexer.Close())
+	indexedHeight, err = indexer.IndexedHeight()
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), indexedHeight)
+	missing, err = indexer.MissingHeights(0, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{9}, missing)
+}
+
+func TestBlobsByNamespace(t *testing.T) {
+	ns := share.RandomBlobNamespace()
+	disabled := blobindex.NewBlobIndexServer(nil)
+	_, err := disabled.BlobsByNamespace(context.Background(), &blobindex.BlobsByNamespaceRequest{Namespace: ns.Bytes()})
+	require.Error(t, err)
+
+	server := blobindex.NewBlobIndexServer(blobindex.NewIndexer(dbm.NewMemDB(), log.NewNopLogger()))
+	_, err = server.BlobsByNamespace(context.Background(), nil)
+	require.Error(t, err)
+	_, err = server.BlobsByNamespace(context.Background(), &blobindex.BlobsByNamespaceRequest{Namespace: []byte{1}})
+	require.Error(t, err)
+	_, err = server.BlobsByNamespace(context.Background(), &blobindex.BlobsByNamespaceRequest{Namespace: ns.Bytes(), StartHeight: 5, EndHeight: 4})
+	require.Error(t, err)
+
+	resp, err := server.BlobsByNamespace(context.Background(), &blobindex.BlobsByNamespaceRequest{Namespace: ns.Bytes()})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Blobs)
+	assert.Zero(t, resp.IndexedHeight)
+}
@@ -0,0 +1,80 @@
+package blobindex
+
+import (
+	"context"
+	"errors"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// RegisterBlobIndexService registers the blob index service on the gRPC
+// router. indexer is nil if the node doesn't index blobs, in which case the
+// queries fail with codes.Unavailable.
+func RegisterBlobIndexService(qrt gogogrpc.Server, indexer *Indexer) {
+	RegisterBlobIndexServer(qrt, NewBlobIndexServer(indexer))
+}
+
+// RegisterGRPCGatewayRoutes mounts the blob index service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterBlobIndexHandlerClient(context.Background(), mux, NewBlobIndexClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ BlobIndexServer = &blobIndexServer{}
+
+type blobIndexServer struct {
+	indexer *Indexer
+}
+
+func NewBlobIndexServer(indexer *Indexer) BlobIndexServer {
+	return &blobIndexServer{indexer: indexer}
+}
+
+// BlobsByNamespace implements the BlobIndexServer.BlobsByNamespace method.
+func (s *blobIndexServer) BlobsByNamespace(_ context.Context, req *BlobsByNamespaceRequest) (*BlobsByNamespaceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if s.indexer == nil {
+		return nil, status.Error(codes.Unavailable, "the node doesn't index blobs, see blob-index.enabled in app.toml")
+	}
+	namespace, err := share.NewNamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %v", err)
+	}
+	if req.StartHeight < 0 || req.EndHeight < 0 {
+		return nil, status.Error(codes.InvalidArgument, "heights cannot be negative")
+	}
+	if req.EndHeight > 0 && req.EndHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "end height %d is lower than start height %d", req.EndHeight, req.StartHeight)
+	}
+
+	indexedHeight, err := s.indexer.IndexedHeight()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	blobs, nextPageKey, err := s.indexer.BlobsByNamespace(namespace, req.StartHeight, req.EndHeight, int(req.Limit), req.PageKey)
+	if errors.Is(err, ErrInvalidPageKey) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	missingHeights, err := s.indexer.MissingHeights(req.StartHeight, req.EndHeight)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &BlobsByNamespaceResponse{
+		Blobs:          blobs,
+		NextPageKey:    nextPageKey,
+		IndexedHeight:  indexedHeight,
+		MissingHeights: missingHeights,
+	}, nil
+}
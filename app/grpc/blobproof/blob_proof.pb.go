@@ -0,0 +1,1205 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/blob_proof/blob_proof.proto
+
+package blobproof
+
+import (
+	context "context"
+	fmt "fmt"
+	proof "github.com/celestiaorg/celestia-app/v4/pkg/proof"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlobWithProofRequest the request to retrieve a blob with its proof.
+type BlobWithProofRequest struct {
+	Height    int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// commitment is the share commitment of the blob.
+	Commitment []byte `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *BlobWithProofRequest) Reset()         { *m = BlobWithProofRequest{} }
+func (m *BlobWithProofRequest) String() string { return proto.CompactTextString(m) }
+func (*BlobWithProofRequest) ProtoMessage()    {}
+func (*BlobWithProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_364c26aaa13a18b7, []int{0}
+}
+func (m *BlobWithProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobWithProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobWithProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobWithProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobWithProofRequest.Merge(m, src)
+}
+func (m *BlobWithProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobWithProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobWithProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobWithProofRequest proto.InternalMessageInfo
+
+func (m *BlobWithProofRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlobWithProofRequest) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *BlobWithProofRequest) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+// BlobWithProofResponse the response containing the blob and its proof.
+type BlobWithProofResponse struct {
+	Blob *Blob `protobuf:"bytes,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	// share_start and share_end are the range of shares, end exclusive, the
+	// blob occupies in the original data square.
+	ShareStart uint32 `protobuf:"varint,2,opt,name=share_start,json=shareStart,proto3" json:"share_start,omitempty"`
+	ShareEnd   uint32 `protobuf:"varint,3,opt,name=share_end,json=shareEnd,proto3" json:"share_end,omitempty"`
+	// proof is the proof of the shares of the blob to the data root.
+	Proof *proof.ShareProof `protobuf:"bytes,4,opt,name=proof,proto3" json:"proof,omitempty"`
+	// data_root is the data root of the block the proof verifies against.
+	DataRoot []byte `protobuf:"bytes,5,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+}
+
+func (m *BlobWithProofResponse) Reset()         { *m = BlobWithProofResponse{} }
+func (m *BlobWithProofResponse) String() string { return proto.CompactTextString(m) }
+func (*BlobWithProofResponse) ProtoMessage()    {}
+func (*BlobWithProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_364c26aaa13a18b7, []int{1}
+}
+func (m *BlobWithProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobWithProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlobWithProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobWithProofResponse.Merge(m, src)
+}
+func (m *BlobWithProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobWithProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobWithProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobWithProofResponse proto.InternalMessageInfo
+
+func (m *BlobWithProofResponse) GetBlob() *Blob {
+	if m != nil {
+		return m.Blob
+	}
+	return nil
+}
+
+func (m *BlobWithProofResponse) GetShareStart() uint32 {
+	if m != nil {
+		return m.ShareStart
+	}
+	return 0
+}
+
+func (m *BlobWithProofResponse) GetShareEnd() uint32 {
+	if m != nil {
+		return m.ShareEnd
+	}
+	return 0
+}
+
+func (m *BlobWithProofResponse) GetProof() *proof.ShareProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *BlobWithProofResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+// Blob is a blob as included in a block.
+type Blob struct {
+	Namespace    []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Data         []byte `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	ShareVersion uint32 `protobuf:"varint,3,opt,name=share_version,json=shareVersion,proto3" json:"share_version,omitempty"`
+	// signer is set for blobs of share version 1.
+	Signer []byte `protobuf:"bytes,4,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *Blob) Reset()         { *m = Blob{} }
+func (m *Blob) String() string { return proto.CompactTextString(m) }
+func (*Blob) ProtoMessage()    {}
+func (*Blob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_364c26aaa13a18b7, []int{2}
+}
+func (m *Blob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Blob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Blob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Blob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Blob.Merge(m, src)
+}
+func (m *Blob) XXX_Size() int {
+	return m.Size()
+}
+func (m *Blob) XXX_DiscardUnknown() {
+	xxx_messageInfo_Blob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Blob proto.InternalMessageInfo
+
+func (m *Blob) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *Blob) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Blob) GetShareVersion() uint32 {
+	if m != nil {
+		return m.ShareVersion
+	}
+	return 0
+}
+
+func (m *Blob) GetSigner() []byte {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BlobWithProofRequest)(nil), "celestia.core.v1.blob_proof.BlobWithProofRequest")
+	proto.RegisterType((*BlobWithProofResponse)(nil), "celestia.core.v1.blob_proof.BlobWithProofResponse")
+	proto.RegisterType((*Blob)(nil), "celestia.core.v1.blob_proof.Blob")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/blob_proof/blob_proof.proto", fileDescriptor_364c26aaa13a18b7)
+}
+
+var fileDescriptor_364c26aaa13a18b7 = []byte{
+	// 461 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xcd, 0xb6, 0x69, 0xd5, 0x4c, 0x93, 0xcb, 0x0a, 0x90, 0x95, 0x56, 0xa6, 0x18, 0x21, 0x7a,
+	0xa0, 0xb6, 0x12, 0x04, 0xe2, 0x5c, 0x89, 0x7b, 0xe5, 0x4a, 0x20, 0x71, 0x89, 0xd6, 0xce, 0x62,
+	0x5b, 0xb2, 0x77, 0xcc, 0xee, 0x26, 0x17, 0xc4, 0x85, 0x2f, 0x40, 0xe2, 0x1b, 0xf8, 0x02, 0x7e,
+	0x82, 0x63, 0x25, 0x2e, 0x1c, 0x51, 0xc2, 0x87, 0xa0, 0x9d, 0x0d, 0x6d, 0x9a, 0x4a, 0x45, 0x3d,
+	0x58, 0xda, 0x7d, 0x9e, 0x79, 0xef, 0xcd, 0xdb, 0x81, 0x67, 0xb9, 0xac, 0xa5, 0xb1, 0x95, 0x48,
+	0x72, 0xd4, 0x32, 0x99, 0x8f, 0x92, 0xac, 0xc6, 0x6c, 0xd2, 0x6a, 0xc4, 0xf7, 0x6b, 0xc7, 0xb8,
+	0xd5, 0x68, 0x91, 0x1f, 0xfc, 0xab, 0x8e, 0x5d, 0x75, 0x3c, 0x1f, 0xc5, 0x57, 0x25, 0xc3, 0xc3,
+	0x02, 0xb1, 0xa8, 0x65, 0x22, 0xda, 0x2a, 0x11, 0x4a, 0xa1, 0x15, 0xb6, 0x42, 0x65, 0x7c, 0xeb,
+	0x30, 0xba, 0x21, 0xe4, 0x35, 0xd6, 0xe8, 0xa3, 0x1a, 0xee, 0x9d, 0xd6, 0x98, 0xbd, 0xad, 0x6c,
+	0x79, 0xe6, 0xe0, 0x54, 0x7e, 0x98, 0x49, 0x63, 0xf9, 0x03, 0xd8, 0x2d, 0x65, 0x55, 0x94, 0x36,
+	0x60, 0x47, 0xec, 0x78, 0x3b, 0x5d, 0xdd, 0xf8, 0x21, 0xf4, 0x94, 0x68, 0xa4, 0x69, 0x45, 0x2e,
+	0x83, 0xad, 0x23, 0x76, 0xdc, 0x4f, 0xaf, 0x00, 0x1e, 0x02, 0xe4, 0xd8, 0x34, 0x95, 0x6d, 0xa4,
+	0xb2, 0xc1, 0x36, 0xfd, 0x5e, 0x43, 0xa2, 0x25, 0x83, 0xfb, 0x1b, 0x72, 0xa6, 0x45, 0x65, 0x24,
+	0x7f, 0x01, 0x5d, 0x37, 0x17, 0xa9, 0xed, 0x8f, 0x1f, 0xc5, 0xb7, 0x4c, 0x1d, 0x3b, 0x86, 0x94,
+	0xca, 0xf9, 0x43, 0xd8, 0x37, 0xa5, 0xd0, 0x72, 0x62, 0xac, 0xd0, 0x96, 0x0c, 0x0d, 0x52, 0x20,
+	0xe8, 0xdc, 0x21, 0xfc, 0x00, 0x7a, 0xbe, 0x40, 0xaa, 0x29, 0x19, 0x1a, 0xa4, 0x7b, 0x04, 0xbc,
+	0x56, 0x53, 0xfe, 0x0a, 0x76, 0x88, 0x31, 0xe8, 0x92, 0x6a, 0x74, 0x53, 0xd5, 0x0b, 0x9e, 0xbb,
+	0x06, 0xef, 0xd7, 0x37, 0x38, 0xda, 0xa9, 0xb0, 0x62, 0xa2, 0x11, 0x6d, 0xb0, 0x43, 0x73, 0xee,
+	0x39, 0x20, 0x45, 0xb4, 0xd1, 0x0c, 0xba, 0xce, 0xe2, 0xf5, 0xac, 0xd8, 0x66, 0x56, 0x1c, 0xba,
+	0xae, 0x63, 0x15, 0x22, 0x9d, 0xf9, 0x63, 0x18, 0x78, 0xb7, 0x73, 0xa9, 0x4d, 0x85, 0x6a, 0xe5,
+	0xb8, 0x4f, 0xe0, 0x1b, 0x8f, 0xb9, 0xa7, 0x31, 0x55, 0xa1, 0xa4, 0x26, 0xdb, 0xfd, 0x74, 0x75,
+	0x1b, 0x7f, 0x67, 0xd0, 0x73, 0xba, 0x64, 0x94, 0x7f, 0x63, 0x30, 0xb8, 0x16, 0x35, 0x1f, 0xfd,
+	0x37, 0xd4, 0xcd, 0x2d, 0x18, 0x8e, 0xef, 0xd2, 0xe2, 0x5f, 0x32, 0x3a, 0xf9, 0xfc, 0xf3, 0xcf,
+	0xd7, 0xad, 0xa7, 0xfc, 0x49, 0x72, 0xdb, 0x9e, 0x7f, 0xf4, 0xfb, 0xf4, 0xe9, 0xf4, 0xec, 0xc7,
+	0x22, 0x64, 0x17, 0x8b, 0x90, 0xfd, 0x5e, 0x84, 0xec, 0xcb, 0x32, 0xec, 0x5c, 0x2c, 0xc3, 0xce,
+	0xaf, 0x65, 0xd8, 0x79, 0xf7, 0xb2, 0xa8, 0x6c, 0x39, 0xcb, 0xe2, 0x1c, 0x9b, 0x4b, 0x2a, 0xd4,
+	0xc5, 0xe5, 0xf9, 0x44, 0xb4, 0x6d, 0xe2, 0xbe, 0x42, 0xb7, 0x39, 0x71, 0x13, 0x75, 0xb6, 0x4b,
+	0x9b, 0xfd, 0xfc, 0xef, 0x00, 0x1b, 0x8a, 0x25, 0x64, 0x68, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlobProofClient is the client API for BlobProof service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlobProofClient interface {
+	// BlobWithProof rebuilds the square of the block at the requested height
+	// from the block store of the node, locates the blob of the namespace with
+	// the share commitment and returns it with a proof of its shares to the
+	// data root of the block.
+	BlobWithProof(ctx context.Context, in *BlobWithProofRequest, opts ...grpc.CallOption) (*BlobWithProofResponse, error)
+}
+
+type blobProofClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlobProofClient(cc grpc1.ClientConn) BlobProofClient {
+	return &blobProofClient{cc}
+}
+
+func (c *blobProofClient) BlobWithProof(ctx context.Context, in *BlobWithProofRequest, opts ...grpc.CallOption) (*BlobWithProofResponse, error) {
+	out := new(BlobWithProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.blob_proof.BlobProof/BlobWithProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlobProofServer is the server API for BlobProof service.
+type BlobProofServer interface {
+	// BlobWithProof rebuilds the square of the block at the requested height
+	// from the block store of the node, locates the blob of the namespace with
+	// the share commitment and returns it with a proof of its shares to the
+	// data root of the block.
+	BlobWithProof(context.Context, *BlobWithProofRequest) (*BlobWithProofResponse, error)
+}
+
+// UnimplementedBlobProofServer can be embedded to have forward compatible implementations.
+type UnimplementedBlobProofServer struct {
+}
+
+func (*UnimplementedBlobProofServer) BlobWithProof(ctx context.Context, req *BlobWithProofRequest) (*BlobWithProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobWithProof not implemented")
+}
+
+func RegisterBlobProofServer(s grpc1.Server, srv BlobProofServer) {
+	s.RegisterService(&_BlobProof_serviceDesc, srv)
+}
+
+func _BlobProof_BlobWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlobWithProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobProofServer).BlobWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.blob_proof.BlobProof/BlobWithProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobProofServer).BlobWithProof(ctx, req.(*BlobWithProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var BlobProof_serviceDesc = _BlobProof_serviceDesc
+var _BlobProof_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.blob_proof.BlobProof",
+	HandlerType: (*BlobProofServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BlobWithProof",
+			Handler:    _BlobProof_BlobWithProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/blob_proof/blob_proof.proto",
+}
+
+func (m *BlobWithProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobWithProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobWithProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintBlobProof(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintBlobProof(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintBlobProof(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobWithProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintBlobProof(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlobProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ShareEnd != 0 {
+		i = encodeVarintBlobProof(dAtA, i, uint64(m.ShareEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ShareStart != 0 {
+		i = encodeVarintBlobProof(dAtA, i, uint64(m.ShareStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Blob != nil {
+		{
+			size, err := m.Blob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlobProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Blob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Blob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Blob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintBlobProof(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ShareVersion != 0 {
+		i = encodeVarintBlobProof(dAtA, i, uint64(m.ShareVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintBlobProof(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintBlobProof(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlobProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlobProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlobWithProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlobProof(uint64(m.Height))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovBlobProof(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovBlobProof(uint64(l))
+	}
+	return n
+}
+
+func (m *BlobWithProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blob != nil {
+		l = m.Blob.Size()
+		n += 1 + l + sovBlobProof(uint64(l))
+	}
+	if m.ShareStart != 0 {
+		n += 1 + sovBlobProof(uint64(m.ShareStart))
+	}
+	if m.ShareEnd != 0 {
+		n += 1 + sovBlobProof(uint64(m.ShareEnd))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovBlobProof(uint64(l))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovBlobProof(uint64(l))
+	}
+	return n
+}
+
+func (m *Blob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovBlobProof(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovBlobProof(uint64(l))
+	}
+	if m.ShareVersion != 0 {
+		n += 1 + sovBlobProof(uint64(m.ShareVersion))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovBlobProof(uint64(l))
+	}
+	return n
+}
+
+func sovBlobProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlobProof(x uint64) (n int) {
+	return sovBlobProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlobWithProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobWithProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobWithProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlobWithProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlobWithProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlobWithProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Blob == nil {
+				m.Blob = &Blob{}
+			}
+			if err := m.Blob.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareStart", wireType)
+			}
+			m.ShareStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareStart |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareEnd", wireType)
+			}
+			m.ShareEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareEnd |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &proof.ShareProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Blob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Blob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Blob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareVersion", wireType)
+			}
+			m.ShareVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShareVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlobProof(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlobProof
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlobProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlobProof
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlobProof
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlobProof
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlobProof        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlobProof          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlobProof = fmt.Errorf("proto: unexpected end of group")
+)
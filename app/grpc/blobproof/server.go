@@ -0,0 +1,94 @@
+package blobproof
+
+import (
+	"bytes"
+	"context"
+	"errors"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/proof"
+)
+
+// RegisterBlobProofService registers the blob proof service on the gRPC
+// router.
+func RegisterBlobProofService(qrt gogogrpc.Server, clientCtx client.Context) {
+	RegisterBlobProofServer(qrt, NewBlobProofServer(clientCtx))
+}
+
+// RegisterGRPCGatewayRoutes mounts the blob proof service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterBlobProofHandlerClient(context.Background(), mux, NewBlobProofClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ BlobProofServer = &blobProofServer{}
+
+type blobProofServer struct {
+	clientCtx client.Context
+}
+
+func NewBlobProofServer(clientCtx client.Context) BlobProofServer {
+	return &blobProofServer{clientCtx: clientCtx}
+}
+
+// BlobWithProof implements the BlobProofServer.BlobWithProof method.
+func (s *blobProofServer) BlobWithProof(ctx context.Context, req *BlobWithProofRequest) (*BlobWithProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Height <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d must be positive", req.Height)
+	}
+	namespace, err := share.NewNamespaceFromBytes(req.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid namespace: %v", err)
+	}
+	if len(req.Commitment) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "commitment cannot be empty")
+	}
+
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	block, err := node.Block(ctx, &req.Height)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "retrieving block %d: %v", req.Height, err)
+	}
+
+	blobProof, err := proof.NewBlobProof(block.Block.Data.Txs.ToSliceOfBytes(), namespace, req.Commitment)
+	if errors.Is(err, proof.ErrBlobNotFound) {
+		return nil, status.Errorf(codes.NotFound, "no blob with commitment %X in namespace %X at height %d", req.Commitment, req.Namespace, req.Height)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// the square is rebuilt with the upper bound square size, make sure it
+	// is the square that was committed to
+	if !bytes.Equal(blobProof.DataRoot, block.Block.DataHash) {
+		return nil, status.Errorf(codes.Internal, "rebuilt data root %X doesn't match the data root %X of block %d", blobProof.DataRoot, block.Block.DataHash, req.Height)
+	}
+
+	blob := blobProof.Blob
+	return &BlobWithProofResponse{
+		Blob: &Blob{
+			Namespace:    blob.Namespace().Bytes(),
+			Data:         blob.Data(),
+			ShareVersion: uint32(blob.ShareVersion()),
+			Signer:       blob.Signer(),
+		},
+		ShareStart: uint32(blobProof.ShareRange.Start),
+		ShareEnd:   uint32(blobProof.ShareRange.End),
+		Proof:      &blobProof.ShareProof,
+		DataRoot:   blobProof.DataRoot,
+	}, nil
+}
@@ -0,0 +1,159 @@
+package gasestimation
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+// worstCaseShareIndex is the share index used by go-square's builder to
+// account for the size of a PFB before the square is laid out.
+const worstCaseShareIndex = 128 * 128
+
+// blobGasPriceIncrement is the amount added to the gas price of the blob
+// transaction that would be left out of the square so that a transaction
+// using the estimation is strictly preferred over it. Clients round the fee
+// up, so any positive increment is preserved.
+const blobGasPriceIncrement = 0.000001
+
+// estimatedPFBTxSize is a rough estimation of the size in bytes of the sdk
+// transaction of a blob transaction, excluding the blob infos. It's used when
+// only the sizes of the blobs are known.
+const estimatedPFBTxSize = 300
+
+// EstimatedBlobShares returns the worst case number of shares used by a blob
+// transaction paying for blobs of the provided sizes when its sdk transaction
+// isn't known.
+func EstimatedBlobShares(blobSizes []uint32) int {
+	return BlobShares(estimatedPFBTxSize+blobtypes.BytesPerBlobInfo*len(blobSizes), blobSizes)
+}
+
+// mempoolTx is a mempool transaction along with its gas price.
+type mempoolTx struct {
+	rawTx    []byte
+	blobTx   *blobtx.BlobTx
+	gasPrice float64
+}
+
+// BlobShares returns the worst case number of shares used by a blob
+// transaction whose sdk transaction is pfbTxSize bytes and that pays for blobs
+// of the provided sizes. It includes the padding that may be needed to follow
+// the non-interactive default rules and mirrors the accounting of go-square's
+// builder.
+func BlobShares(pfbTxSize int, blobSizes []uint32) int {
+	indexes := make([]uint32, len(blobSizes))
+	for i := range indexes {
+		indexes[i] = worstCaseShareIndex
+	}
+	iw := blobtx.NewIndexWrapper(make([]byte, pfbTxSize), indexes...)
+	shares := share.NewCompactShareCounter().Add(proto.Size(iw))
+	for _, size := range blobSizes {
+		blobShares := share.SparseSharesNeeded(size)
+		shares += blobShares + inclusion.SubTreeWidth(blobShares, appconsts.SubtreeRootThreshold) - 1
+	}
+	return shares
+}
+
+// estimateBlobGasPrice simulates packing the mempool transactions into the
+// next square the same way FilterTxs does: normal transactions first, then
+// blob transactions, both in descending gas price order. It returns the gas
+// price a blob transaction using blobShares shares needs to be included ahead
+// of the blob transactions that would otherwise leave it no room, which is
+// strictly above the gas price of the first of them. ok is false
+// if the gas price doesn't change whether the blob fits, either because the
+// square has room for it anyway or because the normal transactions, which are
+// always included first, already leave it no room.
+func estimateBlobGasPrice(txDecoder sdk.TxDecoder, txs []types.Tx, maxSquareSize, blobShares int) (gasPrice float64, ok bool, err error) {
+	maxShares := maxSquareSize * maxSquareSize
+	if blobShares > maxShares {
+		return 0, false, errors.New("blobs don't fit in the max square size")
+	}
+	builder, err := square.NewBuilder(maxSquareSize, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		return 0, false, err
+	}
+
+	normalTxs, blobTxs := decodeMempoolTxs(txDecoder, txs)
+	for _, tx := range normalTxs {
+		builder.AppendTx(tx.rawTx)
+	}
+	if builder.CurrentSize()+blobShares > maxShares {
+		return 0, false, nil
+	}
+	for _, tx := range blobTxs {
+		if !builder.AppendBlobTx(tx.blobTx) {
+			continue
+		}
+		if builder.CurrentSize()+blobShares > maxShares {
+			return tx.gasPrice + blobGasPriceIncrement, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+// decodeMempoolTxs splits the mempool transactions into normal and blob
+// transactions, each sorted by descending gas price. Transactions that can't be
+// decoded are skipped.
+func decodeMempoolTxs(txDecoder sdk.TxDecoder, txs []types.Tx) (normalTxs, blobTxs []mempoolTx) {
+	for _, rawTx := range txs {
+		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		if isBlob {
+			if err != nil {
+				continue
+			}
+			gasPrice, ok := txGasPrice(txDecoder, bTx.Tx)
+			if !ok {
+				continue
+			}
+			blobTxs = append(blobTxs, mempoolTx{rawTx: rawTx, blobTx: bTx, gasPrice: gasPrice})
+			continue
+		}
+		gasPrice, ok := txGasPrice(txDecoder, rawTx)
+		if !ok {
+			continue
+		}
+		normalTxs = append(normalTxs, mempoolTx{rawTx: rawTx, gasPrice: gasPrice})
+	}
+	byGasPrice := func(txs []mempoolTx) func(i, j int) bool {
+		return func(i, j int) bool { return txs[i].gasPrice > txs[j].gasPrice }
+	}
+	sort.SliceStable(normalTxs, byGasPrice(normalTxs))
+	sort.SliceStable(blobTxs, byGasPrice(blobTxs))
+	return normalTxs, blobTxs
+}
+
+// txGasPrice returns the gas price of an sdk transaction paid in the bond
+// denom. ok is false if the transaction can't be decoded or has no gas limit.
+func txGasPrice(txDecoder sdk.TxDecoder, txBytes []byte) (gasPrice float64, ok bool) {
+	sdkTx, err := txDecoder(txBytes)
+	if err != nil {
+		return 0, false
+	}
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return 0, false
+	}
+	return float64(feeTx.GetFee().AmountOf(appconsts.BondDenom).Uint64()) / float64(feeTx.GetGas()), true
+}
+
+// maxSquareSizeFromBytes returns the largest power of two square size whose
+// shares fit in maxSquareBytes, bounded by the square size upper bound.
+func maxSquareSizeFromBytes(maxSquareBytes uint64) int {
+	squareSize := 1
+	for squareSize < appconsts.DefaultSquareSizeUpperBound &&
+		uint64(4*squareSize*squareSize*share.ShareSize) <= maxSquareBytes {
+		squareSize *= 2
+	}
+	return squareSize
+}
@@ -0,0 +1,127 @@
+package gasestimation
+
+import (
+	"context"
+	"encoding/binary"
+	"testing"
+
+	"github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+)
+
+// feeTx is an sdk transaction with a gas limit of 1 whose fee is encoded in
+// its bytes.
+type feeTx struct {
+	sdk.FeeTx
+	fee int64
+}
+
+func (tx feeTx) GetGas() uint64 { return 1 }
+
+func (tx feeTx) GetFee() sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, tx.fee))
+}
+
+func feeTxDecoder(txBytes []byte) (sdk.Tx, error) {
+	return feeTx{fee: int64(binary.BigEndian.Uint64(txBytes))}, nil
+}
+
+func newFeeTx(fee int64) []byte {
+	return binary.BigEndian.AppendUint64(nil, uint64(fee))
+}
+
+func newFeeBlobTx(t *testing.T, fee int64, blobSize int) []byte {
+	blob, err := share.NewBlob(share.RandomBlobNamespace(), make([]byte, blobSize), appconsts.DefaultShareVersion, nil)
+	require.NoError(t, err)
+	tx, err := blobtx.MarshalBlobTx(newFeeTx(fee), blob)
+	require.NoError(t, err)
+	return tx
+}
+
+func TestBlobShares(t *testing.T) {
+	for _, sizes := range [][]int{{1}, {share.FirstSparseShareContentSize}, {100_000}, {10, 1_000_000, 5_000}} {
+		blobs := make([]*share.Blob, 0, len(sizes))
+		blobSizes := make([]uint32, 0, len(sizes))
+		for _, size := range sizes {
+			blob, err := share.NewBlob(share.RandomBlobNamespace(), make([]byte, size), appconsts.DefaultShareVersion, nil)
+			require.NoError(t, err)
+			blobs = append(blobs, blob)
+			blobSizes = append(blobSizes, uint32(size))
+		}
+		pfbTx := make([]byte, 300)
+		builder, err := square.NewBuilder(appconsts.DefaultSquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+		require.True(t, builder.AppendBlobTx(&blobtx.BlobTx{Tx: pfbTx, Blobs: blobs}))
+		assert.Equal(t, builder.CurrentSize(), BlobShares(len(pfbTx), blobSizes))
+	}
+}
+
+func TestMaxSquareSizeFromBytes(t *testing.T) {
+	assert.Equal(t, 64, maxSquareSizeFromBytes(64*64*share.ShareSize))
+	assert.Equal(t, 64, maxSquareSizeFromBytes(128*128*share.ContinuationSparseShareContentSize))
+	assert.Equal(t, 1, maxSquareSizeFromBytes(0))
+	assert.Equal(t, appconsts.DefaultSquareSizeUpperBound, maxSquareSizeFromBytes(1<<40))
+}
+
+func TestEstimateBlobGasPrice(t *testing.T) {
+	threeShares := share.FirstSparseShareContentSize + 2*share.ContinuationSparseShareContentSize
+	// In a 4x4 square, the three blob txs use one compact share for their
+	// PFBs and three shares each for their blobs.
+	txs := []types.Tx{
+		newFeeBlobTx(t, 1, threeShares),
+		newFeeBlobTx(t, 3, threeShares),
+		newFeeBlobTx(t, 2, threeShares),
+	}
+	sixShares := BlobShares(8, []uint32{uint32(threeShares * 2)})
+	require.Equal(t, 7, sixShares)
+
+	gasPrice, ok, err := estimateBlobGasPrice(feeTxDecoder, txs, 4, sixShares)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	// the gas price is strictly above the one of the blob tx left out
+	assert.Equal(t, 1+blobGasPriceIncrement, gasPrice)
+
+	// a small blob fits next to all the mempool txs
+	_, ok, err = estimateBlobGasPrice(feeTxDecoder, txs, 4, BlobShares(8, []uint32{1}))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// normal txs are included first regardless of their gas price
+	normalTxs := append([]types.Tx{newFeeTx(0)}, txs...)
+	_, ok, err = estimateBlobGasPrice(feeTxDecoder, normalTxs, 1, 1)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	_, _, err = estimateBlobGasPrice(feeTxDecoder, txs, 4, 17)
+	require.Error(t, err)
+}
+
+func TestEstimateGasPriceBlobBaseFee(t *testing.T) {
+	blobBaseFee := appconsts.DefaultMinGasPrice * 10
+	server := NewGasEstimatorServer(
+		emptyMempool{},
+		feeTxDecoder,
+		func() (uint64, error) { return 64 * 64 * 512, nil },
+		func() (float64, error) { return blobBaseFee, nil },
+		nil,
+		NewBlockGasPrices(DefaultBlockWindowSize),
+	)
+
+	// the blob base fee is the floor of the gas price of the blob transactions
+	resp, err := server.EstimateGasPrice(context.Background(), &EstimateGasPriceRequest{BlobSizes: []uint32{100}})
+	require.NoError(t, err)
+	assert.Equal(t, blobBaseFee, resp.EstimatedGasPrice)
+
+	// but not of the other transactions
+	resp, err = server.EstimateGasPrice(context.Background(), &EstimateGasPriceRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, appconsts.DefaultMinGasPrice, resp.EstimatedGasPrice)
+}
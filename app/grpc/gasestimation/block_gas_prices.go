@@ -0,0 +1,131 @@
+package gasestimation
+
+import (
+	"sort"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+)
+
+// DefaultBlockWindowSize is the number of recently committed blocks used to
+// estimate the gas price.
+const DefaultBlockWindowSize = 5
+
+// blockGasPrices are the gas prices and the fullness of a committed block.
+type blockGasPrices struct {
+	gasPrices []float64
+	// fullness is the size of the block transactions divided by the max
+	// square size in bytes.
+	fullness float64
+}
+
+// BlockWindow is a snapshot of the recently committed blocks.
+type BlockWindow struct {
+	// GasPrices are the sorted gas prices of all the transactions in the
+	// window.
+	GasPrices []float64
+	// Fullness is the mean fullness of the blocks in the window.
+	Fullness float64
+	// Blocks is the number of blocks in the window.
+	Blocks int
+	// Size is the maximum number of blocks in the window.
+	Size int
+	// Height is the height of the most recent block in the window.
+	Height int64
+}
+
+// BlockGasPrices keeps a rolling window of the gas prices and the fullness of
+// the most recently committed blocks. It is safe for concurrent use. A nil
+// BlockGasPrices records nothing and returns an empty window.
+type BlockGasPrices struct {
+	mtx    sync.Mutex
+	size   int
+	blocks []blockGasPrices
+	height int64
+	// newBlock is closed and replaced every time a block is added.
+	newBlock chan struct{}
+}
+
+// NewBlockGasPrices returns a window of the last size committed blocks.
+func NewBlockGasPrices(size int) *BlockGasPrices {
+	return &BlockGasPrices{
+		size:     size,
+		blocks:   make([]blockGasPrices, 0, size),
+		newBlock: make(chan struct{}),
+	}
+}
+
+// AddBlock records the gas prices of the transactions of the committed block at
+// height and its fullness, evicting the oldest block if the window is full.
+func (b *BlockGasPrices) AddBlock(height int64, gasPrices []float64, fullness float64) {
+	if b == nil || b.size <= 0 {
+		return
+	}
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	if len(b.blocks) == b.size {
+		b.blocks = append(b.blocks[:0], b.blocks[1:]...)
+	}
+	b.blocks = append(b.blocks, blockGasPrices{gasPrices: gasPrices, fullness: fullness})
+	b.height = height
+	close(b.newBlock)
+	b.newBlock = make(chan struct{})
+}
+
+// NewBlock returns a channel that is closed when the next block is added. A nil
+// BlockGasPrices returns a nil channel.
+func (b *BlockGasPrices) NewBlock() <-chan struct{} {
+	if b == nil {
+		return nil
+	}
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.newBlock
+}
+
+// Window returns a snapshot of the blocks currently in the window.
+func (b *BlockGasPrices) Window() BlockWindow {
+	if b == nil {
+		return BlockWindow{}
+	}
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	window := BlockWindow{
+		GasPrices: make([]float64, 0),
+		Blocks:    len(b.blocks),
+		Size:      b.size,
+		Height:    b.height,
+	}
+	for _, block := range b.blocks {
+		window.GasPrices = append(window.GasPrices, block.gasPrices...)
+		window.Fullness += block.fullness
+	}
+	if len(b.blocks) > 0 {
+		window.Fullness /= float64(len(b.blocks))
+	}
+	sort.Float64s(window.GasPrices)
+	return window
+}
+
+// ExtractGasPrices returns the gas prices of the provided transactions.
+// Transactions that can't be decoded or don't pay fees in the bond denom are
+// skipped.
+func ExtractGasPrices(txDecoder sdk.TxDecoder, txs [][]byte) []float64 {
+	gasPrices := make([]float64, 0, len(txs))
+	for _, rawTx := range txs {
+		txBytes := rawTx
+		bTx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		if isBlob {
+			if err != nil {
+				continue
+			}
+			txBytes = bTx.Tx
+		}
+		if gasPrice, ok := txGasPrice(txDecoder, txBytes); ok {
+			gasPrices = append(gasPrices, gasPrice)
+		}
+	}
+	return gasPrices
+}
@@ -0,0 +1,114 @@
+package gasestimation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+)
+
+func TestBlockGasPrices(t *testing.T) {
+	var nilWindow *BlockGasPrices
+	nilWindow.AddBlock(1, []float64{1}, 1)
+	assert.Nil(t, nilWindow.NewBlock())
+	assert.Equal(t, BlockWindow{}, nilWindow.Window())
+
+	blocks := NewBlockGasPrices(3)
+	window := blocks.Window()
+	assert.Empty(t, window.GasPrices)
+	assert.Equal(t, 0, window.Blocks)
+	assert.Equal(t, 3, window.Size)
+
+	newBlock := blocks.NewBlock()
+	blocks.AddBlock(1, []float64{5, 1}, 0.1)
+	select {
+	case <-newBlock:
+	default:
+		t.Fatal("expected the new block channel to be closed")
+	}
+	blocks.AddBlock(2, []float64{3}, 0.2)
+	blocks.AddBlock(3, []float64{4, 2}, 0.3)
+	window = blocks.Window()
+	assert.Equal(t, []float64{1, 2, 3, 4, 5}, window.GasPrices)
+	assert.InDelta(t, 0.2, window.Fullness, 1e-9)
+	assert.Equal(t, 3, window.Blocks)
+	assert.Equal(t, int64(3), window.Height)
+
+	// the oldest block is evicted
+	blocks.AddBlock(4, nil, 0.9)
+	window = blocks.Window()
+	assert.Equal(t, []float64{2, 3, 4}, window.GasPrices)
+	assert.InDelta(t, 1.4/3, window.Fullness, 1e-9)
+	assert.Equal(t, 3, window.Blocks)
+}
+
+func TestBlendGasPriceEstimates(t *testing.T) {
+	fullWindow := BlockWindow{
+		GasPrices: []float64{0.1, 0.2, 0.3},
+		Fullness:  0.9,
+		Blocks:    DefaultBlockWindowSize,
+		Size:      DefaultBlockWindowSize,
+	}
+	emptyWindow := BlockWindow{
+		GasPrices: []float64{0.5},
+		Fullness:  0.1,
+		Blocks:    1,
+		Size:      DefaultBlockWindowSize,
+	}
+
+	tests := []struct {
+		name             string
+		mempoolGasPrices []float64
+		window           BlockWindow
+		wantGasPrice     float64
+		wantSampleSize   uint64
+		wantConfidence   float64
+	}{
+		{
+			name:           "nothing observed",
+			window:         BlockWindow{},
+			wantGasPrice:   appconsts.DefaultMinGasPrice,
+			wantConfidence: 0,
+		},
+		{
+			name:           "uncongested blocks return the min gas price",
+			window:         emptyWindow,
+			wantGasPrice:   appconsts.DefaultMinGasPrice,
+			wantConfidence: 0.2,
+		},
+		{
+			name:           "congested blocks",
+			window:         fullWindow,
+			wantGasPrice:   0.2,
+			wantSampleSize: 3,
+			wantConfidence: 3.0 / confidentSampleSize,
+		},
+		{
+			name:             "congested mempool",
+			mempoolGasPrices: []float64{0.01, 0.02, 0.03},
+			window:           emptyWindow,
+			wantGasPrice:     0.02,
+			wantSampleSize:   3,
+			wantConfidence:   0.2 * 3 / confidentSampleSize,
+		},
+		{
+			name:             "congested mempool and blocks use the highest estimation",
+			mempoolGasPrices: []float64{1, 2, 3},
+			window:           fullWindow,
+			wantGasPrice:     2,
+			wantSampleSize:   6,
+			wantConfidence:   6.0 / confidentSampleSize,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := blendGasPriceEstimates(TxPriority_TX_PRIORITY_MEDIUM, tt.mempoolGasPrices, tt.window)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantGasPrice, got.gasPrice, 1e-9)
+			assert.Equal(t, tt.wantSampleSize, got.sampleSize)
+			assert.InDelta(t, tt.wantConfidence, got.confidence, 1e-9)
+		})
+	}
+}
@@ -29,11 +29,15 @@ type baseAppSimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
 // current max square size in bytes.
 type govMaxSquareBytesFn func() (uint64, error)
 
+// blobBaseFeeFn is the signature of a function that returns the min gas price
+// of the transactions paying for blobs, zero if there is none.
+type blobBaseFeeFn func() (float64, error)
+
 // RegisterGasEstimationService registers the gas estimation service on the gRPC router.
-func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn) {
+func RegisterGasEstimationService(qrt gogogrpc.Server, clientCtx client.Context, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, blobBaseFeeFn blobBaseFeeFn, simulateFn baseAppSimulateFn, blockGasPrices *BlockGasPrices) {
 	RegisterGasEstimatorServer(
 		qrt,
-		NewGasEstimatorServer(clientCtx.Client, txDecoder, govMaxSquareBytesFn, simulateFn),
+		NewGasEstimatorServer(clientCtx.Client, txDecoder, govMaxSquareBytesFn, blobBaseFeeFn, simulateFn, blockGasPrices),
 	)
 }
 
@@ -44,40 +48,56 @@ type gasEstimatorServer struct {
 	simulateFn          baseAppSimulateFn
 	txDecoder           sdk.TxDecoder
 	govMaxSquareBytesFn govMaxSquareBytesFn
+	// blobBaseFeeFn returns the floor of the gas price of the transactions
+	// paying for blobs. It can be nil, in which case there is no floor.
+	blobBaseFeeFn blobBaseFeeFn
+	// blockGasPrices are the gas prices of the recently committed blocks. It
+	// can be nil, in which case only the mempool is used.
+	blockGasPrices *BlockGasPrices
+	// feed computes the gas price feed updates shared by the subscribers.
+	feed *gasPriceFeed
 }
 
-func NewGasEstimatorServer(mempoolClient cmtclient.MempoolClient, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, simulateFn baseAppSimulateFn) GasEstimatorServer {
-	return &gasEstimatorServer{
+func NewGasEstimatorServer(mempoolClient cmtclient.MempoolClient, txDecoder sdk.TxDecoder, govMaxSquareBytesFn govMaxSquareBytesFn, blobBaseFeeFn blobBaseFeeFn, simulateFn baseAppSimulateFn, blockGasPrices *BlockGasPrices) GasEstimatorServer {
+	server := &gasEstimatorServer{
 		mempoolClient:       mempoolClient,
 		simulateFn:          simulateFn,
 		txDecoder:           txDecoder,
 		govMaxSquareBytesFn: govMaxSquareBytesFn,
+		blobBaseFeeFn:       blobBaseFeeFn,
+		blockGasPrices:      blockGasPrices,
 	}
+	server.feed = newGasPriceFeed(server.gasPriceFeedUpdate, blockGasPrices.NewBlock)
+	return server
 }
 
 func (s *gasEstimatorServer) EstimateGasPrice(ctx context.Context, request *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error) {
-	gasPrice, err := s.estimateGasPrice(ctx, request.TxPriority)
+	blobShares := 0
+	if len(request.BlobSizes) > 0 {
+		blobShares = EstimatedBlobShares(request.BlobSizes)
+	}
+	estimate, err := s.estimateGasPrice(ctx, request.TxPriority, blobShares)
 	if err != nil {
 		return nil, err
 	}
-	return &EstimateGasPriceResponse{EstimatedGasPrice: gasPrice}, nil
+	return &EstimateGasPriceResponse{
+		EstimatedGasPrice: estimate.gasPrice,
+		Confidence:        estimate.confidence,
+		SampleSize:        estimate.sampleSize,
+	}, nil
 }
 
 // EstimateGasPriceAndUsage takes a transaction priority and a transaction bytes
-// and estimates the gas price based on the gas prices of the transactions in the last five blocks.
-// If no transaction is found in the last five blocks, return the network
+// and estimates the gas price based on the gas prices of the transactions in the mempool
+// and in the last five blocks.
+// If neither the mempool nor the last five blocks are congested, return the network
 // min gas price.
 // It's up to the light client to set the gas price in this case
 // to the minimum gas price set by that node.
+// If the transaction pays for blobs, the gas price is also high enough for
+// them to fit in the next block given the transactions in the mempool.
 // The gas used is estimated using the state machine simulation.
 func (s *gasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, request *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
-	// estimate the gas price
-	gasPrice, err := s.estimateGasPrice(ctx, request.TxPriority)
-	if err != nil {
-		return nil, err
-	}
-
-	// estimate the gas used
 	btx, isBlob, err := blobtx.UnmarshalBlobTx(request.TxBytes)
 	if isBlob && err != nil {
 		return nil, err
@@ -90,6 +110,24 @@ func (s *gasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, reque
 		txBytes = request.TxBytes
 	}
 
+	blobSizes := request.BlobSizes
+	if len(blobSizes) == 0 && isBlob {
+		for _, blob := range btx.Blobs {
+			blobSizes = append(blobSizes, uint32(len(blob.Data())))
+		}
+	}
+	blobShares := 0
+	if len(blobSizes) > 0 {
+		blobShares = BlobShares(len(txBytes), blobSizes)
+	}
+
+	// estimate the gas price
+	estimate, err := s.estimateGasPrice(ctx, request.TxPriority, blobShares)
+	if err != nil {
+		return nil, err
+	}
+
+	// estimate the gas used
 	gasUsedInfo, _, err := s.simulateFn(txBytes)
 	if err != nil {
 		return nil, err
@@ -97,8 +135,10 @@ func (s *gasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, reque
 	estimatedGasUsed := uint64(math.Round(float64(gasUsedInfo.GasUsed) * gasMultiplier))
 
 	return &EstimateGasPriceAndUsageResponse{
-		EstimatedGasPrice: gasPrice,
+		EstimatedGasPrice: estimate.gasPrice,
 		EstimatedGasUsed:  estimatedGasUsed,
+		Confidence:        estimate.confidence,
+		SampleSize:        estimate.sampleSize,
 	}, nil
 }
 
@@ -107,31 +147,149 @@ func (s *gasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, reque
 // If the returned transactions from the mempool can't fill more than 70% of
 // the max block, the node min gas price is returned.
 // Otherwise, the gas is estimated following the provided priority.
+// The same threshold applies to the mean fullness of the recently committed
+// blocks.
 var gasPriceEstimationThreshold = 0.70
 
+// confidentSampleSize is the number of gas prices above which the sample size
+// no longer lowers the confidence of an estimation.
+const confidentSampleSize = 50
+
+// gasPriceEstimate is a gas price estimation along with how much it can be
+// trusted.
+type gasPriceEstimate struct {
+	gasPrice   float64
+	confidence float64
+	sampleSize uint64
+}
+
 // estimateGasPrice takes a transaction priority and estimates the gas price based
-// on the gas prices of the transactions in the mempool.
-// If the mempool transactions can't fill more than 70% of the block, the min gas
-// price is returned.
-func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPriority) (float64, error) {
+// on the gas prices of the transactions in the mempool and in the recently
+// committed blocks.
+// If neither the mempool transactions can fill more than 70% of the block nor
+// the recent blocks were more than 70% full on average, the min gas price is
+// returned.
+// If blobShares is not zero, the estimation is raised to the gas price needed
+// for a blob transaction using that many shares to fit in the next block and
+// to the blob base fee.
+func (s *gasEstimatorServer) estimateGasPrice(ctx context.Context, priority TxPriority, blobShares int) (gasPriceEstimate, error) {
+	mempool, err := s.mempoolSnapshot(ctx)
+	if err != nil {
+		return gasPriceEstimate{}, err
+	}
+	estimate, err := blendGasPriceEstimates(priority, mempool.gasPrices, s.blockGasPrices.Window())
+	if err != nil {
+		return gasPriceEstimate{}, err
+	}
+	if blobShares == 0 {
+		return estimate, nil
+	}
+	blobGasPrice, ok, err := estimateBlobGasPrice(s.txDecoder, mempool.txs, maxSquareSizeFromBytes(mempool.govMaxSquareBytes), blobShares)
+	if err != nil {
+		return gasPriceEstimate{}, err
+	}
+	if ok {
+		estimate.gasPrice = max(estimate.gasPrice, blobGasPrice)
+	}
+	if s.blobBaseFeeFn != nil {
+		blobBaseFee, err := s.blobBaseFeeFn()
+		if err != nil {
+			return gasPriceEstimate{}, err
+		}
+		estimate.gasPrice = max(estimate.gasPrice, blobBaseFee)
+	}
+	return estimate, nil
+}
+
+// mempoolSnapshot is the state of the mempool used to estimate the gas price.
+type mempoolSnapshot struct {
+	txs               []types.Tx
+	totalBytes        int64
+	govMaxSquareBytes uint64
+	// gasPrices are the sorted gas prices of the mempool transactions. They
+	// are only set if the mempool transactions can fill more than
+	// gasPriceEstimationThreshold of the block.
+	gasPrices []float64
+}
+
+// fullness returns the size of the mempool transactions divided by the max
+// square size in bytes.
+func (m mempoolSnapshot) fullness() float64 {
+	if m.govMaxSquareBytes == 0 {
+		return 0
+	}
+	return float64(m.totalBytes) / float64(m.govMaxSquareBytes)
+}
+
+func (s *gasEstimatorServer) mempoolSnapshot(ctx context.Context) (mempoolSnapshot, error) {
 	// Use -1 to query all the unconfirmed transactions.
 	limit := -1
 	txsResp, err := s.mempoolClient.UnconfirmedTxs(ctx, &limit)
 	if err != nil {
-		return 0, err
+		return mempoolSnapshot{}, err
 	}
 	govMaxSquareBytes, err := s.govMaxSquareBytesFn()
 	if err != nil {
-		return 0, err
+		return mempoolSnapshot{}, err
 	}
-	if float64(txsResp.TotalBytes) < float64(govMaxSquareBytes)*gasPriceEstimationThreshold {
-		return appconsts.DefaultMinGasPrice, nil
+	mempool := mempoolSnapshot{
+		txs:               txsResp.Txs,
+		totalBytes:        txsResp.TotalBytes,
+		govMaxSquareBytes: govMaxSquareBytes,
 	}
-	gasPrices, err := SortAndExtractGasPrices(s.txDecoder, txsResp.Txs, int64(appconsts.DefaultUpperBoundMaxBytes))
-	if err != nil {
-		return 0, err
+	if float64(txsResp.TotalBytes) >= float64(govMaxSquareBytes)*gasPriceEstimationThreshold {
+		mempool.gasPrices, err = SortAndExtractGasPrices(s.txDecoder, txsResp.Txs, int64(appconsts.DefaultUpperBoundMaxBytes))
+		if err != nil {
+			return mempoolSnapshot{}, err
+		}
+	}
+	return mempool, nil
+}
+
+// blendGasPriceEstimates combines the estimation based on the mempool gas
+// prices with the one based on the recently committed blocks. The mempool gas
+// prices are only provided when the mempool is congested and the blocks are
+// only taken into account when they were congested on average. If both are
+// congested, the highest estimation is returned so that the transaction is
+// competitive in both. If neither is, the min gas price is returned.
+func blendGasPriceEstimates(priority TxPriority, mempoolGasPrices []float64, window BlockWindow) (gasPriceEstimate, error) {
+	estimate := gasPriceEstimate{}
+	if len(mempoolGasPrices) > 0 {
+		gasPrice, err := estimateGasPriceForTransactions(mempoolGasPrices, priority)
+		if err != nil {
+			return gasPriceEstimate{}, err
+		}
+		estimate.gasPrice = gasPrice
+		estimate.sampleSize += uint64(len(mempoolGasPrices))
+	}
+	if window.Fullness >= gasPriceEstimationThreshold && len(window.GasPrices) > 0 {
+		gasPrice, err := estimateGasPriceForTransactions(window.GasPrices, priority)
+		if err != nil {
+			return gasPriceEstimate{}, err
+		}
+		estimate.gasPrice = max(estimate.gasPrice, gasPrice)
+		estimate.sampleSize += uint64(len(window.GasPrices))
+	}
+	if estimate.sampleSize == 0 {
+		estimate.gasPrice = appconsts.DefaultMinGasPrice
+	}
+	estimate.confidence = estimationConfidence(window, estimate.sampleSize)
+	return estimate, nil
+}
+
+// estimationConfidence returns a value between 0 and 1 describing how much an
+// estimation can be trusted. It is the share of the block window that has been
+// observed, reduced when the estimation is based on fewer than
+// confidentSampleSize gas prices.
+func estimationConfidence(window BlockWindow, sampleSize uint64) float64 {
+	if window.Size == 0 {
+		return 0
+	}
+	confidence := float64(window.Blocks) / float64(window.Size)
+	if sampleSize > 0 {
+		confidence *= min(1, float64(sampleSize)/confidentSampleSize)
 	}
-	return estimateGasPriceForTransactions(gasPrices, priority)
+	return confidence
 }
 
 const (
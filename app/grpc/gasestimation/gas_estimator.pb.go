@@ -78,6 +78,11 @@ func (TxPriority) EnumDescriptor() ([]byte, []int) {
 // Takes a priority enum to define the priority level.
 type EstimateGasPriceRequest struct {
 	TxPriority TxPriority `protobuf:"varint,1,opt,name=tx_priority,json=txPriority,proto3,enum=celestia.core.v1.gas_estimation.TxPriority" json:"tx_priority,omitempty"`
+	// blob_sizes are the sizes in bytes of the blobs of the transaction the gas
+	// price is estimated for, if any. If set, the estimated gas price is high
+	// enough for the blobs to fit in the next block given the transactions in
+	// the mempool.
+	BlobSizes []uint32 `protobuf:"varint,2,rep,packed,name=blob_sizes,json=blobSizes,proto3" json:"blob_sizes,omitempty"`
 }
 
 func (m *EstimateGasPriceRequest) Reset()         { *m = EstimateGasPriceRequest{} }
@@ -120,9 +125,24 @@ func (m *EstimateGasPriceRequest) GetTxPriority() TxPriority {
 	return TxPriority_TX_PRIORITY_UNSPECIFIED
 }
 
+func (m *EstimateGasPriceRequest) GetBlobSizes() []uint32 {
+	if m != nil {
+		return m.BlobSizes
+	}
+	return nil
+}
+
 // EstimateGasPriceResponse the response of the gas price estimation.
 type EstimateGasPriceResponse struct {
 	EstimatedGasPrice float64 `protobuf:"fixed64,1,opt,name=estimated_gas_price,json=estimatedGasPrice,proto3" json:"estimated_gas_price,omitempty"`
+	// confidence is a value between 0 and 1 that grows with the number of
+	// recently committed blocks observed by the node and with the number of gas
+	// prices the estimation is based on.
+	Confidence float64 `protobuf:"fixed64,2,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// sample_size is the number of gas prices, from the mempool and from
+	// recently committed blocks, the estimation is based on. Zero means the
+	// network min gas price was returned.
+	SampleSize uint64 `protobuf:"varint,3,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
 }
 
 func (m *EstimateGasPriceResponse) Reset()         { *m = EstimateGasPriceResponse{} }
@@ -165,11 +185,30 @@ func (m *EstimateGasPriceResponse) GetEstimatedGasPrice() float64 {
 	return 0
 }
 
+func (m *EstimateGasPriceResponse) GetConfidence() float64 {
+	if m != nil {
+		return m.Confidence
+	}
+	return 0
+}
+
+func (m *EstimateGasPriceResponse) GetSampleSize() uint64 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
 // EstimateGasPriceAndUsageRequest the request to estimate the gas price of the
 // network and also the gas used for the provided transaction.
 type EstimateGasPriceAndUsageRequest struct {
 	TxPriority TxPriority `protobuf:"varint,1,opt,name=tx_priority,json=txPriority,proto3,enum=celestia.core.v1.gas_estimation.TxPriority" json:"tx_priority,omitempty"`
 	TxBytes    []byte     `protobuf:"bytes,2,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// blob_sizes are the sizes in bytes of the blobs the transaction pays for.
+	// If set, the estimated gas price is high enough for the blobs to fit in the
+	// next block given the transactions in the mempool. If empty and tx_bytes is
+	// a blob transaction, the sizes of its blobs are used.
+	BlobSizes []uint32 `protobuf:"varint,3,rep,packed,name=blob_sizes,json=blobSizes,proto3" json:"blob_sizes,omitempty"`
 }
 
 func (m *EstimateGasPriceAndUsageRequest) Reset()         { *m = EstimateGasPriceAndUsageRequest{} }
@@ -219,11 +258,26 @@ func (m *EstimateGasPriceAndUsageRequest) GetTxBytes() []byte {
 	return nil
 }
 
+func (m *EstimateGasPriceAndUsageRequest) GetBlobSizes() []uint32 {
+	if m != nil {
+		return m.BlobSizes
+	}
+	return nil
+}
+
 // EstimateGasPriceAndUsageResponse the response of the gas price and used
 // estimation.
 type EstimateGasPriceAndUsageResponse struct {
 	EstimatedGasPrice float64 `protobuf:"fixed64,1,opt,name=estimated_gas_price,json=estimatedGasPrice,proto3" json:"estimated_gas_price,omitempty"`
 	EstimatedGasUsed  uint64  `protobuf:"varint,2,opt,name=estimated_gas_used,json=estimatedGasUsed,proto3" json:"estimated_gas_used,omitempty"`
+	// confidence is a value between 0 and 1 that grows with the number of
+	// recently committed blocks observed by the node and with the number of gas
+	// prices the estimation is based on.
+	Confidence float64 `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	// sample_size is the number of gas prices, from the mempool and from
+	// recently committed blocks, the estimation is based on. Zero means the
+	// network min gas price was returned.
+	SampleSize uint64 `protobuf:"varint,4,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
 }
 
 func (m *EstimateGasPriceAndUsageResponse) Reset()         { *m = EstimateGasPriceAndUsageResponse{} }
@@ -273,12 +327,212 @@ func (m *EstimateGasPriceAndUsageResponse) GetEstimatedGasUsed() uint64 {
 	return 0
 }
 
+func (m *EstimateGasPriceAndUsageResponse) GetConfidence() float64 {
+	if m != nil {
+		return m.Confidence
+	}
+	return 0
+}
+
+func (m *EstimateGasPriceAndUsageResponse) GetSampleSize() uint64 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+// GasPriceFeedRequest the request to subscribe to the gas price feed.
+type GasPriceFeedRequest struct {
+	// change_threshold is the relative change of an estimated gas price, e.g.
+	// 0.1 for 10%, that triggers an update between blocks. Zero only sends
+	// updates when a block is committed.
+	ChangeThreshold float64 `protobuf:"fixed64,1,opt,name=change_threshold,json=changeThreshold,proto3" json:"change_threshold,omitempty"`
+}
+
+func (m *GasPriceFeedRequest) Reset()         { *m = GasPriceFeedRequest{} }
+func (m *GasPriceFeedRequest) String() string { return proto.CompactTextString(m) }
+func (*GasPriceFeedRequest) ProtoMessage()    {}
+func (*GasPriceFeedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{4}
+}
+func (m *GasPriceFeedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceFeedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceFeedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceFeedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceFeedRequest.Merge(m, src)
+}
+func (m *GasPriceFeedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceFeedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceFeedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceFeedRequest proto.InternalMessageInfo
+
+func (m *GasPriceFeedRequest) GetChangeThreshold() float64 {
+	if m != nil {
+		return m.ChangeThreshold
+	}
+	return 0
+}
+
+// GasPriceEstimation the gas price estimation for a priority level.
+type GasPriceEstimation struct {
+	TxPriority        TxPriority `protobuf:"varint,1,opt,name=tx_priority,json=txPriority,proto3,enum=celestia.core.v1.gas_estimation.TxPriority" json:"tx_priority,omitempty"`
+	EstimatedGasPrice float64    `protobuf:"fixed64,2,opt,name=estimated_gas_price,json=estimatedGasPrice,proto3" json:"estimated_gas_price,omitempty"`
+	Confidence        float64    `protobuf:"fixed64,3,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	SampleSize        uint64     `protobuf:"varint,4,opt,name=sample_size,json=sampleSize,proto3" json:"sample_size,omitempty"`
+}
+
+func (m *GasPriceEstimation) Reset()         { *m = GasPriceEstimation{} }
+func (m *GasPriceEstimation) String() string { return proto.CompactTextString(m) }
+func (*GasPriceEstimation) ProtoMessage()    {}
+func (*GasPriceEstimation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{5}
+}
+func (m *GasPriceEstimation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceEstimation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceEstimation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceEstimation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceEstimation.Merge(m, src)
+}
+func (m *GasPriceEstimation) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceEstimation) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceEstimation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceEstimation proto.InternalMessageInfo
+
+func (m *GasPriceEstimation) GetTxPriority() TxPriority {
+	if m != nil {
+		return m.TxPriority
+	}
+	return TxPriority_TX_PRIORITY_UNSPECIFIED
+}
+
+func (m *GasPriceEstimation) GetEstimatedGasPrice() float64 {
+	if m != nil {
+		return m.EstimatedGasPrice
+	}
+	return 0
+}
+
+func (m *GasPriceEstimation) GetConfidence() float64 {
+	if m != nil {
+		return m.Confidence
+	}
+	return 0
+}
+
+func (m *GasPriceEstimation) GetSampleSize() uint64 {
+	if m != nil {
+		return m.SampleSize
+	}
+	return 0
+}
+
+// GasPriceFeedResponse an update of the gas price feed.
+type GasPriceFeedResponse struct {
+	// height is the height of the last block committed when the update was
+	// computed.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// estimations are the estimations for the low, medium and high priorities.
+	Estimations []*GasPriceEstimation `protobuf:"bytes,2,rep,name=estimations,proto3" json:"estimations,omitempty"`
+	// mempool_fullness is the size of the mempool transactions divided by the
+	// max square size in bytes.
+	MempoolFullness float64 `protobuf:"fixed64,3,opt,name=mempool_fullness,json=mempoolFullness,proto3" json:"mempool_fullness,omitempty"`
+}
+
+func (m *GasPriceFeedResponse) Reset()         { *m = GasPriceFeedResponse{} }
+func (m *GasPriceFeedResponse) String() string { return proto.CompactTextString(m) }
+func (*GasPriceFeedResponse) ProtoMessage()    {}
+func (*GasPriceFeedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67d02876d749b9cc, []int{6}
+}
+func (m *GasPriceFeedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceFeedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceFeedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceFeedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceFeedResponse.Merge(m, src)
+}
+func (m *GasPriceFeedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceFeedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceFeedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceFeedResponse proto.InternalMessageInfo
+
+func (m *GasPriceFeedResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GasPriceFeedResponse) GetEstimations() []*GasPriceEstimation {
+	if m != nil {
+		return m.Estimations
+	}
+	return nil
+}
+
+func (m *GasPriceFeedResponse) GetMempoolFullness() float64 {
+	if m != nil {
+		return m.MempoolFullness
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("celestia.core.v1.gas_estimation.TxPriority", TxPriority_name, TxPriority_value)
 	proto.RegisterType((*EstimateGasPriceRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceRequest")
 	proto.RegisterType((*EstimateGasPriceResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceResponse")
 	proto.RegisterType((*EstimateGasPriceAndUsageRequest)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageRequest")
 	proto.RegisterType((*EstimateGasPriceAndUsageResponse)(nil), "celestia.core.v1.gas_estimation.EstimateGasPriceAndUsageResponse")
+	proto.RegisterType((*GasPriceFeedRequest)(nil), "celestia.core.v1.gas_estimation.GasPriceFeedRequest")
+	proto.RegisterType((*GasPriceEstimation)(nil), "celestia.core.v1.gas_estimation.GasPriceEstimation")
+	proto.RegisterType((*GasPriceFeedResponse)(nil), "celestia.core.v1.gas_estimation.GasPriceFeedResponse")
 }
 
 func init() {
@@ -286,35 +540,49 @@ func init() {
 }
 
 var fileDescriptor_67d02876d749b9cc = []byte{
-	// 444 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0x4e, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xce, 0x2f, 0x4a, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0x2c,
-	0x8e, 0x07, 0x89, 0xe4, 0x26, 0x96, 0x64, 0xe6, 0xe7, 0x21, 0x73, 0xf3, 0x8b, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xe4, 0x61, 0x9a, 0xf4, 0x40, 0x9a, 0xf4, 0xca, 0x0c, 0xf5, 0x50, 0x35,
-	0x29, 0xa5, 0x73, 0x89, 0xbb, 0x42, 0x78, 0xa9, 0xee, 0x89, 0xc5, 0x01, 0x45, 0x99, 0xc9, 0xa9,
-	0x41, 0xa9, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x3e, 0x5c, 0xdc, 0x25, 0x15, 0xf1, 0x05, 0x45,
-	0x99, 0xf9, 0x45, 0x99, 0x25, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x7c, 0x46, 0xda, 0x7a, 0x04,
-	0x4c, 0xd4, 0x0b, 0xa9, 0x08, 0x80, 0x6a, 0x09, 0xe2, 0x2a, 0x81, 0xb3, 0x95, 0xbc, 0xb8, 0x24,
-	0x30, 0x2d, 0x2a, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0xd2, 0xe3, 0x12, 0x86, 0x1a, 0x90, 0x9a,
-	0x12, 0x0f, 0x32, 0xae, 0x00, 0x24, 0x0d, 0xb6, 0x91, 0x31, 0x48, 0x10, 0x2e, 0x05, 0xd3, 0xa7,
-	0xd4, 0xc5, 0xc8, 0x25, 0x8f, 0x6e, 0x98, 0x63, 0x5e, 0x4a, 0x68, 0x71, 0x62, 0x3a, 0x6d, 0x5c,
-	0x2f, 0x24, 0xc9, 0xc5, 0x51, 0x52, 0x11, 0x9f, 0x54, 0x59, 0x92, 0x5a, 0x2c, 0xc1, 0xa4, 0xc0,
-	0xa8, 0xc1, 0x13, 0xc4, 0x5e, 0x52, 0xe1, 0x04, 0xe2, 0x2a, 0x35, 0x30, 0x72, 0x29, 0xe0, 0x76,
-	0x0c, 0x79, 0x3e, 0x14, 0xd2, 0xe1, 0x12, 0x42, 0x55, 0x5f, 0x5a, 0x9c, 0x9a, 0x02, 0xb6, 0x99,
-	0x25, 0x48, 0x00, 0x59, 0x79, 0x68, 0x71, 0x6a, 0x8a, 0x56, 0x0e, 0x17, 0x17, 0xc2, 0xdd, 0x42,
-	0xd2, 0x5c, 0xe2, 0x21, 0x11, 0xf1, 0x01, 0x41, 0x9e, 0xfe, 0x41, 0x9e, 0x21, 0x91, 0xf1, 0xa1,
-	0x7e, 0xc1, 0x01, 0xae, 0xce, 0x9e, 0x6e, 0x9e, 0xae, 0x2e, 0x02, 0x0c, 0x42, 0xc2, 0x5c, 0xfc,
-	0xc8, 0x92, 0x3e, 0xfe, 0xe1, 0x02, 0x8c, 0x42, 0x62, 0x5c, 0x42, 0xc8, 0x82, 0xbe, 0xae, 0x2e,
-	0x9e, 0xa1, 0xbe, 0x02, 0x4c, 0x42, 0x22, 0x5c, 0x02, 0xc8, 0xe2, 0x1e, 0x9e, 0xee, 0x1e, 0x02,
-	0xcc, 0x46, 0xfb, 0x98, 0xb8, 0x78, 0xdc, 0x13, 0x8b, 0x5d, 0x61, 0x49, 0x4d, 0xa8, 0x93, 0x91,
-	0x4b, 0x00, 0x3d, 0x04, 0x84, 0x2c, 0x08, 0x06, 0x35, 0x8e, 0x74, 0x27, 0x65, 0x49, 0x86, 0x4e,
-	0x48, 0x30, 0x2b, 0x31, 0x08, 0x2d, 0x64, 0xc4, 0x4c, 0x67, 0xb0, 0xd8, 0x10, 0x72, 0x20, 0xd9,
-	0x64, 0xb4, 0x54, 0x25, 0xe5, 0x48, 0x81, 0x09, 0x30, 0x37, 0x3a, 0x85, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x55, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xcc, 0xa2, 0xfc, 0xa2, 0x74, 0x38, 0x5b, 0x37, 0xb1, 0xa0, 0x40, 0x1f, 0x84,
-	0xd3, 0x8b, 0x0a, 0x92, 0x41, 0x19, 0x1e, 0x61, 0x71, 0x12, 0x1b, 0x38, 0xc7, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x47, 0x63, 0x14, 0xb9, 0x28, 0x04, 0x00, 0x00,
+	// 658 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0x4f, 0xd4, 0x4e,
+	0x18, 0xc7, 0x77, 0xb6, 0x84, 0xdf, 0xcf, 0x67, 0x51, 0xea, 0x40, 0x60, 0xc5, 0x58, 0x36, 0x7b,
+	0x5a, 0xff, 0x75, 0x05, 0x34, 0x51, 0x4f, 0x80, 0x2c, 0xb0, 0x09, 0xc8, 0xa6, 0xec, 0xc6, 0x3f,
+	0x97, 0xa6, 0xdb, 0x0e, 0x6d, 0x93, 0x6e, 0xa7, 0x76, 0x66, 0xc9, 0xe2, 0xc5, 0x9b, 0x89, 0xf1,
+	0xe2, 0x5b, 0xf0, 0xe8, 0xcd, 0x77, 0x60, 0xbc, 0x79, 0x24, 0xf1, 0xe2, 0xd1, 0xc0, 0x1b, 0x31,
+	0xd3, 0x6d, 0x97, 0x52, 0x24, 0x0b, 0xa8, 0x87, 0x26, 0x9d, 0xef, 0xcc, 0xf3, 0x3c, 0xdf, 0xf9,
+	0xcc, 0x3f, 0x58, 0x30, 0x89, 0x47, 0x18, 0x77, 0x8d, 0xaa, 0x49, 0x43, 0x52, 0xdd, 0x9d, 0xab,
+	0xda, 0x06, 0xd3, 0x85, 0xd2, 0x31, 0xb8, 0x4b, 0xfd, 0x74, 0x93, 0x86, 0x6a, 0x10, 0x52, 0x4e,
+	0xf1, 0x6c, 0x12, 0xa4, 0x8a, 0x20, 0x75, 0x77, 0x4e, 0x3d, 0x1e, 0x54, 0x7e, 0x8b, 0x60, 0xba,
+	0xd6, 0x6f, 0x92, 0x35, 0x83, 0x35, 0x42, 0xd7, 0x24, 0x1a, 0x79, 0xd5, 0x25, 0x8c, 0xe3, 0x0d,
+	0x28, 0xf0, 0x9e, 0x1e, 0x84, 0x2e, 0x0d, 0x5d, 0xbe, 0x57, 0x44, 0x25, 0x54, 0xb9, 0x32, 0x7f,
+	0x5b, 0x1d, 0x92, 0x52, 0x6d, 0xf6, 0x1a, 0x71, 0x88, 0x06, 0x7c, 0xf0, 0x8f, 0x6f, 0x00, 0xb4,
+	0x3d, 0xda, 0xd6, 0x99, 0xfb, 0x9a, 0xb0, 0x62, 0xbe, 0x24, 0x55, 0x2e, 0x6b, 0x97, 0x84, 0xb2,
+	0x2d, 0x84, 0xf2, 0x7b, 0x04, 0xc5, 0x93, 0x46, 0x58, 0x40, 0x7d, 0x46, 0xb0, 0x0a, 0x13, 0x71,
+	0x01, 0x62, 0xe9, 0xa2, 0x5c, 0x20, 0xba, 0x23, 0x47, 0x48, 0xbb, 0x3a, 0xe8, 0x4a, 0xe2, 0xb0,
+	0x02, 0x60, 0x52, 0x7f, 0xc7, 0xb5, 0x88, 0x6f, 0x92, 0x62, 0x3e, 0x1a, 0x96, 0x52, 0xf0, 0x2c,
+	0x14, 0x98, 0xd1, 0x09, 0x3c, 0x12, 0xb9, 0x29, 0x4a, 0x25, 0x54, 0x19, 0xd1, 0xa0, 0x2f, 0x09,
+	0x3b, 0xe5, 0x4f, 0x08, 0x66, 0xb3, 0x6e, 0x96, 0x7c, 0xab, 0xc5, 0x0c, 0xfb, 0x1f, 0xe1, 0xb9,
+	0x06, 0xff, 0xf3, 0x9e, 0xde, 0xde, 0xe3, 0x11, 0x1c, 0x54, 0x19, 0xd3, 0xfe, 0xe3, 0xbd, 0x65,
+	0xd1, 0xcc, 0x90, 0x93, 0xb2, 0xe4, 0xbe, 0x22, 0x28, 0x9d, 0xee, 0xf5, 0x82, 0x04, 0xef, 0x00,
+	0x3e, 0x3e, 0xbe, 0xcb, 0x88, 0x15, 0x19, 0x1b, 0xd1, 0xe4, 0xf4, 0xf0, 0x16, 0x23, 0x56, 0x86,
+	0xb7, 0x34, 0x8c, 0xf7, 0xc8, 0x09, 0xde, 0x8b, 0x30, 0x91, 0x94, 0x5e, 0x25, 0xc4, 0x4a, 0x10,
+	0xdf, 0x04, 0xd9, 0x74, 0x0c, 0xdf, 0x26, 0x3a, 0x77, 0x42, 0xc2, 0x1c, 0xea, 0x59, 0xb1, 0xe5,
+	0xf1, 0xbe, 0xde, 0x4c, 0xe4, 0xf2, 0x77, 0x04, 0x38, 0x49, 0x51, 0x1b, 0xd0, 0xfe, 0xcb, 0x8b,
+	0x74, 0x0a, 0xc5, 0xfc, 0xd9, 0xf6, 0xe1, 0x05, 0xb8, 0x7c, 0x46, 0x30, 0x79, 0x1c, 0x4c, 0xbc,
+	0x9e, 0x53, 0x30, 0xea, 0x10, 0xd7, 0x76, 0x78, 0x34, 0x25, 0x49, 0x8b, 0x5b, 0xb8, 0x05, 0x85,
+	0xa3, 0x69, 0xf4, 0x8f, 0x59, 0x61, 0x7e, 0x61, 0xe8, 0x7c, 0x4f, 0x92, 0xd3, 0xd2, 0x79, 0xc4,
+	0x42, 0x74, 0x48, 0x27, 0xa0, 0xd4, 0xd3, 0x77, 0xba, 0x9e, 0xe7, 0x13, 0xc6, 0xe2, 0xe9, 0x8c,
+	0xc7, 0xfa, 0x6a, 0x2c, 0xdf, 0xf2, 0x00, 0x8e, 0xe8, 0xe1, 0xeb, 0x30, 0xdd, 0x7c, 0xae, 0x37,
+	0xb4, 0xfa, 0x96, 0x56, 0x6f, 0xbe, 0xd0, 0x5b, 0x4f, 0xb7, 0x1b, 0xb5, 0x27, 0xf5, 0xd5, 0x7a,
+	0x6d, 0x45, 0xce, 0xe1, 0x09, 0x18, 0x4f, 0x77, 0x6e, 0x6c, 0x3d, 0x93, 0x11, 0x9e, 0x02, 0x9c,
+	0x16, 0x37, 0x6b, 0x2b, 0xf5, 0xd6, 0xa6, 0x9c, 0xc7, 0x93, 0x20, 0xa7, 0xf5, 0xf5, 0xfa, 0xda,
+	0xba, 0x2c, 0xcd, 0x7f, 0x91, 0x60, 0x6c, 0xcd, 0x60, 0xb5, 0xe4, 0xde, 0xc3, 0xef, 0x10, 0xc8,
+	0xd9, 0xd3, 0x80, 0x1f, 0x0e, 0x05, 0x70, 0xca, 0x1d, 0x38, 0xf3, 0xe8, 0x02, 0x91, 0xfd, 0x25,
+	0x2a, 0xe7, 0xf0, 0xc7, 0xdf, 0xdc, 0x69, 0xc9, 0xc9, 0xc4, 0x8b, 0xe7, 0xce, 0x9c, 0xb9, 0x80,
+	0x66, 0x96, 0xfe, 0x20, 0xc3, 0xc0, 0xe3, 0x1b, 0x18, 0x4b, 0x7a, 0xc5, 0x06, 0xc3, 0xf7, 0xcf,
+	0xbc, 0x57, 0x52, 0x07, 0x75, 0xe6, 0xc1, 0x39, 0xa3, 0x92, 0xf2, 0xf7, 0xd0, 0x72, 0xf3, 0xdb,
+	0x81, 0x82, 0xf6, 0x0f, 0x14, 0xf4, 0xf3, 0x40, 0x41, 0x1f, 0x0e, 0x95, 0xdc, 0xfe, 0xa1, 0x92,
+	0xfb, 0x71, 0xa8, 0xe4, 0x5e, 0x3e, 0xb6, 0x5d, 0xee, 0x74, 0xdb, 0xaa, 0x49, 0x3b, 0xd5, 0x24,
+	0x3d, 0x0d, 0xed, 0xc1, 0xff, 0x5d, 0x23, 0x08, 0xaa, 0xe2, 0xb3, 0xc3, 0xc0, 0x14, 0xcf, 0xdf,
+	0x51, 0xb9, 0xf6, 0x68, 0xf4, 0xfe, 0x2d, 0xfc, 0x1a, 0x00, 0xba, 0x2f, 0xa9, 0x32, 0x36, 0x07,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -330,9 +598,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type GasEstimatorClient interface {
 	// estimateGasPrice takes a transaction priority and estimates the gas price
-	// based on the gas prices of the transactions in the last five blocks. If no
-	// transaction is found in the last five blocks, return the network min gas
-	// price. It's up to the light client to set the gas price in this case to the
+	// based on the gas prices of the transactions in the mempool and in the last
+	// five blocks. If neither the mempool nor the last five blocks are congested,
+	// return the network min gas price. It's up to the light client to set the gas price in this case to the
 	// minimum gas price set by that node.
 	EstimateGasPrice(ctx context.Context, in *EstimateGasPriceRequest, opts ...grpc.CallOption) (*EstimateGasPriceResponse, error)
 	// EstimateGasPriceAndUsage takes a transaction priority and a transaction
@@ -343,6 +611,11 @@ type GasEstimatorClient interface {
 	// gas price in this case to the minimum gas price set by that node. The gas
 	// used is estimated using the state machine simulation.
 	EstimateGasPriceAndUsage(ctx context.Context, in *EstimateGasPriceAndUsageRequest, opts ...grpc.CallOption) (*EstimateGasPriceAndUsageResponse, error)
+	// GasPriceFeed streams the gas price estimations for the low, medium and
+	// high priorities along with the mempool fullness. An update is sent every
+	// time a block is committed and, between blocks, whenever an estimation
+	// changes by more than the requested threshold.
+	GasPriceFeed(ctx context.Context, in *GasPriceFeedRequest, opts ...grpc.CallOption) (GasEstimator_GasPriceFeedClient, error)
 }
 
 type gasEstimatorClient struct {
@@ -371,12 +644,44 @@ func (c *gasEstimatorClient) EstimateGasPriceAndUsage(ctx context.Context, in *E
 	return out, nil
 }
 
+func (c *gasEstimatorClient) GasPriceFeed(ctx context.Context, in *GasPriceFeedRequest, opts ...grpc.CallOption) (GasEstimator_GasPriceFeedClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GasEstimator_serviceDesc.Streams[0], "/celestia.core.v1.gas_estimation.GasEstimator/GasPriceFeed", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gasEstimatorGasPriceFeedClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GasEstimator_GasPriceFeedClient interface {
+	Recv() (*GasPriceFeedResponse, error)
+	grpc.ClientStream
+}
+
+type gasEstimatorGasPriceFeedClient struct {
+	grpc.ClientStream
+}
+
+func (x *gasEstimatorGasPriceFeedClient) Recv() (*GasPriceFeedResponse, error) {
+	m := new(GasPriceFeedResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GasEstimatorServer is the server API for GasEstimator service.
 type GasEstimatorServer interface {
 	// estimateGasPrice takes a transaction priority and estimates the gas price
-	// based on the gas prices of the transactions in the last five blocks. If no
-	// transaction is found in the last five blocks, return the network min gas
-	// price. It's up to the light client to set the gas price in this case to the
+	// based on the gas prices of the transactions in the mempool and in the last
+	// five blocks. If neither the mempool nor the last five blocks are congested,
+	// return the network min gas price. It's up to the light client to set the gas price in this case to the
 	// minimum gas price set by that node.
 	EstimateGasPrice(context.Context, *EstimateGasPriceRequest) (*EstimateGasPriceResponse, error)
 	// EstimateGasPriceAndUsage takes a transaction priority and a transaction
@@ -387,6 +692,11 @@ type GasEstimatorServer interface {
 	// gas price in this case to the minimum gas price set by that node. The gas
 	// used is estimated using the state machine simulation.
 	EstimateGasPriceAndUsage(context.Context, *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error)
+	// GasPriceFeed streams the gas price estimations for the low, medium and
+	// high priorities along with the mempool fullness. An update is sent every
+	// time a block is committed and, between blocks, whenever an estimation
+	// changes by more than the requested threshold.
+	GasPriceFeed(*GasPriceFeedRequest, GasEstimator_GasPriceFeedServer) error
 }
 
 // UnimplementedGasEstimatorServer can be embedded to have forward compatible implementations.
@@ -399,6 +709,9 @@ func (*UnimplementedGasEstimatorServer) EstimateGasPrice(ctx context.Context, re
 func (*UnimplementedGasEstimatorServer) EstimateGasPriceAndUsage(ctx context.Context, req *EstimateGasPriceAndUsageRequest) (*EstimateGasPriceAndUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EstimateGasPriceAndUsage not implemented")
 }
+func (*UnimplementedGasEstimatorServer) GasPriceFeed(req *GasPriceFeedRequest, srv GasEstimator_GasPriceFeedServer) error {
+	return status.Errorf(codes.Unimplemented, "method GasPriceFeed not implemented")
+}
 
 func RegisterGasEstimatorServer(s grpc1.Server, srv GasEstimatorServer) {
 	s.RegisterService(&_GasEstimator_serviceDesc, srv)
@@ -440,6 +753,27 @@ func _GasEstimator_EstimateGasPriceAndUsage_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GasEstimator_GasPriceFeed_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GasPriceFeedRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GasEstimatorServer).GasPriceFeed(m, &gasEstimatorGasPriceFeedServer{stream})
+}
+
+type GasEstimator_GasPriceFeedServer interface {
+	Send(*GasPriceFeedResponse) error
+	grpc.ServerStream
+}
+
+type gasEstimatorGasPriceFeedServer struct {
+	grpc.ServerStream
+}
+
+func (x *gasEstimatorGasPriceFeedServer) Send(m *GasPriceFeedResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var GasEstimator_serviceDesc = _GasEstimator_serviceDesc
 var _GasEstimator_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.core.v1.gas_estimation.GasEstimator",
@@ -454,7 +788,13 @@ var _GasEstimator_serviceDesc = grpc.ServiceDesc{
 			Handler:    _GasEstimator_EstimateGasPriceAndUsage_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GasPriceFeed",
+			Handler:       _GasEstimator_GasPriceFeed_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "celestia/core/v1/gas_estimation/gas_estimator.proto",
 }
 
@@ -478,6 +818,24 @@ func (m *EstimateGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlobSizes) > 0 {
+		dAtA2 := make([]byte, len(m.BlobSizes)*10)
+		var j1 int
+		for _, num := range m.BlobSizes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGasEstimator(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.TxPriority != 0 {
 		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
 		i--
@@ -506,6 +864,17 @@ func (m *EstimateGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.SampleSize != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.SampleSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Confidence != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Confidence))))
+		i--
+		dAtA[i] = 0x11
+	}
 	if m.EstimatedGasPrice != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
@@ -535,6 +904,24 @@ func (m *EstimateGasPriceAndUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlobSizes) > 0 {
+		dAtA4 := make([]byte, len(m.BlobSizes)*10)
+		var j3 int
+		for _, num := range m.BlobSizes {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintGasEstimator(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.TxBytes) > 0 {
 		i -= len(m.TxBytes)
 		copy(dAtA[i:], m.TxBytes)
@@ -570,6 +957,17 @@ func (m *EstimateGasPriceAndUsageResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
+	if m.SampleSize != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.SampleSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Confidence != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Confidence))))
+		i--
+		dAtA[i] = 0x19
+	}
 	if m.EstimatedGasUsed != 0 {
 		i = encodeVarintGasEstimator(dAtA, i, uint64(m.EstimatedGasUsed))
 		i--
@@ -584,69 +982,271 @@ func (m *EstimateGasPriceAndUsageResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGasEstimator(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GasPriceFeedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EstimateGasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TxPriority != 0 {
-		n += 1 + sovGasEstimator(uint64(m.TxPriority))
-	}
-	return n
+
+func (m *GasPriceFeedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GasPriceFeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EstimatedGasPrice != 0 {
-		n += 9
+	if m.ChangeThreshold != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.ChangeThreshold))))
+		i--
+		dAtA[i] = 0x9
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateGasPriceAndUsageRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TxPriority != 0 {
-		n += 1 + sovGasEstimator(uint64(m.TxPriority))
-	}
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovGasEstimator(uint64(l))
+func (m *GasPriceEstimation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EstimateGasPriceAndUsageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GasPriceEstimation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceEstimation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EstimatedGasPrice != 0 {
-		n += 9
+	if m.SampleSize != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.SampleSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Confidence != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Confidence))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if m.EstimatedGasPrice != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.EstimatedGasPrice))))
+		i--
+		dAtA[i] = 0x11
+	}
+	if m.TxPriority != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.TxPriority))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceFeedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceFeedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceFeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MempoolFullness != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MempoolFullness))))
+		i--
+		dAtA[i] = 0x19
+	}
+	if len(m.Estimations) > 0 {
+		for iNdEx := len(m.Estimations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Estimations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGasEstimator(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintGasEstimator(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGasEstimator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGasEstimator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EstimateGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	if len(m.BlobSizes) > 0 {
+		l = 0
+		for _, e := range m.BlobSizes {
+			l += sovGasEstimator(uint64(e))
+		}
+		n += 1 + sovGasEstimator(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EstimateGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	if m.Confidence != 0 {
+		n += 9
+	}
+	if m.SampleSize != 0 {
+		n += 1 + sovGasEstimator(uint64(m.SampleSize))
+	}
+	return n
+}
+
+func (m *EstimateGasPriceAndUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovGasEstimator(uint64(l))
+	}
+	if len(m.BlobSizes) > 0 {
+		l = 0
+		for _, e := range m.BlobSizes {
+			l += sovGasEstimator(uint64(e))
+		}
+		n += 1 + sovGasEstimator(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *EstimateGasPriceAndUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EstimatedGasPrice != 0 {
+		n += 9
 	}
 	if m.EstimatedGasUsed != 0 {
 		n += 1 + sovGasEstimator(uint64(m.EstimatedGasUsed))
 	}
+	if m.Confidence != 0 {
+		n += 9
+	}
+	if m.SampleSize != 0 {
+		n += 1 + sovGasEstimator(uint64(m.SampleSize))
+	}
+	return n
+}
+
+func (m *GasPriceFeedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ChangeThreshold != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *GasPriceEstimation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxPriority != 0 {
+		n += 1 + sovGasEstimator(uint64(m.TxPriority))
+	}
+	if m.EstimatedGasPrice != 0 {
+		n += 9
+	}
+	if m.Confidence != 0 {
+		n += 9
+	}
+	if m.SampleSize != 0 {
+		n += 1 + sovGasEstimator(uint64(m.SampleSize))
+	}
+	return n
+}
+
+func (m *GasPriceFeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGasEstimator(uint64(m.Height))
+	}
+	if len(m.Estimations) > 0 {
+		for _, e := range m.Estimations {
+			l = e.Size()
+			n += 1 + l + sovGasEstimator(uint64(l))
+		}
+	}
+	if m.MempoolFullness != 0 {
+		n += 9
+	}
 	return n
 }
 
@@ -704,6 +1304,82 @@ func (m *EstimateGasPriceRequest) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasEstimator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlobSizes = append(m.BlobSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasEstimator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasEstimator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasEstimator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlobSizes) == 0 {
+					m.BlobSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasEstimator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlobSizes = append(m.BlobSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -765,6 +1441,36 @@ func (m *EstimateGasPriceResponse) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Confidence = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleSize", wireType)
+			}
+			m.SampleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -868,6 +1574,82 @@ func (m *EstimateGasPriceAndUsageRequest) Unmarshal(dAtA []byte) error {
 				m.TxBytes = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasEstimator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BlobSizes = append(m.BlobSizes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGasEstimator
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGasEstimator
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGasEstimator
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BlobSizes) == 0 {
+					m.BlobSizes = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGasEstimator
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BlobSizes = append(m.BlobSizes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobSizes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -948,6 +1730,321 @@ func (m *EstimateGasPriceAndUsageResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Confidence = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleSize", wireType)
+			}
+			m.SampleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceFeedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceFeedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceFeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangeThreshold", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.ChangeThreshold = float64(math.Float64frombits(v))
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceEstimation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceEstimation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceEstimation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriority", wireType)
+			}
+			m.TxPriority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxPriority |= TxPriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedGasPrice", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.EstimatedGasPrice = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confidence", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Confidence = float64(math.Float64frombits(v))
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleSize", wireType)
+			}
+			m.SampleSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SampleSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasEstimator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceFeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasEstimator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceFeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceFeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasEstimator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasEstimator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Estimations = append(m.Estimations, &GasPriceEstimation{})
+			if err := m.Estimations[len(m.Estimations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MempoolFullness", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MempoolFullness = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGasEstimator(dAtA[iNdEx:])
@@ -0,0 +1,205 @@
+package gasestimation
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// feedPollInterval is how often the mempool is checked for gas price changes
+// between blocks.
+var feedPollInterval = time.Second
+
+// feedPriorities are the priorities of the estimations sent in the gas price
+// feed.
+var feedPriorities = []TxPriority{
+	TxPriority_TX_PRIORITY_LOW,
+	TxPriority_TX_PRIORITY_MEDIUM,
+	TxPriority_TX_PRIORITY_HIGH,
+}
+
+// GasPriceFeed streams the gas price estimations for every priority level and
+// the mempool fullness. An update is sent when a block is committed and, if
+// the request sets a change threshold, when an estimation changes by more than
+// that threshold between blocks. The updates are computed once and shared by
+// all the subscribers.
+func (s *gasEstimatorServer) GasPriceFeed(request *GasPriceFeedRequest, stream GasEstimator_GasPriceFeedServer) error {
+	if request == nil {
+		return status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if request.ChangeThreshold < 0 || math.IsNaN(request.ChangeThreshold) {
+		return status.Error(codes.InvalidArgument, "change threshold cannot be negative")
+	}
+
+	ctx := stream.Context()
+	unsubscribe := s.feed.subscribe()
+	defer unsubscribe()
+
+	var last *GasPriceFeedResponse
+	for {
+		update, updated, err := s.feed.latest()
+		if err != nil {
+			return err
+		}
+		if update != nil && shouldSendFeedUpdate(last, update, request.ChangeThreshold) {
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+			last = update
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-updated:
+		}
+	}
+}
+
+// gasPriceFeed computes the gas price feed updates when a block is committed
+// and every feedPollInterval in between, as long as there is at least one
+// subscriber, and shares them with all the subscribers.
+type gasPriceFeed struct {
+	compute  func(ctx context.Context) (*GasPriceFeedResponse, error)
+	newBlock func() <-chan struct{}
+
+	mtx         sync.Mutex
+	subscribers int
+	// cancel stops the computation of the updates. It is set while there are
+	// subscribers.
+	cancel context.CancelFunc
+	// update is the latest update and err the error computing it, if any.
+	update *GasPriceFeedResponse
+	err    error
+	// updated is closed when the latest update changes.
+	updated chan struct{}
+}
+
+func newGasPriceFeed(compute func(ctx context.Context) (*GasPriceFeedResponse, error), newBlock func() <-chan struct{}) *gasPriceFeed {
+	return &gasPriceFeed{
+		compute:  compute,
+		newBlock: newBlock,
+		updated:  make(chan struct{}),
+	}
+}
+
+// subscribe registers a subscriber, starting the computation of the updates
+// if it is the first one. The returned function must be called once the
+// subscriber is done.
+func (f *gasPriceFeed) subscribe() (unsubscribe func()) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	f.subscribers++
+	if f.subscribers == 1 {
+		ctx, cancel := context.WithCancel(context.Background())
+		f.cancel = cancel
+		go f.run(ctx)
+	}
+	return func() {
+		f.mtx.Lock()
+		defer f.mtx.Unlock()
+		f.subscribers--
+		if f.subscribers == 0 {
+			f.cancel()
+			f.cancel = nil
+			f.update, f.err = nil, nil
+		}
+	}
+}
+
+// latest returns the latest update, or the error computing it, along with a
+// channel that is closed once a newer one is available. The update is nil if
+// none has been computed yet.
+func (f *gasPriceFeed) latest() (*GasPriceFeedResponse, <-chan struct{}, error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	return f.update, f.updated, f.err
+}
+
+func (f *gasPriceFeed) run(ctx context.Context) {
+	ticker := time.NewTicker(feedPollInterval)
+	defer ticker.Stop()
+	for {
+		// get the channel before computing the update so that a block added
+		// in between isn't missed.
+		newBlock := f.newBlock()
+		update, err := f.compute(ctx)
+		f.publish(ctx, update, err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-newBlock:
+		case <-ticker.C:
+		}
+	}
+}
+
+func (f *gasPriceFeed) publish(ctx context.Context, update *GasPriceFeedResponse, err error) {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+	// the computation was stopped, possibly while the update was computed.
+	if ctx.Err() != nil {
+		return
+	}
+	f.update, f.err = update, err
+	close(f.updated)
+	f.updated = make(chan struct{})
+}
+
+// gasPriceFeedUpdate estimates the gas price of every priority level from a
+// single view of the mempool and of the recently committed blocks.
+func (s *gasEstimatorServer) gasPriceFeedUpdate(ctx context.Context) (*GasPriceFeedResponse, error) {
+	mempool, err := s.mempoolSnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	window := s.blockGasPrices.Window()
+	update := &GasPriceFeedResponse{
+		Height:          window.Height,
+		Estimations:     make([]*GasPriceEstimation, 0, len(feedPriorities)),
+		MempoolFullness: mempool.fullness(),
+	}
+	for _, priority := range feedPriorities {
+		estimate, err := blendGasPriceEstimates(priority, mempool.gasPrices, window)
+		if err != nil {
+			return nil, err
+		}
+		update.Estimations = append(update.Estimations, &GasPriceEstimation{
+			TxPriority:        priority,
+			EstimatedGasPrice: estimate.gasPrice,
+			Confidence:        estimate.confidence,
+			SampleSize:        estimate.sampleSize,
+		})
+	}
+	return update, nil
+}
+
+// shouldSendFeedUpdate returns true if update is the first one, follows a new
+// block or, if changeThreshold is not zero, if one of its estimated gas prices
+// changed by more than changeThreshold relative to the last update sent.
+func shouldSendFeedUpdate(last, update *GasPriceFeedResponse, changeThreshold float64) bool {
+	if last == nil || update.Height != last.Height {
+		return true
+	}
+	if changeThreshold == 0 || len(last.Estimations) != len(update.Estimations) {
+		return false
+	}
+	for i, estimation := range update.Estimations {
+		previous := last.Estimations[i].EstimatedGasPrice
+		if previous == 0 {
+			if estimation.EstimatedGasPrice != 0 {
+				return true
+			}
+			continue
+		}
+		if math.Abs(estimation.EstimatedGasPrice-previous)/previous > changeThreshold {
+			return true
+		}
+	}
+	return false
+}
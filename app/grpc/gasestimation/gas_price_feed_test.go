@@ -0,0 +1,161 @@
+package gasestimation
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	cmtclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+)
+
+type emptyMempool struct {
+	cmtclient.MempoolClient
+}
+
+func (emptyMempool) UnconfirmedTxs(context.Context, *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	return &coretypes.ResultUnconfirmedTxs{}, nil
+}
+
+type feedStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	updates chan *GasPriceFeedResponse
+}
+
+func (s feedStream) Context() context.Context { return s.ctx }
+
+func (s feedStream) Send(update *GasPriceFeedResponse) error {
+	s.updates <- update
+	return nil
+}
+
+func TestGasPriceFeed(t *testing.T) {
+	blocks := NewBlockGasPrices(DefaultBlockWindowSize)
+	server := NewGasEstimatorServer(
+		emptyMempool{},
+		feeTxDecoder,
+		func() (uint64, error) { return 64 * 64 * 512, nil },
+		nil,
+		nil,
+		blocks,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := feedStream{ctx: ctx, updates: make(chan *GasPriceFeedResponse)}
+	done := make(chan error)
+	go func() {
+		done <- server.GasPriceFeed(&GasPriceFeedRequest{}, stream)
+	}()
+
+	receive := func() *GasPriceFeedResponse {
+		select {
+		case update := <-stream.updates:
+			return update
+		case <-time.After(10 * time.Second):
+			t.Fatal("timed out waiting for a gas price feed update")
+			return nil
+		}
+	}
+
+	update := receive()
+	assert.Equal(t, int64(0), update.Height)
+	require.Len(t, update.Estimations, 3)
+	for i, priority := range []TxPriority{TxPriority_TX_PRIORITY_LOW, TxPriority_TX_PRIORITY_MEDIUM, TxPriority_TX_PRIORITY_HIGH} {
+		assert.Equal(t, priority, update.Estimations[i].TxPriority)
+		assert.Equal(t, appconsts.DefaultMinGasPrice, update.Estimations[i].EstimatedGasPrice)
+	}
+
+	blocks.AddBlock(1, []float64{0.1, 0.2, 0.3}, 0.9)
+	update = receive()
+	assert.Equal(t, int64(1), update.Height)
+	assert.InDelta(t, 0.2, update.Estimations[1].EstimatedGasPrice, 1e-9)
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+// countingMempool is an empty mempool that counts the queries made to it.
+type countingMempool struct {
+	emptyMempool
+	queries atomic.Int64
+}
+
+func (m *countingMempool) UnconfirmedTxs(ctx context.Context, limit *int) (*coretypes.ResultUnconfirmedTxs, error) {
+	m.queries.Add(1)
+	return m.emptyMempool.UnconfirmedTxs(ctx, limit)
+}
+
+func TestGasPriceFeedSharesUpdates(t *testing.T) {
+	// only compute updates when a block is committed
+	pollInterval := feedPollInterval
+	feedPollInterval = time.Hour
+	defer func() { feedPollInterval = pollInterval }()
+
+	mempool := &countingMempool{}
+	blocks := NewBlockGasPrices(DefaultBlockWindowSize)
+	server := NewGasEstimatorServer(
+		mempool,
+		feeTxDecoder,
+		func() (uint64, error) { return 64 * 64 * 512, nil },
+		nil,
+		nil,
+		blocks,
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	streams := []feedStream{
+		{ctx: ctx, updates: make(chan *GasPriceFeedResponse, 1)},
+		{ctx: ctx, updates: make(chan *GasPriceFeedResponse, 1)},
+	}
+	done := make(chan error, len(streams))
+	for _, stream := range streams {
+		go func() {
+			done <- server.GasPriceFeed(&GasPriceFeedRequest{}, stream)
+		}()
+	}
+
+	blocks.AddBlock(1, []float64{0.1}, 0.9)
+	received := make([]*GasPriceFeedResponse, len(streams))
+	for i, stream := range streams {
+		for received[i] == nil || received[i].Height != 1 {
+			select {
+			case received[i] = <-stream.updates:
+			case <-time.After(10 * time.Second):
+				t.Fatal("timed out waiting for a gas price feed update")
+			}
+		}
+	}
+	// both subscribers receive the same update, which is computed once.
+	assert.Same(t, received[0], received[1])
+	assert.LessOrEqual(t, mempool.queries.Load(), int64(2))
+
+	cancel()
+	for range streams {
+		assert.ErrorIs(t, <-done, context.Canceled)
+	}
+}
+
+func TestShouldSendFeedUpdate(t *testing.T) {
+	feedUpdate := func(height int64, gasPrice float64) *GasPriceFeedResponse {
+		return &GasPriceFeedResponse{
+			Height:      height,
+			Estimations: []*GasPriceEstimation{{EstimatedGasPrice: gasPrice}},
+		}
+	}
+	last := feedUpdate(1, 1)
+
+	assert.True(t, shouldSendFeedUpdate(nil, last, 0))
+	assert.True(t, shouldSendFeedUpdate(last, feedUpdate(2, 1), 0))
+	assert.False(t, shouldSendFeedUpdate(last, feedUpdate(1, 2), 0))
+	assert.False(t, shouldSendFeedUpdate(last, feedUpdate(1, 1.05), 0.1))
+	assert.True(t, shouldSendFeedUpdate(last, feedUpdate(1, 1.2), 0.1))
+	assert.True(t, shouldSendFeedUpdate(last, feedUpdate(1, 0.8), 0.1))
+	assert.True(t, shouldSendFeedUpdate(feedUpdate(1, 0), feedUpdate(1, 1), 0.1))
+}
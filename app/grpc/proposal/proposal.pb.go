@@ -0,0 +1,1059 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/proposal/proposal.proto
+
+package proposal
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RejectionCode is the reason a block proposal was rejected.
+type RejectionCode int32
+
+const (
+	// REJECTION_CODE_UNSPECIFIED is the default value and is never used for a
+	// rejection.
+	RejectionCode_REJECTION_CODE_UNSPECIFIED RejectionCode = 0
+	// REJECTION_CODE_UNDECODABLE_TX a transaction could not be decoded.
+	RejectionCode_REJECTION_CODE_UNDECODABLE_TX RejectionCode = 1
+	// REJECTION_CODE_PFB_IN_NON_BLOB_TX a transaction that is not a blob
+	// transaction contains a MsgPayForBlobs.
+	RejectionCode_REJECTION_CODE_PFB_IN_NON_BLOB_TX RejectionCode = 2
+	// REJECTION_CODE_INVALID_BLOB_TX a blob transaction is malformed or its
+	// blobs don't match the MsgPayForBlobs.
+	RejectionCode_REJECTION_CODE_INVALID_BLOB_TX RejectionCode = 3
+	// REJECTION_CODE_INVALID_TX a transaction failed the ante handler, for
+	// example because of an invalid signature, nonce or fee.
+	RejectionCode_REJECTION_CODE_INVALID_TX RejectionCode = 4
+	// REJECTION_CODE_INVALID_SQUARE the data square could not be constructed or
+	// erasure coded from the transactions.
+	RejectionCode_REJECTION_CODE_INVALID_SQUARE RejectionCode = 5
+	// REJECTION_CODE_BAD_SQUARE_SIZE the square size stated by the proposer
+	// differs from the calculated square size.
+	RejectionCode_REJECTION_CODE_BAD_SQUARE_SIZE RejectionCode = 6
+	// REJECTION_CODE_DATA_ROOT_MISMATCH the data root stated by the proposer
+	// differs from the calculated data root.
+	RejectionCode_REJECTION_CODE_DATA_ROOT_MISMATCH RejectionCode = 7
+	// REJECTION_CODE_PANIC processing the proposal caused a panic.
+	RejectionCode_REJECTION_CODE_PANIC RejectionCode = 8
+)
+
+var RejectionCode_name = map[int32]string{
+	0: "REJECTION_CODE_UNSPECIFIED",
+	1: "REJECTION_CODE_UNDECODABLE_TX",
+	2: "REJECTION_CODE_PFB_IN_NON_BLOB_TX",
+	3: "REJECTION_CODE_INVALID_BLOB_TX",
+	4: "REJECTION_CODE_INVALID_TX",
+	5: "REJECTION_CODE_INVALID_SQUARE",
+	6: "REJECTION_CODE_BAD_SQUARE_SIZE",
+	7: "REJECTION_CODE_DATA_ROOT_MISMATCH",
+	8: "REJECTION_CODE_PANIC",
+}
+
+var RejectionCode_value = map[string]int32{
+	"REJECTION_CODE_UNSPECIFIED":        0,
+	"REJECTION_CODE_UNDECODABLE_TX":     1,
+	"REJECTION_CODE_PFB_IN_NON_BLOB_TX": 2,
+	"REJECTION_CODE_INVALID_BLOB_TX":    3,
+	"REJECTION_CODE_INVALID_TX":         4,
+	"REJECTION_CODE_INVALID_SQUARE":     5,
+	"REJECTION_CODE_BAD_SQUARE_SIZE":    6,
+	"REJECTION_CODE_DATA_ROOT_MISMATCH": 7,
+	"REJECTION_CODE_PANIC":              8,
+}
+
+func (x RejectionCode) String() string {
+	return proto.EnumName(RejectionCode_name, int32(x))
+}
+
+func (RejectionCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d6bc0de19fa2c552, []int{0}
+}
+
+// RejectedProposal describes a block proposal that was rejected.
+type RejectedProposal struct {
+	// height is the height of the proposed block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// proposer_address is the address of the validator that proposed the block.
+	ProposerAddress []byte `protobuf:"bytes,2,opt,name=proposer_address,json=proposerAddress,proto3" json:"proposer_address,omitempty"`
+	// code is the reason the proposal was rejected.
+	Code RejectionCode `protobuf:"varint,3,opt,name=code,proto3,enum=celestia.core.v1.proposal.RejectionCode" json:"code,omitempty"`
+	// tx_index is the index of the offending transaction in the block. It is
+	// -1 if the rejection is not caused by a single transaction.
+	TxIndex int64 `protobuf:"varint,4,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// reason is a human readable description of the rejection.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// error is the underlying error, if any.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *RejectedProposal) Reset()         { *m = RejectedProposal{} }
+func (m *RejectedProposal) String() string { return proto.CompactTextString(m) }
+func (*RejectedProposal) ProtoMessage()    {}
+func (*RejectedProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6bc0de19fa2c552, []int{0}
+}
+func (m *RejectedProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RejectedProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RejectedProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RejectedProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedProposal.Merge(m, src)
+}
+func (m *RejectedProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RejectedProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectedProposal proto.InternalMessageInfo
+
+func (m *RejectedProposal) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *RejectedProposal) GetProposerAddress() []byte {
+	if m != nil {
+		return m.ProposerAddress
+	}
+	return nil
+}
+
+func (m *RejectedProposal) GetCode() RejectionCode {
+	if m != nil {
+		return m.Code
+	}
+	return RejectionCode_REJECTION_CODE_UNSPECIFIED
+}
+
+func (m *RejectedProposal) GetTxIndex() int64 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *RejectedProposal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RejectedProposal) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// RejectedProposalsRequest is the request type for the RejectedProposals gRPC
+// method.
+type RejectedProposalsRequest struct {
+	// limit is the maximum number of rejections to return. Zero returns all
+	// rejections that are kept.
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *RejectedProposalsRequest) Reset()         { *m = RejectedProposalsRequest{} }
+func (m *RejectedProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*RejectedProposalsRequest) ProtoMessage()    {}
+func (*RejectedProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6bc0de19fa2c552, []int{1}
+}
+func (m *RejectedProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RejectedProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RejectedProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RejectedProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedProposalsRequest.Merge(m, src)
+}
+func (m *RejectedProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RejectedProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectedProposalsRequest proto.InternalMessageInfo
+
+func (m *RejectedProposalsRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// RejectedProposalsResponse is the response type for the RejectedProposals
+// gRPC method.
+type RejectedProposalsResponse struct {
+	RejectedProposals []*RejectedProposal `protobuf:"bytes,1,rep,name=rejected_proposals,json=rejectedProposals,proto3" json:"rejected_proposals,omitempty"`
+}
+
+func (m *RejectedProposalsResponse) Reset()         { *m = RejectedProposalsResponse{} }
+func (m *RejectedProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*RejectedProposalsResponse) ProtoMessage()    {}
+func (*RejectedProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d6bc0de19fa2c552, []int{2}
+}
+func (m *RejectedProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RejectedProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RejectedProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RejectedProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectedProposalsResponse.Merge(m, src)
+}
+func (m *RejectedProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RejectedProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectedProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectedProposalsResponse proto.InternalMessageInfo
+
+func (m *RejectedProposalsResponse) GetRejectedProposals() []*RejectedProposal {
+	if m != nil {
+		return m.RejectedProposals
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("celestia.core.v1.proposal.RejectionCode", RejectionCode_name, RejectionCode_value)
+	proto.RegisterType((*RejectedProposal)(nil), "celestia.core.v1.proposal.RejectedProposal")
+	proto.RegisterType((*RejectedProposalsRequest)(nil), "celestia.core.v1.proposal.RejectedProposalsRequest")
+	proto.RegisterType((*RejectedProposalsResponse)(nil), "celestia.core.v1.proposal.RejectedProposalsResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/proposal/proposal.proto", fileDescriptor_d6bc0de19fa2c552)
+}
+
+var fileDescriptor_d6bc0de19fa2c552 = []byte{
+	// 570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x26, 0x6d, 0x5a, 0x16, 0x0a, 0xee, 0xaa, 0x42, 0x4e, 0x45, 0xad, 0x34, 0xa8, 0x92,
+	0xa1, 0xc2, 0xa6, 0x2d, 0xdc, 0xb8, 0xf8, 0xaf, 0xc2, 0xa8, 0xb5, 0x8b, 0xe3, 0xa2, 0xaa, 0x97,
+	0x95, 0x6b, 0xaf, 0x1c, 0xa3, 0xd4, 0x6b, 0xd6, 0x6e, 0xc9, 0x99, 0x27, 0x40, 0xe2, 0x31, 0x78,
+	0x01, 0x1e, 0x80, 0x03, 0xc7, 0x4a, 0x5c, 0x38, 0x70, 0x40, 0x09, 0x0f, 0x82, 0x1c, 0xc7, 0x46,
+	0xa4, 0x89, 0x50, 0x0f, 0x96, 0x76, 0x66, 0xbe, 0x6f, 0xe6, 0xfb, 0xbc, 0xb3, 0x50, 0xf4, 0x49,
+	0x9f, 0xa4, 0x59, 0xe4, 0xc9, 0x3e, 0x65, 0x44, 0xbe, 0xdc, 0x91, 0x13, 0x46, 0x13, 0x9a, 0x7a,
+	0xfd, 0xea, 0x20, 0x25, 0x8c, 0x66, 0x14, 0xb5, 0x4a, 0xa4, 0x94, 0x23, 0xa5, 0xcb, 0x1d, 0xa9,
+	0x04, 0xac, 0x3f, 0x08, 0x29, 0x0d, 0xfb, 0x44, 0xf6, 0x92, 0x48, 0xf6, 0xe2, 0x98, 0x66, 0x5e,
+	0x16, 0xd1, 0x38, 0x2d, 0x88, 0x9d, 0x9f, 0x00, 0x72, 0x0e, 0x79, 0x4b, 0xfc, 0x8c, 0x04, 0x47,
+	0x13, 0x0a, 0xba, 0x0f, 0x9b, 0x3d, 0x12, 0x85, 0xbd, 0x8c, 0x07, 0x6d, 0x20, 0x36, 0x9c, 0x49,
+	0x84, 0x1e, 0x41, 0xae, 0x68, 0x4b, 0x18, 0xf6, 0x82, 0x80, 0x91, 0x34, 0xe5, 0xeb, 0x6d, 0x20,
+	0xde, 0x71, 0xee, 0x95, 0x79, 0xa5, 0x48, 0xa3, 0x17, 0x70, 0xc1, 0xa7, 0x01, 0xe1, 0x1b, 0x6d,
+	0x20, 0xde, 0xdd, 0x15, 0xa5, 0xb9, 0xfa, 0xa4, 0x62, 0x7a, 0x44, 0x63, 0x8d, 0x06, 0xc4, 0x19,
+	0xb3, 0x50, 0x0b, 0x2e, 0x67, 0x03, 0x1c, 0xc5, 0x01, 0x19, 0xf0, 0x0b, 0x63, 0x09, 0x4b, 0xd9,
+	0xc0, 0xcc, 0xc3, 0x5c, 0x1b, 0x23, 0x5e, 0x4a, 0x63, 0x7e, 0xb1, 0x0d, 0xc4, 0x5b, 0xce, 0x24,
+	0x42, 0x6b, 0x70, 0x91, 0x30, 0x46, 0x19, 0xdf, 0x1c, 0xa7, 0x8b, 0xa0, 0xf3, 0x14, 0xf2, 0xd3,
+	0xee, 0x52, 0x87, 0xbc, 0xbb, 0x20, 0x69, 0x96, 0x33, 0xfa, 0xd1, 0x79, 0x54, 0x98, 0x5c, 0x71,
+	0x8a, 0xa0, 0xf3, 0x1e, 0xb6, 0x66, 0x30, 0xd2, 0x84, 0xc6, 0x29, 0x41, 0xa7, 0x10, 0xb1, 0x49,
+	0x11, 0x97, 0x06, 0x52, 0x1e, 0xb4, 0x1b, 0xe2, 0xed, 0xdd, 0xed, 0xff, 0x7a, 0xfc, 0xdb, 0xd1,
+	0x59, 0x65, 0xd3, 0x33, 0x1e, 0x7f, 0xad, 0xc3, 0x95, 0x7f, 0xfe, 0x05, 0x12, 0xe0, 0xba, 0x63,
+	0xbc, 0x32, 0x34, 0xd7, 0xb4, 0x2d, 0xac, 0xd9, 0xba, 0x81, 0x8f, 0xad, 0xee, 0x91, 0xa1, 0x99,
+	0xfb, 0xa6, 0xa1, 0x73, 0x35, 0xb4, 0x09, 0x37, 0xae, 0xd5, 0x75, 0x43, 0xb3, 0x75, 0x45, 0x3d,
+	0x30, 0xb0, 0x7b, 0xc2, 0x01, 0xb4, 0x05, 0x37, 0xa7, 0x20, 0x47, 0xfb, 0x2a, 0x36, 0x2d, 0x6c,
+	0xd9, 0x16, 0x56, 0x0f, 0x6c, 0x35, 0x87, 0xd5, 0x51, 0x07, 0x0a, 0x53, 0x30, 0xd3, 0x7a, 0xa3,
+	0x1c, 0x98, 0x7a, 0x85, 0x69, 0xa0, 0x0d, 0xd8, 0x9a, 0x83, 0x71, 0x4f, 0xb8, 0x85, 0x19, 0x62,
+	0xca, 0x72, 0xf7, 0xf5, 0xb1, 0xe2, 0x18, 0xdc, 0xe2, 0x8c, 0x29, 0xaa, 0x52, 0x96, 0x71, 0xd7,
+	0x3c, 0x35, 0xb8, 0xe6, 0x0c, 0xc1, 0xba, 0xe2, 0x2a, 0xd8, 0xb1, 0x6d, 0x17, 0x1f, 0x9a, 0xdd,
+	0x43, 0xc5, 0xd5, 0x5e, 0x72, 0x4b, 0x88, 0x87, 0x6b, 0xd3, 0xbe, 0x14, 0xcb, 0xd4, 0xb8, 0xe5,
+	0xdd, 0x2f, 0x00, 0x2e, 0x57, 0x8b, 0xfc, 0x19, 0xc0, 0xd5, 0x6b, 0xb7, 0x89, 0xf6, 0x6e, 0x70,
+	0x53, 0xe5, 0xb6, 0xac, 0x3f, 0xbb, 0x19, 0xa9, 0x58, 0x98, 0xce, 0xf6, 0x87, 0xef, 0xbf, 0x3f,
+	0xd5, 0xb7, 0xd0, 0x43, 0x79, 0xfe, 0x53, 0x2e, 0x57, 0x41, 0xb5, 0xbf, 0x0d, 0x05, 0x70, 0x35,
+	0x14, 0xc0, 0xaf, 0xa1, 0x00, 0x3e, 0x8e, 0x84, 0xda, 0xd5, 0x48, 0xa8, 0xfd, 0x18, 0x09, 0xb5,
+	0xd3, 0xe7, 0x61, 0x94, 0xf5, 0x2e, 0xce, 0x24, 0x9f, 0x9e, 0x57, 0x8d, 0x28, 0x0b, 0xab, 0xf3,
+	0x13, 0x2f, 0x49, 0xe4, 0xfc, 0x0b, 0x59, 0xe2, 0x57, 0x9d, 0xcf, 0x9a, 0xe3, 0x37, 0xbe, 0xf7,
+	0x67, 0x00, 0x50, 0x8f, 0xf4, 0xdf, 0x48, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProposalClient is the client API for Proposal service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProposalClient interface {
+	// RejectedProposals returns the most recent block proposals that were
+	// rejected by this node in ProcessProposal, ordered from newest to oldest.
+	// Only a bounded number of rejections are kept in memory and they are not
+	// persisted across restarts.
+	RejectedProposals(ctx context.Context, in *RejectedProposalsRequest, opts ...grpc.CallOption) (*RejectedProposalsResponse, error)
+}
+
+type proposalClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewProposalClient(cc grpc1.ClientConn) ProposalClient {
+	return &proposalClient{cc}
+}
+
+func (c *proposalClient) RejectedProposals(ctx context.Context, in *RejectedProposalsRequest, opts ...grpc.CallOption) (*RejectedProposalsResponse, error) {
+	out := new(RejectedProposalsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.proposal.Proposal/RejectedProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProposalServer is the server API for Proposal service.
+type ProposalServer interface {
+	// RejectedProposals returns the most recent block proposals that were
+	// rejected by this node in ProcessProposal, ordered from newest to oldest.
+	// Only a bounded number of rejections are kept in memory and they are not
+	// persisted across restarts.
+	RejectedProposals(context.Context, *RejectedProposalsRequest) (*RejectedProposalsResponse, error)
+}
+
+// UnimplementedProposalServer can be embedded to have forward compatible implementations.
+type UnimplementedProposalServer struct {
+}
+
+func (*UnimplementedProposalServer) RejectedProposals(ctx context.Context, req *RejectedProposalsRequest) (*RejectedProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectedProposals not implemented")
+}
+
+func RegisterProposalServer(s grpc1.Server, srv ProposalServer) {
+	s.RegisterService(&_Proposal_serviceDesc, srv)
+}
+
+func _Proposal_RejectedProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectedProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProposalServer).RejectedProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.proposal.Proposal/RejectedProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProposalServer).RejectedProposals(ctx, req.(*RejectedProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Proposal_serviceDesc = _Proposal_serviceDesc
+var _Proposal_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.proposal.Proposal",
+	HandlerType: (*ProposalServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RejectedProposals",
+			Handler:    _Proposal_RejectedProposals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/proposal/proposal.proto",
+}
+
+func (m *RejectedProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RejectedProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RejectedProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Code != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProposerAddress) > 0 {
+		i -= len(m.ProposerAddress)
+		copy(dAtA[i:], m.ProposerAddress)
+		i = encodeVarintProposal(dAtA, i, uint64(len(m.ProposerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RejectedProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RejectedProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RejectedProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintProposal(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RejectedProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RejectedProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RejectedProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RejectedProposals) > 0 {
+		for iNdEx := len(m.RejectedProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RejectedProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProposal(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RejectedProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProposal(uint64(m.Height))
+	}
+	l = len(m.ProposerAddress)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovProposal(uint64(m.Code))
+	}
+	if m.TxIndex != 0 {
+		n += 1 + sovProposal(uint64(m.TxIndex))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovProposal(uint64(l))
+	}
+	return n
+}
+
+func (m *RejectedProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovProposal(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *RejectedProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RejectedProposals) > 0 {
+		for _, e := range m.RejectedProposals {
+			l = e.Size()
+			n += 1 + l + sovProposal(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProposal(x uint64) (n int) {
+	return sovProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RejectedProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejectedProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejectedProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerAddress = append(m.ProposerAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposerAddress == nil {
+				m.ProposerAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= RejectionCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RejectedProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejectedProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejectedProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RejectedProposalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RejectedProposalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RejectedProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RejectedProposals = append(m.RejectedProposals, &RejectedProposal{})
+			if err := m.RejectedProposals[len(m.RejectedProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProposal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,102 @@
+package proposal
+
+import (
+	"context"
+	"sync"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// DefaultRejectionLogSize is the default number of rejected proposals kept by
+// the RejectionLog.
+const DefaultRejectionLogSize = 100
+
+// RegisterProposalService registers the proposal service on the gRPC router.
+func RegisterProposalService(qrt gogogrpc.Server, rejections *RejectionLog) {
+	RegisterProposalServer(qrt, NewProposalServer(rejections))
+}
+
+// RegisterGRPCGatewayRoutes mounts the proposal service's GRPC-gateway routes
+// on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterProposalHandlerClient(context.Background(), mux, NewProposalClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+var _ ProposalServer = &proposalServer{}
+
+type proposalServer struct {
+	rejections *RejectionLog
+}
+
+func NewProposalServer(rejections *RejectionLog) ProposalServer {
+	return &proposalServer{rejections: rejections}
+}
+
+// RejectedProposals implements the ProposalServer.RejectedProposals method.
+func (s *proposalServer) RejectedProposals(_ context.Context, req *RejectedProposalsRequest) (*RejectedProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	return &RejectedProposalsResponse{
+		RejectedProposals: s.rejections.List(int(req.Limit)),
+	}, nil
+}
+
+// RejectionLog is a fixed size ring buffer of the most recently rejected
+// proposals. It is safe for concurrent use.
+type RejectionLog struct {
+	mtx     sync.Mutex
+	entries []*RejectedProposal
+	// next is the position in entries that the next rejection is written to.
+	next int
+	// full is true once entries has wrapped around.
+	full bool
+}
+
+// NewRejectionLog returns a RejectionLog that keeps the last size rejections.
+func NewRejectionLog(size int) *RejectionLog {
+	if size <= 0 {
+		size = DefaultRejectionLogSize
+	}
+	return &RejectionLog{
+		entries: make([]*RejectedProposal, size),
+	}
+}
+
+// Add records a rejected proposal, overwriting the oldest one if the log is
+// full.
+func (l *RejectionLog) Add(rejection *RejectedProposal) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.entries[l.next] = rejection
+	l.next = (l.next + 1) % len(l.entries)
+	if l.next == 0 {
+		l.full = true
+	}
+}
+
+// List returns up to limit rejected proposals ordered from newest to oldest.
+// A limit of zero returns all of them.
+func (l *RejectionLog) List(limit int) []*RejectedProposal {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	count := l.next
+	if l.full {
+		count = len(l.entries)
+	}
+	if limit > 0 && limit < count {
+		count = limit
+	}
+	rejections := make([]*RejectedProposal, 0, count)
+	for i := 1; i <= count; i++ {
+		idx := (l.next - i + len(l.entries)) % len(l.entries)
+		rejections = append(rejections, l.entries[idx])
+	}
+	return rejections
+}
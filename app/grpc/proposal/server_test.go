@@ -0,0 +1,43 @@
+package proposal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRejectionLog(t *testing.T) {
+	log := NewRejectionLog(3)
+	require.Empty(t, log.List(0))
+
+	for height := int64(1); height <= 5; height++ {
+		log.Add(&RejectedProposal{Height: height, Code: RejectionCode_REJECTION_CODE_DATA_ROOT_MISMATCH, TxIndex: -1})
+	}
+
+	heights := func(rejections []*RejectedProposal) []int64 {
+		out := make([]int64, 0, len(rejections))
+		for _, r := range rejections {
+			out = append(out, r.Height)
+		}
+		return out
+	}
+	assert.Equal(t, []int64{5, 4, 3}, heights(log.List(0)))
+	assert.Equal(t, []int64{5, 4}, heights(log.List(2)))
+	assert.Equal(t, []int64{5, 4, 3}, heights(log.List(10)))
+}
+
+func TestRejectedProposals(t *testing.T) {
+	log := NewRejectionLog(DefaultRejectionLogSize)
+	log.Add(&RejectedProposal{Height: 10, Code: RejectionCode_REJECTION_CODE_UNDECODABLE_TX, TxIndex: 2})
+	server := NewProposalServer(log)
+
+	_, err := server.RejectedProposals(context.Background(), nil)
+	require.Error(t, err)
+
+	resp, err := server.RejectedProposals(context.Background(), &RejectedProposalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.RejectedProposals, 1)
+	assert.Equal(t, int64(2), resp.RejectedProposals[0].TxIndex)
+}
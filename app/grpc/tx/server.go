@@ -3,6 +3,7 @@ package tx
 import (
 	"context"
 	"encoding/hex"
+	"time"
 
 	rpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -13,15 +14,19 @@ import (
 	status "google.golang.org/grpc/status"
 )
 
+// dryRunBlockFn is the signature of the App#DryRunBlock function.
+type dryRunBlockFn func(txs [][]byte) (*DryRunBlockResponse, error)
+
 // RegisterTxService registers the tx service on the gRPC router.
 func RegisterTxService(
 	qrt gogogrpc.Server,
 	clientCtx client.Context,
 	interfaceRegistry codectypes.InterfaceRegistry,
+	dryRunBlockFn dryRunBlockFn,
 ) {
 	RegisterTxServer(
 		qrt,
-		NewTxServer(clientCtx, interfaceRegistry),
+		NewTxServer(clientCtx, interfaceRegistry, dryRunBlockFn),
 	)
 }
 
@@ -39,13 +44,20 @@ var _ TxServer = &txServer{}
 type txServer struct {
 	clientCtx         client.Context
 	interfaceRegistry codectypes.InterfaceRegistry
+	dryRunBlockFn     dryRunBlockFn
+	// statusPoller polls the status of the transactions of the
+	// SubscribeTxStatus subscribers.
+	statusPoller *txStatusPoller
 }
 
-func NewTxServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry) TxServer {
-	return &txServer{
+func NewTxServer(clientCtx client.Context, interfaceRegistry codectypes.InterfaceRegistry, dryRunBlockFn dryRunBlockFn) TxServer {
+	server := &txServer{
 		clientCtx:         clientCtx,
 		interfaceRegistry: interfaceRegistry,
+		dryRunBlockFn:     dryRunBlockFn,
 	}
+	server.statusPoller = newTxStatusPoller(server.signClient)
+	return server
 }
 
 // TxStatus implements the TxServer.TxStatus method proxying to the underlying celestia-core RPC server
@@ -58,6 +70,169 @@ func (s *txServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatu
 		return nil, status.Error(codes.InvalidArgument, "tx id cannot be empty")
 	}
 
+	node, err := s.signClient()
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := hex.DecodeString(req.TxId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id: %s", err)
+	}
+
+	return txStatus(ctx, node, txID)
+}
+
+// MaxTxStatusesBatchSize is the maximum number of transactions that can be
+// requested in a single TxStatuses or SubscribeTxStatus call.
+const MaxTxStatusesBatchSize = 1000
+
+// txStatusPollInterval is how often SubscribeTxStatus checks the status of the
+// pending transactions.
+var txStatusPollInterval = time.Second
+
+// TxStatuses implements the TxServer.TxStatuses method. It returns the status
+// of every requested transaction in the order they were requested.
+func (s *txServer) TxStatuses(ctx context.Context, req *TxStatusesRequest) (*TxStatusesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	txIDs, err := decodeTxIDs(req.TxIds)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := s.signClient()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &TxStatusesResponse{Statuses: make([]*TxStatusResult, 0, len(txIDs))}
+	for i, txID := range txIDs {
+		txStatus, err := txStatus(ctx, node, txID)
+		if err != nil {
+			return nil, err
+		}
+		resp.Statuses = append(resp.Statuses, &TxStatusResult{TxId: req.TxIds[i], Status: txStatus})
+	}
+	return resp, nil
+}
+
+// SubscribeTxStatus implements the TxServer.SubscribeTxStatus method. It sends
+// the current status of every requested transaction and then an update every
+// time one changes, until all of them are committed, rejected or evicted. The
+// statuses are polled once for all the subscribers.
+func (s *txServer) SubscribeTxStatus(req *SubscribeTxStatusRequest, stream Tx_SubscribeTxStatusServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	txIDs, err := decodeTxIDs(req.TxIds)
+	if err != nil {
+		return err
+	}
+
+	node, err := s.signClient()
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+
+	// pending are the indexes in the request of the transactions whose status
+	// may still change and last the last status sent for each of them.
+	pending := make([]int, 0, len(txIDs))
+	seen := make(map[string]struct{}, len(txIDs))
+	for i, txID := range req.TxIds {
+		if _, ok := seen[txID]; ok {
+			continue
+		}
+		seen[txID] = struct{}{}
+		pending = append(pending, i)
+	}
+	last := make(map[int]*TxStatusResponse, len(pending))
+
+	// send sends the status of the transaction at index i if it changed and
+	// returns true if it is final.
+	send := func(i int, txStatus *TxStatusResponse) (bool, error) {
+		if previous, ok := last[i]; !ok || !txStatusEqual(previous, txStatus) {
+			err := stream.Send(&SubscribeTxStatusResponse{
+				Result: &TxStatusResult{TxId: req.TxIds[i], Status: txStatus},
+			})
+			if err != nil {
+				return false, err
+			}
+			last[i] = txStatus
+		}
+		return isFinalTxStatus(txStatus.Status), nil
+	}
+
+	stillPending := pending[:0]
+	for _, i := range pending {
+		txStatus, err := txStatus(ctx, node, txIDs[i])
+		if err != nil {
+			return err
+		}
+		final, err := send(i, txStatus)
+		if err != nil {
+			return err
+		}
+		if !final {
+			stillPending = append(stillPending, i)
+		}
+	}
+	pending = stillPending
+
+	pendingTxIDs := func() [][]byte {
+		ids := make([][]byte, len(pending))
+		for j, i := range pending {
+			ids[j] = txIDs[i]
+		}
+		return ids
+	}
+	s.statusPoller.watch(pendingTxIDs()...)
+	defer func() {
+		s.statusPoller.unwatch(pendingTxIDs()...)
+	}()
+
+	for len(pending) > 0 {
+		statuses, updated, err := s.statusPoller.latest(pendingTxIDs())
+		if err != nil {
+			return err
+		}
+		stillPending := pending[:0]
+		for j, i := range pending {
+			if statuses[j] == nil {
+				stillPending = append(stillPending, i)
+				continue
+			}
+			final, err := send(i, statuses[j])
+			if err != nil {
+				return err
+			}
+			if final {
+				s.statusPoller.unwatch(txIDs[i])
+				continue
+			}
+			stillPending = append(stillPending, i)
+		}
+		pending = stillPending
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-updated:
+		}
+	}
+	return nil
+}
+
+// signClient returns the node client used to query the status of transactions.
+func (s *txServer) signClient() (rpcclient.SignClient, error) {
 	node, err := s.clientCtx.GetNode()
 	if err != nil {
 		return nil, err
@@ -67,13 +242,12 @@ func (s *txServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatu
 	if !ok {
 		return nil, status.Error(codes.Unimplemented, "node does not support tx status")
 	}
+	return nodeTxStatus, nil
+}
 
-	txID, err := hex.DecodeString(req.TxId)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid tx id: %s", err)
-	}
-
-	resTx, err := nodeTxStatus.TxStatus(ctx, txID)
+// txStatus queries the status of the transaction with hash txID.
+func txStatus(ctx context.Context, node rpcclient.SignClient, txID []byte) (*TxStatusResponse, error) {
+	resTx, err := node.TxStatus(ctx, txID)
 	if err != nil {
 		return nil, err
 	}
@@ -86,3 +260,51 @@ func (s *txServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatu
 		Status:        resTx.Status,
 	}, nil
 }
+
+// decodeTxIDs validates and decodes the hex encoded hashes of a batch of
+// transactions.
+func decodeTxIDs(txIDs []string) ([][]byte, error) {
+	if len(txIDs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "tx ids cannot be empty")
+	}
+	if len(txIDs) > MaxTxStatusesBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many tx ids: %d, maximum is %d", len(txIDs), MaxTxStatusesBatchSize)
+	}
+	decoded := make([][]byte, len(txIDs))
+	for i, txID := range txIDs {
+		if len(txID) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "tx id %d cannot be empty", i)
+		}
+		id, err := hex.DecodeString(txID)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tx id %d: %s", i, err)
+		}
+		decoded[i] = id
+	}
+	return decoded, nil
+}
+
+// txStatusEqual returns true if both statuses describe the same state of a
+// transaction.
+func txStatusEqual(a, b *TxStatusResponse) bool {
+	return a.Status == b.Status &&
+		a.Height == b.Height &&
+		a.Index == b.Index &&
+		a.ExecutionCode == b.ExecutionCode &&
+		a.Error == b.Error
+}
+
+// DryRunBlock implements the TxServer.DryRunBlock method. It builds a block
+// from the provided transactions against the latest state without committing
+// anything.
+func (s *txServer) DryRunBlock(_ context.Context, req *DryRunBlockRequest) (*DryRunBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	if s.dryRunBlockFn == nil {
+		return nil, status.Error(codes.Unimplemented, "node does not support dry running blocks")
+	}
+
+	return s.dryRunBlockFn(req.Txs)
+}
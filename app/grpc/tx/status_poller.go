@@ -0,0 +1,156 @@
+package tx
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	"github.com/cometbft/cometbft/rpc/core"
+)
+
+// txStatusPoller polls the status of the transactions watched by the
+// SubscribeTxStatus subscribers. Every watched transaction is queried once per
+// txStatusPollInterval regardless of how many subscribers watch it and the
+// polling stops while no transaction is watched.
+type txStatusPoller struct {
+	signClient func() (rpcclient.SignClient, error)
+
+	mtx sync.Mutex
+	// watchers is the number of subscribers watching each transaction, keyed
+	// by hash.
+	watchers map[string]int
+	// statuses are the latest statuses of the watched transactions and err
+	// the error of the latest poll, if any.
+	statuses map[string]*TxStatusResponse
+	err      error
+	// updated is closed after every poll.
+	updated chan struct{}
+	// cancel stops the polling. It is set while transactions are watched.
+	cancel context.CancelFunc
+}
+
+func newTxStatusPoller(signClient func() (rpcclient.SignClient, error)) *txStatusPoller {
+	return &txStatusPoller{
+		signClient: signClient,
+		watchers:   make(map[string]int),
+		statuses:   make(map[string]*TxStatusResponse),
+		updated:    make(chan struct{}),
+	}
+}
+
+// watch adds the transactions with the provided hashes to the ones that are
+// polled, starting the polling if needed. Every call must be matched by a
+// call to unwatch with the same hashes.
+func (p *txStatusPoller) watch(txIDs ...[]byte) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, txID := range txIDs {
+		p.watchers[string(txID)]++
+	}
+	if p.cancel == nil && len(p.watchers) > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		p.cancel = cancel
+		go p.run(ctx)
+	}
+}
+
+// unwatch removes the transactions with the provided hashes from the ones
+// that are polled, stopping the polling once none are left.
+func (p *txStatusPoller) unwatch(txIDs ...[]byte) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	for _, txID := range txIDs {
+		key := string(txID)
+		p.watchers[key]--
+		if p.watchers[key] <= 0 {
+			delete(p.watchers, key)
+			delete(p.statuses, key)
+		}
+	}
+	if p.cancel != nil && len(p.watchers) == 0 {
+		p.cancel()
+		p.cancel = nil
+		p.err = nil
+	}
+}
+
+// latest returns the latest polled status of the provided transactions, in
+// the same order, along with a channel that is closed after the next poll. A
+// status is nil if the transaction hasn't been polled yet.
+func (p *txStatusPoller) latest(txIDs [][]byte) ([]*TxStatusResponse, <-chan struct{}, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	statuses := make([]*TxStatusResponse, len(txIDs))
+	for i, txID := range txIDs {
+		statuses[i] = p.statuses[string(txID)]
+	}
+	return statuses, p.updated, p.err
+}
+
+func (p *txStatusPoller) run(ctx context.Context) {
+	ticker := time.NewTicker(txStatusPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		statuses, err := p.poll(ctx)
+		p.publish(ctx, statuses, err)
+	}
+}
+
+// poll queries the status of every watched transaction.
+func (p *txStatusPoller) poll(ctx context.Context) (map[string]*TxStatusResponse, error) {
+	node, err := p.signClient()
+	if err != nil {
+		return nil, err
+	}
+	p.mtx.Lock()
+	txIDs := make([]string, 0, len(p.watchers))
+	for txID := range p.watchers {
+		txIDs = append(txIDs, txID)
+	}
+	p.mtx.Unlock()
+
+	statuses := make(map[string]*TxStatusResponse, len(txIDs))
+	for _, txID := range txIDs {
+		status, err := txStatus(ctx, node, []byte(txID))
+		if err != nil {
+			return nil, err
+		}
+		statuses[txID] = status
+	}
+	return statuses, nil
+}
+
+func (p *txStatusPoller) publish(ctx context.Context, statuses map[string]*TxStatusResponse, err error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	// the polling was stopped, possibly while the statuses were queried.
+	if ctx.Err() != nil {
+		return
+	}
+	for txID, status := range statuses {
+		// the transaction may have been unwatched during the poll.
+		if _, ok := p.watchers[txID]; ok {
+			p.statuses[txID] = status
+		}
+	}
+	p.err = err
+	close(p.updated)
+	p.updated = make(chan struct{})
+}
+
+// isFinalTxStatus returns true if a transaction with the provided status
+// won't change status anymore.
+func isFinalTxStatus(status string) bool {
+	switch status {
+	case core.TxStatusCommitted, core.TxStatusRejected, core.TxStatusEvicted:
+		return true
+	default:
+		return false
+	}
+}
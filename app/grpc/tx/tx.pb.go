@@ -156,271 +156,2555 @@ func (m *TxStatusResponse) GetStatus() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*TxStatusRequest)(nil), "celestia.core.v1.tx.TxStatusRequest")
-	proto.RegisterType((*TxStatusResponse)(nil), "celestia.core.v1.tx.TxStatusResponse")
+// TxStatusesRequest is the request type for the TxStatuses gRPC method.
+type TxStatusesRequest struct {
+	// tx_ids are the hex encoded transaction hashes.
+	TxIds []string `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
 }
 
-func init() { proto.RegisterFile("celestia/core/v1/tx/tx.proto", fileDescriptor_7d8b070565b0dcb6) }
-
-var fileDescriptor_7d8b070565b0dcb6 = []byte{
-	// 337 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xcd, 0x4a, 0x33, 0x31,
-	0x14, 0x6d, 0xfa, 0xc7, 0xf7, 0x05, 0xfa, 0x7d, 0x92, 0x8a, 0x0c, 0xa5, 0x0c, 0xa5, 0xb4, 0xd2,
-	0x8d, 0x13, 0xaa, 0x6f, 0xa0, 0xab, 0x6e, 0xc7, 0xae, 0xdc, 0x94, 0xe9, 0xcc, 0x65, 0x1a, 0xa8,
-	0x93, 0x31, 0xb9, 0x53, 0x02, 0xd2, 0x8d, 0xbe, 0x80, 0x20, 0xbe, 0x93, 0xcb, 0x82, 0x1b, 0x97,
-	0xd2, 0xfa, 0x20, 0x32, 0x99, 0xb6, 0x82, 0x14, 0x5c, 0x04, 0x72, 0xee, 0x39, 0x37, 0x27, 0xf7,
-	0x5c, 0xda, 0x0e, 0x61, 0x0e, 0x1a, 0x45, 0xc0, 0x43, 0xa9, 0x80, 0x2f, 0x86, 0x1c, 0x0d, 0x47,
-	0xe3, 0xa5, 0x4a, 0xa2, 0x64, 0xcd, 0x1d, 0xeb, 0xe5, 0xac, 0xb7, 0x18, 0x7a, 0x68, 0x5a, 0xed,
-	0x58, 0xca, 0x78, 0x0e, 0x3c, 0x48, 0x05, 0x0f, 0x92, 0x44, 0x62, 0x80, 0x42, 0x26, 0xba, 0x68,
-	0xe9, 0x9e, 0xd2, 0xff, 0x63, 0x73, 0x8d, 0x01, 0x66, 0xda, 0x87, 0xbb, 0x0c, 0x34, 0xb2, 0x26,
-	0xad, 0xa1, 0x99, 0x88, 0xc8, 0x21, 0x1d, 0x32, 0xf8, 0xeb, 0x57, 0xd1, 0x8c, 0xa2, 0xee, 0x0b,
-	0xa1, 0x47, 0xdf, 0x42, 0x9d, 0xca, 0x44, 0x03, 0x3b, 0xa1, 0xf5, 0x19, 0x88, 0x78, 0x86, 0x56,
-	0x5a, 0xf1, 0xb7, 0x88, 0x1d, 0xd3, 0x9a, 0x48, 0x22, 0x30, 0x4e, 0xb9, 0x43, 0x06, 0x0d, 0xbf,
-	0x00, 0xac, 0x4f, 0xff, 0x81, 0x81, 0x30, 0xcb, 0xed, 0x27, 0xa1, 0x8c, 0xc0, 0xa9, 0x58, 0xba,
-	0xb1, 0xaf, 0x5e, 0xc9, 0x08, 0xf2, 0x66, 0x50, 0x4a, 0x2a, 0xa7, 0x6a, 0xed, 0x0b, 0x90, 0x5b,
-	0x69, 0x6b, 0xee, 0xd4, 0x6c, 0x79, 0x8b, 0xce, 0x1f, 0x09, 0x2d, 0x8f, 0x0d, 0x5b, 0xd2, 0x3f,
-	0xbb, 0xdf, 0xb1, 0x9e, 0x77, 0x20, 0x06, 0xef, 0xc7, 0x94, 0xad, 0xfe, 0x2f, 0xaa, 0x62, 0xc4,
-	0x6e, 0xef, 0xe1, 0xed, 0xf3, 0xb9, 0xec, 0xb2, 0x36, 0x3f, 0x94, 0xfc, 0xbd, 0x0d, 0x6a, 0x79,
-	0x39, 0x7a, 0x5d, 0xbb, 0x64, 0xb5, 0x76, 0xc9, 0xc7, 0xda, 0x25, 0x4f, 0x1b, 0xb7, 0xb4, 0xda,
-	0xb8, 0xa5, 0xf7, 0x8d, 0x5b, 0xba, 0xe1, 0xb1, 0xc0, 0x59, 0x36, 0xf5, 0x42, 0x79, 0xbb, 0x7f,
-	0x41, 0xaa, 0x78, 0x7f, 0x3f, 0x0b, 0xd2, 0x94, 0xe7, 0x27, 0x56, 0x69, 0xc8, 0xd1, 0x4c, 0xeb,
-	0x76, 0x2f, 0x17, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x5a, 0x2f, 0x1b, 0xd1, 0xea, 0x01, 0x00,
-	0x00,
+func (m *TxStatusesRequest) Reset()         { *m = TxStatusesRequest{} }
+func (m *TxStatusesRequest) String() string { return proto.CompactTextString(m) }
+func (*TxStatusesRequest) ProtoMessage()    {}
+func (*TxStatusesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{2}
+}
+func (m *TxStatusesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusesRequest.Merge(m, src)
+}
+func (m *TxStatusesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_TxStatusesRequest proto.InternalMessageInfo
 
-// TxClient is the client API for Tx service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type TxClient interface {
-	// TxStatus returns the status of a transaction. There are four possible
-	// states:
-	// - Committed
-	// - Pending
-	// - Evicted
-	// - Unknown
-	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+func (m *TxStatusesRequest) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
+	}
+	return nil
 }
 
-type txClient struct {
-	cc grpc1.ClientConn
+// TxStatusesResponse is the response type for the TxStatuses gRPC method.
+type TxStatusesResponse struct {
+	// statuses are in the same order as the requested tx_ids.
+	Statuses []*TxStatusResult `protobuf:"bytes,1,rep,name=statuses,proto3" json:"statuses,omitempty"`
 }
 
-func NewTxClient(cc grpc1.ClientConn) TxClient {
-	return &txClient{cc}
+func (m *TxStatusesResponse) Reset()         { *m = TxStatusesResponse{} }
+func (m *TxStatusesResponse) String() string { return proto.CompactTextString(m) }
+func (*TxStatusesResponse) ProtoMessage()    {}
+func (*TxStatusesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{3}
+}
+func (m *TxStatusesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusesResponse.Merge(m, src)
+}
+func (m *TxStatusesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusesResponse.DiscardUnknown(m)
 }
 
-func (c *txClient) TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error) {
-	out := new(TxStatusResponse)
-	err := c.cc.Invoke(ctx, "/celestia.core.v1.tx.Tx/TxStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_TxStatusesResponse proto.InternalMessageInfo
+
+func (m *TxStatusesResponse) GetStatuses() []*TxStatusResult {
+	if m != nil {
+		return m.Statuses
 	}
-	return out, nil
+	return nil
 }
 
-// TxServer is the server API for Tx service.
-type TxServer interface {
-	// TxStatus returns the status of a transaction. There are four possible
-	// states:
-	// - Committed
-	// - Pending
-	// - Evicted
-	// - Unknown
-	TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error)
+// TxStatusResult is the status of a transaction identified by its hash.
+type TxStatusResult struct {
+	TxId   string            `protobuf:"bytes,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Status *TxStatusResponse `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-// UnimplementedTxServer can be embedded to have forward compatible implementations.
-type UnimplementedTxServer struct {
+func (m *TxStatusResult) Reset()         { *m = TxStatusResult{} }
+func (m *TxStatusResult) String() string { return proto.CompactTextString(m) }
+func (*TxStatusResult) ProtoMessage()    {}
+func (*TxStatusResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{4}
+}
+func (m *TxStatusResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxStatusResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxStatusResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxStatusResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxStatusResult.Merge(m, src)
+}
+func (m *TxStatusResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxStatusResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxStatusResult.DiscardUnknown(m)
 }
 
-func (*UnimplementedTxServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+var xxx_messageInfo_TxStatusResult proto.InternalMessageInfo
+
+func (m *TxStatusResult) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
 }
 
-func RegisterTxServer(s grpc1.Server, srv TxServer) {
-	s.RegisterService(&_Tx_serviceDesc, srv)
+func (m *TxStatusResult) GetStatus() *TxStatusResponse {
+	if m != nil {
+		return m.Status
+	}
+	return nil
 }
 
-func _Tx_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TxStatusRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// SubscribeTxStatusRequest is the request type for the SubscribeTxStatus gRPC
+// method.
+type SubscribeTxStatusRequest struct {
+	// tx_ids are the hex encoded transaction hashes.
+	TxIds []string `protobuf:"bytes,1,rep,name=tx_ids,json=txIds,proto3" json:"tx_ids,omitempty"`
+}
+
+func (m *SubscribeTxStatusRequest) Reset()         { *m = SubscribeTxStatusRequest{} }
+func (m *SubscribeTxStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTxStatusRequest) ProtoMessage()    {}
+func (*SubscribeTxStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{5}
+}
+func (m *SubscribeTxStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeTxStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeTxStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(TxServer).TxStatus(ctx, in)
+}
+func (m *SubscribeTxStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTxStatusRequest.Merge(m, src)
+}
+func (m *SubscribeTxStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeTxStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTxStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTxStatusRequest proto.InternalMessageInfo
+
+func (m *SubscribeTxStatusRequest) GetTxIds() []string {
+	if m != nil {
+		return m.TxIds
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/celestia.core.v1.tx.Tx/TxStatus",
+	return nil
+}
+
+// SubscribeTxStatusResponse is the response type for the SubscribeTxStatus
+// gRPC method. It carries the status of a single transaction.
+type SubscribeTxStatusResponse struct {
+	Result *TxStatusResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+}
+
+func (m *SubscribeTxStatusResponse) Reset()         { *m = SubscribeTxStatusResponse{} }
+func (m *SubscribeTxStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTxStatusResponse) ProtoMessage()    {}
+func (*SubscribeTxStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{6}
+}
+func (m *SubscribeTxStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeTxStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeTxStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TxServer).TxStatus(ctx, req.(*TxStatusRequest))
+}
+func (m *SubscribeTxStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTxStatusResponse.Merge(m, src)
+}
+func (m *SubscribeTxStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeTxStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTxStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTxStatusResponse proto.InternalMessageInfo
+
+func (m *SubscribeTxStatusResponse) GetResult() *TxStatusResult {
+	if m != nil {
+		return m.Result
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var Tx_serviceDesc = _Tx_serviceDesc
-var _Tx_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.core.v1.tx.Tx",
-	HandlerType: (*TxServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TxStatus",
-			Handler:    _Tx_TxStatus_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/core/v1/tx/tx.proto",
+// DryRunBlockRequest is the request type for the DryRunBlock gRPC method.
+type DryRunBlockRequest struct {
+	// txs are the raw transactions, either normal transactions or BlobTxs.
+	Txs [][]byte `protobuf:"bytes,1,rep,name=txs,proto3" json:"txs,omitempty"`
 }
 
-func (m *TxStatusRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *DryRunBlockRequest) Reset()         { *m = DryRunBlockRequest{} }
+func (m *DryRunBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*DryRunBlockRequest) ProtoMessage()    {}
+func (*DryRunBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{7}
+}
+func (m *DryRunBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *DryRunBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunBlockRequest.Merge(m, src)
+}
+func (m *DryRunBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunBlockRequest.DiscardUnknown(m)
 }
 
-func (m *TxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_DryRunBlockRequest proto.InternalMessageInfo
+
+func (m *DryRunBlockRequest) GetTxs() [][]byte {
+	if m != nil {
+		return m.Txs
+	}
+	return nil
 }
 
-func (m *TxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxId) > 0 {
-		i -= len(m.TxId)
-		copy(dAtA[i:], m.TxId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TxId)))
-		i--
-		dAtA[i] = 0xa
+// DryRunBlockResponse is the response type for the DryRunBlock gRPC method.
+type DryRunBlockResponse struct {
+	// included_txs are the transactions that would be included in the block, in
+	// block order.
+	IncludedTxs []*IncludedTx `protobuf:"bytes,1,rep,name=included_txs,json=includedTxs,proto3" json:"included_txs,omitempty"`
+	// dropped_txs are the transactions that would not be included in the block.
+	DroppedTxs []*DroppedTx `protobuf:"bytes,2,rep,name=dropped_txs,json=droppedTxs,proto3" json:"dropped_txs,omitempty"`
+	// square_size is the width of the original data square.
+	SquareSize uint64 `protobuf:"varint,3,opt,name=square_size,json=squareSize,proto3" json:"square_size,omitempty"`
+	// data_root is the data root of the block.
+	DataRoot []byte `protobuf:"bytes,4,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+}
+
+func (m *DryRunBlockResponse) Reset()         { *m = DryRunBlockResponse{} }
+func (m *DryRunBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*DryRunBlockResponse) ProtoMessage()    {}
+func (*DryRunBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{8}
+}
+func (m *DryRunBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DryRunBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DryRunBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *DryRunBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DryRunBlockResponse.Merge(m, src)
+}
+func (m *DryRunBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DryRunBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DryRunBlockResponse.DiscardUnknown(m)
 }
 
-func (m *TxStatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DryRunBlockResponse proto.InternalMessageInfo
+
+func (m *DryRunBlockResponse) GetIncludedTxs() []*IncludedTx {
+	if m != nil {
+		return m.IncludedTxs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *DryRunBlockResponse) GetDroppedTxs() []*DroppedTx {
+	if m != nil {
+		return m.DroppedTxs
+	}
+	return nil
 }
 
-func (m *TxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Status) > 0 {
-		i -= len(m.Status)
-		copy(dAtA[i:], m.Status)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
-		i--
-		dAtA[i] = 0x2a
+func (m *DryRunBlockResponse) GetSquareSize() uint64 {
+	if m != nil {
+		return m.SquareSize
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *DryRunBlockResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
 	}
-	if m.ExecutionCode != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionCode))
-		i--
-		dAtA[i] = 0x18
+	return nil
+}
+
+// IncludedTx is a transaction that would be included in the block.
+type IncludedTx struct {
+	// index is the position of the transaction in the request.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// hash is the hash of the transaction. For BlobTxs this is the hash of the
+	// transaction without the blobs.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// blobs are the share ranges of the blobs of the transaction, in the order
+	// of the blobs in the BlobTx.
+	Blobs []*BlobShareRange `protobuf:"bytes,3,rep,name=blobs,proto3" json:"blobs,omitempty"`
+}
+
+func (m *IncludedTx) Reset()         { *m = IncludedTx{} }
+func (m *IncludedTx) String() string { return proto.CompactTextString(m) }
+func (*IncludedTx) ProtoMessage()    {}
+func (*IncludedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{9}
+}
+func (m *IncludedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncludedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncludedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Index != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *IncludedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncludedTx.Merge(m, src)
+}
+func (m *IncludedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncludedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncludedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncludedTx proto.InternalMessageInfo
+
+func (m *IncludedTx) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	if m.Height != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *IncludedTx) GetHash() []byte {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *IncludedTx) GetBlobs() []*BlobShareRange {
+	if m != nil {
+		return m.Blobs
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *TxStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// BlobShareRange is the range of shares a blob occupies in the original data
+// square.
+type BlobShareRange struct {
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// start is the index of the first share of the blob.
+	Start uint32 `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the index of the share after the last share of the blob.
+	End uint32 `protobuf:"varint,3,opt,name=end,proto3" json:"end,omitempty"`
+}
+
+func (m *BlobShareRange) Reset()         { *m = BlobShareRange{} }
+func (m *BlobShareRange) String() string { return proto.CompactTextString(m) }
+func (*BlobShareRange) ProtoMessage()    {}
+func (*BlobShareRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{10}
+}
+func (m *BlobShareRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlobShareRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlobShareRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.TxId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *BlobShareRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlobShareRange.Merge(m, src)
+}
+func (m *BlobShareRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlobShareRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlobShareRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlobShareRange proto.InternalMessageInfo
+
+func (m *BlobShareRange) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *BlobShareRange) GetStart() uint32 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *BlobShareRange) GetEnd() uint32 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+// DroppedTx is a transaction that would not be included in the block.
+type DroppedTx struct {
+	// index is the position of the transaction in the request.
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// hash is the hash of the transaction. It is empty if the transaction
+	// could not be parsed.
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// reason describes why the transaction was dropped.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *DroppedTx) Reset()         { *m = DroppedTx{} }
+func (m *DroppedTx) String() string { return proto.CompactTextString(m) }
+func (*DroppedTx) ProtoMessage()    {}
+func (*DroppedTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d8b070565b0dcb6, []int{11}
+}
+func (m *DroppedTx) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DroppedTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DroppedTx.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DroppedTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DroppedTx.Merge(m, src)
+}
+func (m *DroppedTx) XXX_Size() int {
+	return m.Size()
+}
+func (m *DroppedTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_DroppedTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DroppedTx proto.InternalMessageInfo
+
+func (m *DroppedTx) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *DroppedTx) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *DroppedTx) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TxStatusRequest)(nil), "celestia.core.v1.tx.TxStatusRequest")
+	proto.RegisterType((*TxStatusResponse)(nil), "celestia.core.v1.tx.TxStatusResponse")
+	proto.RegisterType((*TxStatusesRequest)(nil), "celestia.core.v1.tx.TxStatusesRequest")
+	proto.RegisterType((*TxStatusesResponse)(nil), "celestia.core.v1.tx.TxStatusesResponse")
+	proto.RegisterType((*TxStatusResult)(nil), "celestia.core.v1.tx.TxStatusResult")
+	proto.RegisterType((*SubscribeTxStatusRequest)(nil), "celestia.core.v1.tx.SubscribeTxStatusRequest")
+	proto.RegisterType((*SubscribeTxStatusResponse)(nil), "celestia.core.v1.tx.SubscribeTxStatusResponse")
+	proto.RegisterType((*DryRunBlockRequest)(nil), "celestia.core.v1.tx.DryRunBlockRequest")
+	proto.RegisterType((*DryRunBlockResponse)(nil), "celestia.core.v1.tx.DryRunBlockResponse")
+	proto.RegisterType((*IncludedTx)(nil), "celestia.core.v1.tx.IncludedTx")
+	proto.RegisterType((*BlobShareRange)(nil), "celestia.core.v1.tx.BlobShareRange")
+	proto.RegisterType((*DroppedTx)(nil), "celestia.core.v1.tx.DroppedTx")
+}
+
+func init() { proto.RegisterFile("celestia/core/v1/tx/tx.proto", fileDescriptor_7d8b070565b0dcb6) }
+
+var fileDescriptor_7d8b070565b0dcb6 = []byte{
+	// 762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4b, 0x6f, 0xeb, 0x44,
+	0x14, 0x8e, 0xf3, 0x52, 0x73, 0x92, 0x5b, 0xee, 0x9d, 0x42, 0x65, 0x42, 0x70, 0x2b, 0xd3, 0x47,
+	0x54, 0xa9, 0x36, 0x0d, 0x2b, 0x8a, 0x50, 0xa5, 0xd0, 0x4d, 0x17, 0x6c, 0x26, 0x01, 0x21, 0x36,
+	0xd1, 0xd8, 0x1e, 0x25, 0x16, 0xa9, 0xc7, 0x9d, 0x19, 0x57, 0x6e, 0x51, 0x37, 0x6c, 0x58, 0x82,
+	0x84, 0x10, 0x7f, 0x89, 0x65, 0x25, 0x36, 0x5d, 0xa2, 0x96, 0x1f, 0x82, 0x3c, 0x7e, 0x24, 0x2d,
+	0x6e, 0x53, 0x16, 0x91, 0xce, 0xfb, 0x7c, 0xdf, 0xf1, 0x39, 0x13, 0xe8, 0xb9, 0x74, 0x4e, 0x85,
+	0xf4, 0x89, 0xed, 0x32, 0x4e, 0xed, 0xcb, 0x23, 0x5b, 0xc6, 0xb6, 0x8c, 0xad, 0x90, 0x33, 0xc9,
+	0xd0, 0x46, 0xee, 0xb5, 0x12, 0xaf, 0x75, 0x79, 0x64, 0xc9, 0xb8, 0xdb, 0x9b, 0x32, 0x36, 0x9d,
+	0x53, 0x9b, 0x84, 0xbe, 0x4d, 0x82, 0x80, 0x49, 0x22, 0x7d, 0x16, 0x88, 0x34, 0xc5, 0xdc, 0x83,
+	0xf7, 0xc6, 0xf1, 0x48, 0x12, 0x19, 0x09, 0x4c, 0x2f, 0x22, 0x2a, 0x24, 0xda, 0x80, 0x86, 0x8c,
+	0x27, 0xbe, 0xa7, 0x6b, 0xdb, 0x5a, 0xbf, 0x85, 0xeb, 0x32, 0x3e, 0xf3, 0xcc, 0xdf, 0x35, 0x78,
+	0xbb, 0x08, 0x14, 0x21, 0x0b, 0x04, 0x45, 0x9b, 0xd0, 0x9c, 0x51, 0x7f, 0x3a, 0x93, 0x2a, 0xb4,
+	0x86, 0x33, 0x0d, 0xbd, 0x0f, 0x0d, 0x3f, 0xf0, 0x68, 0xac, 0x57, 0xb7, 0xb5, 0xfe, 0x1b, 0x9c,
+	0x2a, 0x68, 0x17, 0xd6, 0x69, 0x4c, 0xdd, 0x28, 0x69, 0x3f, 0x71, 0x99, 0x47, 0xf5, 0x9a, 0x72,
+	0xbf, 0x29, 0xac, 0x5f, 0x31, 0x8f, 0x26, 0xc9, 0x94, 0x73, 0xc6, 0xf5, 0xba, 0x6a, 0x9f, 0x2a,
+	0x49, 0x2b, 0xa1, 0x9a, 0xeb, 0x0d, 0x65, 0xce, 0x34, 0xf3, 0x00, 0xde, 0xe5, 0xb0, 0x68, 0xc1,
+	0xe0, 0x03, 0x68, 0x2a, 0x06, 0x42, 0xd7, 0xb6, 0x6b, 0x49, 0x8d, 0x84, 0x82, 0x30, 0xbf, 0x01,
+	0xb4, 0x1c, 0x9b, 0x91, 0x38, 0x81, 0x35, 0x91, 0xd9, 0x54, 0x78, 0x7b, 0xf0, 0x89, 0x55, 0x32,
+	0x47, 0x6b, 0x89, 0x7d, 0x34, 0x97, 0xb8, 0x48, 0x32, 0x3d, 0x58, 0x7f, 0xec, 0x2b, 0x9d, 0x20,
+	0xfa, 0xb2, 0x60, 0x90, 0x4c, 0xa5, 0x3d, 0xd8, 0x5d, 0xd5, 0x45, 0xc1, 0x2b, 0x88, 0x1e, 0x81,
+	0x3e, 0x8a, 0x1c, 0xe1, 0x72, 0xdf, 0xa1, 0x4f, 0xbf, 0xd8, 0x33, 0x7c, 0xbf, 0x83, 0x0f, 0x4b,
+	0x52, 0x32, 0xda, 0x5f, 0x40, 0x93, 0x2b, 0xb4, 0x0a, 0xe4, 0x2b, 0x49, 0x67, 0x29, 0xe6, 0x1e,
+	0xa0, 0x53, 0x7e, 0x85, 0xa3, 0x60, 0x38, 0x67, 0xee, 0x0f, 0x39, 0x8c, 0xb7, 0x50, 0x93, 0x71,
+	0x8a, 0xa1, 0x83, 0x13, 0xd1, 0xbc, 0xd3, 0x60, 0xe3, 0x51, 0x60, 0xd6, 0x7c, 0x08, 0x1d, 0x3f,
+	0x70, 0xe7, 0x91, 0x47, 0xbd, 0x49, 0x9e, 0xd2, 0x1e, 0x6c, 0x95, 0x42, 0x38, 0xcb, 0x02, 0xc7,
+	0x31, 0x6e, 0xfb, 0x85, 0x2c, 0xd0, 0x09, 0xb4, 0x3d, 0xce, 0xc2, 0x30, 0x2b, 0x51, 0x55, 0x25,
+	0x8c, 0xd2, 0x12, 0xa7, 0x69, 0xdc, 0x38, 0xc6, 0xe0, 0xe5, 0xa2, 0x40, 0x5b, 0xd0, 0x16, 0x17,
+	0x11, 0xe1, 0x74, 0x22, 0xfc, 0xeb, 0x74, 0x19, 0xeb, 0x18, 0x52, 0xd3, 0xc8, 0xbf, 0xa6, 0xe8,
+	0x23, 0x68, 0x79, 0x44, 0x92, 0x09, 0x67, 0x4c, 0xaa, 0x6d, 0xec, 0xe0, 0xb5, 0xc4, 0x80, 0x19,
+	0x93, 0xe6, 0x05, 0xc0, 0x02, 0xd9, 0x62, 0xe3, 0xb5, 0xe5, 0x8d, 0x47, 0x50, 0x9f, 0x11, 0x31,
+	0x53, 0x1f, 0xbc, 0x83, 0x95, 0x8c, 0x3e, 0x87, 0x86, 0x33, 0x67, 0x8e, 0xd0, 0x6b, 0x2f, 0xec,
+	0xda, 0x70, 0xce, 0x9c, 0xd1, 0x8c, 0x70, 0x8a, 0x49, 0x30, 0xa5, 0x38, 0xcd, 0x30, 0xbf, 0x85,
+	0xf5, 0xc7, 0x0e, 0xd4, 0x83, 0x56, 0x40, 0xce, 0xa9, 0x08, 0x89, 0x4b, 0x55, 0xeb, 0x0e, 0x5e,
+	0x18, 0x12, 0x50, 0x42, 0x12, 0x2e, 0xf3, 0x33, 0x54, 0x4a, 0xf2, 0x95, 0x68, 0xe0, 0x65, 0xb7,
+	0x97, 0x88, 0xe6, 0xd7, 0xd0, 0x2a, 0x26, 0xf4, 0x3f, 0x98, 0x6c, 0x26, 0x1b, 0x44, 0x04, 0x0b,
+	0x54, 0xad, 0x16, 0xce, 0xb4, 0xc1, 0x1f, 0x75, 0xa8, 0x8e, 0x63, 0x74, 0x03, 0x6b, 0xf9, 0xf6,
+	0xa0, 0x9d, 0x15, 0xcb, 0xa5, 0xf6, 0xa7, 0xfb, 0xba, 0x8b, 0x30, 0x77, 0x7e, 0xfa, 0xeb, 0x9f,
+	0xdf, 0xaa, 0x06, 0xea, 0xd9, 0x65, 0x8f, 0xe1, 0x8f, 0xea, 0x12, 0x6e, 0xd0, 0xcf, 0x1a, 0xc0,
+	0xe2, 0xda, 0xd1, 0xde, 0x8b, 0xb5, 0x8b, 0xa7, 0xa3, 0xbb, 0xbf, 0x32, 0x2e, 0x43, 0xd1, 0x57,
+	0x28, 0x4c, 0xf3, 0xe3, 0x52, 0x14, 0xf9, 0xe3, 0x70, 0xac, 0x1d, 0xa0, 0x4b, 0x78, 0xf7, 0x9f,
+	0x33, 0x44, 0x87, 0xa5, 0x7d, 0x9e, 0xbb, 0xf0, 0xae, 0xf5, 0xda, 0xf0, 0x0c, 0x5d, 0xe5, 0x53,
+	0x0d, 0xfd, 0xa2, 0x41, 0x7b, 0xe9, 0xf8, 0xd0, 0xfe, 0x33, 0xb7, 0xf1, 0xf4, 0x8e, 0xbb, 0xfd,
+	0xd5, 0x81, 0x59, 0x9b, 0x43, 0x35, 0x84, 0xfd, 0x63, 0xed, 0xc0, 0x34, 0x4b, 0xe7, 0xe0, 0xf1,
+	0xab, 0x09, 0x8f, 0x82, 0x89, 0x93, 0xa4, 0x0d, 0xcf, 0xfe, 0xbc, 0x37, 0xb4, 0xdb, 0x7b, 0x43,
+	0xfb, 0xfb, 0xde, 0xd0, 0x7e, 0x7d, 0x30, 0x2a, 0xb7, 0x0f, 0x46, 0xe5, 0xee, 0xc1, 0xa8, 0x7c,
+	0x6f, 0x4f, 0x7d, 0x39, 0x8b, 0x1c, 0xcb, 0x65, 0xe7, 0x45, 0x1d, 0xc6, 0xa7, 0x85, 0x7c, 0x48,
+	0xc2, 0xd0, 0x4e, 0x7e, 0x53, 0x1e, 0xba, 0xb6, 0x8c, 0x9d, 0xa6, 0xfa, 0xfb, 0xfa, 0xec, 0xdf,
+	0x01, 0x00, 0xe1, 0x49, 0x51, 0xe9, 0x11, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TxClient is the client API for Tx service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TxClient interface {
+	// TxStatus returns the status of a transaction. There are four possible
+	// states:
+	// - Committed
+	// - Pending
+	// - Evicted
+	// - Unknown
+	TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error)
+	// TxStatuses returns the status of multiple transactions in a single call.
+	TxStatuses(ctx context.Context, in *TxStatusesRequest, opts ...grpc.CallOption) (*TxStatusesResponse, error)
+	// SubscribeTxStatus streams the status of the provided transactions. The
+	// current status of every transaction is sent first, followed by an update
+	// every time the status of a transaction changes. The stream ends once all
+	// of the transactions are committed, rejected or evicted.
+	SubscribeTxStatus(ctx context.Context, in *SubscribeTxStatusRequest, opts ...grpc.CallOption) (Tx_SubscribeTxStatusClient, error)
+	// DryRunBlock builds a block from the provided transactions against the
+	// latest state in the same way a proposer does, without committing
+	// anything. It returns which transactions would be included or dropped and
+	// the layout of the resulting data square.
+	DryRunBlock(ctx context.Context, in *DryRunBlockRequest, opts ...grpc.CallOption) (*DryRunBlockResponse, error)
+}
+
+type txClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewTxClient(cc grpc1.ClientConn) TxClient {
+	return &txClient{cc}
+}
+
+func (c *txClient) TxStatus(ctx context.Context, in *TxStatusRequest, opts ...grpc.CallOption) (*TxStatusResponse, error) {
+	out := new(TxStatusResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.tx.Tx/TxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txClient) TxStatuses(ctx context.Context, in *TxStatusesRequest, opts ...grpc.CallOption) (*TxStatusesResponse, error) {
+	out := new(TxStatusesResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.tx.Tx/TxStatuses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *txClient) SubscribeTxStatus(ctx context.Context, in *SubscribeTxStatusRequest, opts ...grpc.CallOption) (Tx_SubscribeTxStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Tx_serviceDesc.Streams[0], "/celestia.core.v1.tx.Tx/SubscribeTxStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &txSubscribeTxStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Tx_SubscribeTxStatusClient interface {
+	Recv() (*SubscribeTxStatusResponse, error)
+	grpc.ClientStream
+}
+
+type txSubscribeTxStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *txSubscribeTxStatusClient) Recv() (*SubscribeTxStatusResponse, error) {
+	m := new(SubscribeTxStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *txClient) DryRunBlock(ctx context.Context, in *DryRunBlockRequest, opts ...grpc.CallOption) (*DryRunBlockResponse, error) {
+	out := new(DryRunBlockResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.tx.Tx/DryRunBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TxServer is the server API for Tx service.
+type TxServer interface {
+	// TxStatus returns the status of a transaction. There are four possible
+	// states:
+	// - Committed
+	// - Pending
+	// - Evicted
+	// - Unknown
+	TxStatus(context.Context, *TxStatusRequest) (*TxStatusResponse, error)
+	// TxStatuses returns the status of multiple transactions in a single call.
+	TxStatuses(context.Context, *TxStatusesRequest) (*TxStatusesResponse, error)
+	// SubscribeTxStatus streams the status of the provided transactions. The
+	// current status of every transaction is sent first, followed by an update
+	// every time the status of a transaction changes. The stream ends once all
+	// of the transactions are committed, rejected or evicted.
+	SubscribeTxStatus(*SubscribeTxStatusRequest, Tx_SubscribeTxStatusServer) error
+	// DryRunBlock builds a block from the provided transactions against the
+	// latest state in the same way a proposer does, without committing
+	// anything. It returns which transactions would be included or dropped and
+	// the layout of the resulting data square.
+	DryRunBlock(context.Context, *DryRunBlockRequest) (*DryRunBlockResponse, error)
+}
+
+// UnimplementedTxServer can be embedded to have forward compatible implementations.
+type UnimplementedTxServer struct {
+}
+
+func (*UnimplementedTxServer) TxStatus(ctx context.Context, req *TxStatusRequest) (*TxStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+}
+func (*UnimplementedTxServer) TxStatuses(ctx context.Context, req *TxStatusesRequest) (*TxStatusesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatuses not implemented")
+}
+func (*UnimplementedTxServer) SubscribeTxStatus(req *SubscribeTxStatusRequest, srv Tx_SubscribeTxStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTxStatus not implemented")
+}
+func (*UnimplementedTxServer) DryRunBlock(ctx context.Context, req *DryRunBlockRequest) (*DryRunBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DryRunBlock not implemented")
+}
+
+func RegisterTxServer(s grpc1.Server, srv TxServer) {
+	s.RegisterService(&_Tx_serviceDesc, srv)
+}
+
+func _Tx_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServer).TxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.tx.Tx/TxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServer).TxStatus(ctx, req.(*TxStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tx_TxStatuses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatusesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServer).TxStatuses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.tx.Tx/TxStatuses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServer).TxStatuses(ctx, req.(*TxStatusesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Tx_SubscribeTxStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTxStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TxServer).SubscribeTxStatus(m, &txSubscribeTxStatusServer{stream})
+}
+
+type Tx_SubscribeTxStatusServer interface {
+	Send(*SubscribeTxStatusResponse) error
+	grpc.ServerStream
+}
+
+type txSubscribeTxStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *txSubscribeTxStatusServer) Send(m *SubscribeTxStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Tx_DryRunBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DryRunBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxServer).DryRunBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.tx.Tx/DryRunBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxServer).DryRunBlock(ctx, req.(*DryRunBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Tx_serviceDesc = _Tx_serviceDesc
+var _Tx_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.tx.Tx",
+	HandlerType: (*TxServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TxStatus",
+			Handler:    _Tx_TxStatus_Handler,
+		},
+		{
+			MethodName: "TxStatuses",
+			Handler:    _Tx_TxStatuses_Handler,
+		},
+		{
+			MethodName: "DryRunBlock",
+			Handler:    _Tx_DryRunBlock_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTxStatus",
+			Handler:       _Tx_SubscribeTxStatus_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "celestia/core/v1/tx/tx.proto",
+}
+
+func (m *TxStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ExecutionCode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExecutionCode))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatusesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for iNdEx := len(m.TxIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxIds[iNdEx])
+			copy(dAtA[i:], m.TxIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TxIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatusesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatusResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatusResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatusResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeTxStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeTxStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeTxStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for iNdEx := len(m.TxIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TxIds[iNdEx])
+			copy(dAtA[i:], m.TxIds[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TxIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeTxStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeTxStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeTxStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Result != nil {
+		{
+			size, err := m.Result.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRunBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for iNdEx := len(m.Txs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Txs[iNdEx])
+			copy(dAtA[i:], m.Txs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Txs[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DryRunBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DryRunBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DryRunBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SquareSize != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SquareSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DroppedTxs) > 0 {
+		for iNdEx := len(m.DroppedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DroppedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.IncludedTxs) > 0 {
+		for iNdEx := len(m.IncludedTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncludedTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncludedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncludedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncludedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Blobs) > 0 {
+		for iNdEx := len(m.Blobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlobShareRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlobShareRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlobShareRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.End != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Start != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Start))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DroppedTx) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DroppedTx) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DroppedTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *TxStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTx(uint64(m.Height))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.ExecutionCode != 0 {
+		n += 1 + sovTx(uint64(m.ExecutionCode))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *TxStatusesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for _, s := range m.TxIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxStatusesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxStatusResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeTxStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TxIds) > 0 {
+		for _, s := range m.TxIds {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SubscribeTxStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Result != nil {
+		l = m.Result.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *DryRunBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Txs) > 0 {
+		for _, b := range m.Txs {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DryRunBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncludedTxs) > 0 {
+		for _, e := range m.IncludedTxs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DroppedTxs) > 0 {
+		for _, e := range m.DroppedTxs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SquareSize != 0 {
+		n += 1 + sovTx(uint64(m.SquareSize))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *IncludedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Blobs) > 0 {
+		for _, e := range m.Blobs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlobShareRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Start != 0 {
+		n += 1 + sovTx(uint64(m.Start))
+	}
+	if m.End != 0 {
+		n += 1 + sovTx(uint64(m.End))
+	}
+	return n
+}
+
+func (m *DroppedTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCode", wireType)
+			}
+			m.ExecutionCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecutionCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxIds = append(m.TxIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, &TxStatusResult{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxStatusResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxStatusResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxStatusResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &TxStatusResponse{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeTxStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeTxStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeTxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxIds = append(m.TxIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeTxStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeTxStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeTxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Result", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Result == nil {
+				m.Result = &TxStatusResult{}
+			}
+			if err := m.Result.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryRunBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Txs", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Txs = append(m.Txs, make([]byte, postIndex-iNdEx))
+			copy(m.Txs[len(m.Txs)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DryRunBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DryRunBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DryRunBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncludedTxs = append(m.IncludedTxs, &IncludedTx{})
+			if err := m.IncludedTxs[len(m.IncludedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DroppedTxs = append(m.DroppedTxs, &DroppedTx{})
+			if err := m.DroppedTxs[len(m.DroppedTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareSize", wireType)
+			}
+			m.SquareSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SquareSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *TxStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovTx(uint64(m.Height))
-	}
-	if m.Index != 0 {
-		n += 1 + sovTx(uint64(m.Index))
-	}
-	if m.ExecutionCode != 0 {
-		n += 1 + sovTx(uint64(m.ExecutionCode))
-	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Status)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *IncludedTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -443,17 +2727,36 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: IncludedTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IncludedTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -463,23 +2766,59 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxId = string(dAtA[iNdEx:postIndex])
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blobs = append(m.Blobs, &BlobShareRange{})
+			if err := m.Blobs[len(m.Blobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -502,7 +2841,7 @@ func (m *TxStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *BlobShareRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -525,17 +2864,17 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TxStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlobShareRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlobShareRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -545,16 +2884,31 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
 			}
-			m.Index = 0
+			m.Start = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -564,16 +2918,16 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Index |= uint32(b&0x7F) << shift
+				m.Start |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			m.ExecutionCode = 0
+			m.End = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -583,16 +2937,85 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ExecutionCode |= uint32(b&0x7F) << shift
+				m.End |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DroppedTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DroppedTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DroppedTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -602,27 +3025,29 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -650,7 +3075,7 @@ func (m *TxStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -48,6 +48,8 @@ import (
 	"github.com/celestiaorg/celestia-app/v4/x/minfee"
 	minfeetypes "github.com/celestiaorg/celestia-app/v4/x/minfee/types"
 	minttypes "github.com/celestiaorg/celestia-app/v4/x/mint/types"
+	"github.com/celestiaorg/celestia-app/v4/x/nsregistry"
+	nsregistrytypes "github.com/celestiaorg/celestia-app/v4/x/nsregistry/types"
 	"github.com/celestiaorg/celestia-app/v4/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v4/x/signal/types"
 )
@@ -75,6 +77,7 @@ var ModuleEncodingRegisters = []module.AppModuleBasic{
 	blob.AppModule{},
 	signal.AppModule{},
 	minfee.AppModule{},
+	nsregistry.AppModule{},
 	packetforward.AppModuleBasic{},
 	consensus.AppModuleBasic{},
 	upgrade.AppModuleBasic{},
@@ -131,6 +134,7 @@ func (app *App) setModuleOrder() {
 		vestingtypes.ModuleName,
 		signaltypes.ModuleName,
 		minfeetypes.ModuleName,
+		nsregistrytypes.ModuleName,
 		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
 	)
@@ -158,6 +162,7 @@ func (app *App) setModuleOrder() {
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		blobtypes.ModuleName,
+		nsregistrytypes.ModuleName,
 		vestingtypes.ModuleName,
 		feegrant.ModuleName,
 		paramstypes.ModuleName,
@@ -198,5 +203,6 @@ func allStoreKeys() []string {
 		circuittypes.StoreKey,     // added in v4
 		hyperlanetypes.ModuleName, // added in v4
 		warptypes.ModuleName,      // added in v4
+		nsregistrytypes.StoreKey,  // added in v4
 	}
 }
@@ -27,6 +27,7 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.BlobKeeper,
+		app.NsRegistryKeeper,
 		app.FeeGrantKeeper,
 		app.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -36,13 +37,15 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 		app.GovParamFilters(),
 	)
 
-	// Filter out invalid transactions.
-	txs := FilterTxs(app.Logger(), ctx, handler, app.encodingConfig.TxConfig, req.Txs)
+	maxSquareSize := app.MaxEffectiveSquareSize(ctx)
+
+	// Filter out invalid transactions and order the rest by fee priority.
+	txs := FilterTxs(app.Logger(), ctx, handler, app.encodingConfig.TxConfig, req.Txs, maxSquareSize)
 
 	// Build the square from the set of valid and prioritised transactions.
 	// The txs returned are the ones used in the square and block.
 	var dataSquare square.Square
-	dataSquare, txs, err := square.Build(txs, app.MaxEffectiveSquareSize(ctx), appconsts.SubtreeRootThreshold)
+	dataSquare, txs, err := square.Build(txs, maxSquareSize, appconsts.SubtreeRootThreshold)
 	if err != nil {
 		panic(err)
 	}
@@ -50,7 +53,8 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 	// Erasure encode the data square to create the extended data square (eds).
 	// Note: uses the nmt wrapper to construct the tree. See
 	// pkg/wrapper/nmt_wrapper.go for more information.
-	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	shares := share.ToBytes(dataSquare)
+	eds, err := da.ExtendShares(shares)
 	if err != nil {
 		app.Logger().Error("failure to erasure the data square while creating a proposal block", "error", err.Error())
 		panic(err)
@@ -62,6 +66,10 @@ func (app *App) PrepareProposalHandler(ctx sdk.Context, req *abci.RequestPrepare
 		panic(err)
 	}
 
+	// Cache the eds so that ProcessProposal and proof queries for this block
+	// don't need to erasure code the square again.
+	app.edsCache.Add(shares, eds, dah)
+
 	// Tendermint doesn't need to use any of the erasure data because only the
 	// protobuf encoded version of the block data is gossiped. Therefore, the
 	// eds is not returned here.
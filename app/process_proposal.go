@@ -7,15 +7,14 @@ import (
 
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
-	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/share"
-	blobtx "github.com/celestiaorg/go-square/v2/tx"
 
 	"github.com/celestiaorg/celestia-app/v4/app/ante"
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/proposal"
 	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v4/pkg/da"
 	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
@@ -25,25 +24,34 @@ const rejectedPropBlockLog = "Rejected proposal block:"
 
 func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcessProposal) (resp *abci.ResponseProcessProposal, err error) {
 	defer telemetry.MeasureSince(time.Now(), "process_proposal")
+	// txIndex is the index of the transaction that is being processed or -1
+	// if no single transaction is being processed.
+	txIndex := -1
 	// In the case of a panic resulting from an unexpected condition, it is
 	// better for the liveness of the network to catch it, log an error, and
 	// vote nil rather than crashing the node.
 	defer func() {
 		if err := recover(); err != nil {
-			logInvalidPropBlock(app.Logger(), ctx.BlockHeader(), fmt.Sprintf("caught panic: %v", err))
 			telemetry.IncrCounter(1, "process_proposal", "panics")
-			resp = reject()
+			resp = app.rejectProposal(ctx, proposal.RejectionCode_REJECTION_CODE_PANIC, txIndex, fmt.Sprintf("caught panic: %v", err), nil)
 		}
 	}()
 
+	// Run the stateless checks for all transactions concurrently. Signatures
+	// that are verified here are recorded in the cache and skipped by the
+	// ante handler below.
+	sigCache := ante.NewSignatureCache()
+	verifiedTxs := app.preVerifyTxs(ctx, req.Txs, sigCache)
+
 	// Create the anteHandler that is used to check the validity of
 	// transactions. All transactions need to be equally validated here
 	// so that the nonce number is always correctly incremented (which
 	// may affect the validity of future transactions).
-	handler := ante.NewAnteHandler(
+	handler := ante.NewAnteHandlerWithSignatureCache(
 		app.AccountKeeper,
 		app.BankKeeper,
 		app.BlobKeeper,
+		app.NsRegistryKeeper,
 		app.FeeGrantKeeper,
 		app.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -51,107 +59,73 @@ func (app *App) ProcessProposalHandler(ctx sdk.Context, req *abci.RequestProcess
 		app.MinFeeKeeper,
 		&app.CircuitKeeper,
 		app.GovParamFilters(),
+		sigCache,
 	)
-	blockHeader := ctx.BlockHeader()
-
-	// iterate over all txs and ensure that all blobTxs are valid, PFBs are correctly signed and non
-	// blobTxs have no PFBs present
-	for idx, rawTx := range req.Txs {
-		tx := rawTx
-		blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
-		if isBlobTx {
-			if err != nil {
-				logInvalidPropBlockError(app.Logger(), blockHeader, fmt.Sprintf("err with blob tx %d", idx), err)
-				return reject(), nil
-			}
-			tx = blobTx.Tx
+	// iterate over all txs in order and reject the block at the first invalid
+	// tx. The stateless checks have already been performed so only the ante
+	// handler, which increments the nonces, needs to run sequentially.
+	for idx, vtx := range verifiedTxs {
+		txIndex = idx
+		if vtx.panicked != nil {
+			panic(vtx.panicked)
+		}
+		if vtx.reason != "" {
+			return app.rejectProposal(ctx, vtx.code, idx, vtx.reason, vtx.err), nil
 		}
-		sdkTx, err := app.encodingConfig.TxConfig.TxDecoder()(tx)
 
 		// Set the tx bytes in the context for app version v3 and greater
-		ctx = ctx.WithTxBytes(tx)
+		ctx = ctx.WithTxBytes(vtx.tx)
 
+		// we need to increment the sequence for every transaction so that
+		// the signature check of the following transactions is accurate.
+		// For blob txs this also validates the PFB signature.
+		ctx, err = handler(ctx, vtx.sdkTx, false)
 		if err != nil {
-			// An error here means that a tx was included in the block that is not decodable.
-			logInvalidPropBlock(app.Logger(), blockHeader, fmt.Sprintf("tx %d is not decodable", idx))
-			return reject(), nil
-		}
-
-		// handle non-blob transactions first
-		if !isBlobTx {
-			msgs := sdkTx.GetMsgs()
-
-			_, has := hasPFB(msgs)
-			if has {
-				// A non-blob tx has a PFB, which is invalid
-				logInvalidPropBlock(app.Logger(), blockHeader, fmt.Sprintf("tx %d has PFB but is not a blob tx", idx))
-				return reject(), nil
-			}
-
-			// we need to increment the sequence for every transaction so that
-			// the signature check below is accurate. this error only gets hit
-			// if the account in question doesn't exist.
-			ctx, err = handler(ctx, sdkTx, false)
-			if err != nil {
-				logInvalidPropBlockError(app.Logger(), blockHeader, "failure to increment sequence", err)
-				return reject(), nil
+			reason := "failure to increment sequence"
+			if vtx.isBlobTx {
+				reason = "invalid PFB signature"
 			}
-
-			// we do not need to perform further checks on this transaction,
-			// since it has no PFB
-			continue
+			return app.rejectProposal(ctx, proposal.RejectionCode_REJECTION_CODE_INVALID_TX, idx, reason, err), nil
 		}
-
-		// validate the blobTx. This is the same validation used in CheckTx ensuring
-		// - there is one PFB
-		// - that each blob has a valid namespace
-		// - that the sizes match
-		// - that the namespaces match between blob and PFB
-		// - that the share commitment is correct
-		if err := blobtypes.ValidateBlobTx(app.encodingConfig.TxConfig, blobTx, appconsts.SubtreeRootThreshold, appconsts.LatestVersion); err != nil {
-			logInvalidPropBlockError(app.Logger(), blockHeader, fmt.Sprintf("invalid blob tx %d", idx), err)
-			return reject(), nil
-		}
-
-		// validated the PFB signature
-		ctx, err = handler(ctx, sdkTx, false)
-		if err != nil {
-			logInvalidPropBlockError(app.Logger(), blockHeader, "invalid PFB signature", err)
-			return reject(), nil
-		}
-
 	}
+	txIndex = -1
 
 	dataSquare, err := square.Construct(req.Txs, app.MaxEffectiveSquareSize(ctx), appconsts.SubtreeRootThreshold)
 	if err != nil {
-		logInvalidPropBlockError(app.Logger(), blockHeader, "failure to compute data square from transactions:", err)
-		return reject(), nil
+		return app.rejectProposal(ctx, proposal.RejectionCode_REJECTION_CODE_INVALID_SQUARE, -1, "failure to compute data square from transactions:", err), nil
 	}
 
 	// Assert that the square size stated by the proposer is correct
 	if uint64(dataSquare.Size()) != req.SquareSize {
-		logInvalidPropBlock(app.Logger(), blockHeader, "proposed square size differs from calculated square size")
-		return reject(), nil
+		return app.rejectProposal(ctx, proposal.RejectionCode_REJECTION_CODE_BAD_SQUARE_SIZE, -1, "proposed square size differs from calculated square size", nil), nil
 	}
 
-	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), blockHeader, "failure to erasure the data square", err)
-		return reject(), nil
-	}
+	// The proposer has already erasure coded the square in PrepareProposal so
+	// the eds and dah can be reused if they are cached for these shares.
+	shares := share.ToBytes(dataSquare)
+	eds, dah, cached := app.edsCache.Get(req.DataRootHash, shares)
+	if !cached {
+		eds, err = da.ExtendShares(shares)
+		if err != nil {
+			return app.rejectProposal(ctx, proposal.RejectionCode_REJECTION_CODE_INVALID_SQUARE, -1, "failure to erasure the data square", err), nil
+		}
 
-	dah, err := da.NewDataAvailabilityHeader(eds)
-	if err != nil {
-		logInvalidPropBlockError(app.Logger(), blockHeader, "failure to create new data availability header", err)
-		return reject(), nil
+		dah, err = da.NewDataAvailabilityHeader(eds)
+		if err != nil {
+			return app.rejectProposal(ctx, proposal.RejectionCode_REJECTION_CODE_INVALID_SQUARE, -1, "failure to create new data availability header", err), nil
+		}
 	}
 
 	// by comparing the hashes we know the computed IndexWrappers (with the share indexes of the PFB's blobs)
 	// are identical and that square layout is consistent. This also means that the share commitment rules
 	// have been followed and thus each blobs share commitment should be valid
 	if !bytes.Equal(dah.Hash(), req.DataRootHash) {
-		logInvalidPropBlock(app.Logger(), blockHeader, fmt.Sprintf("proposed data root %X differs from calculated data root %X", req.DataRootHash, dah.Hash()))
-		return reject(), nil
+		reason := fmt.Sprintf("proposed data root %X differs from calculated data root %X", req.DataRootHash, dah.Hash())
+		return app.rejectProposal(ctx, proposal.RejectionCode_REJECTION_CODE_DATA_ROOT_MISMATCH, -1, reason, nil), nil
+	}
+
+	if !cached {
+		app.edsCache.Add(shares, eds, dah)
 	}
 
 	return accept(), nil
@@ -166,26 +140,44 @@ func hasPFB(msgs []sdk.Msg) (*blobtypes.MsgPayForBlobs, bool) {
 	return nil, false
 }
 
-func logInvalidPropBlock(l log.Logger, h tmproto.Header, reason string) {
-	l.Error(
-		rejectedPropBlockLog,
-		"reason",
-		reason,
-		"proposer",
-		h.ProposerAddress,
-	)
+// rejectProposal logs and records why the proposal was rejected and returns
+// the reject response. txIndex is the index of the offending transaction or -1
+// if the rejection isn't caused by a single transaction.
+func (app *App) rejectProposal(ctx sdk.Context, code proposal.RejectionCode, txIndex int, reason string, err error) *abci.ResponseProcessProposal {
+	h := ctx.BlockHeader()
+	rejection := &proposal.RejectedProposal{
+		Height:          h.Height,
+		ProposerAddress: h.ProposerAddress,
+		Code:            code,
+		TxIndex:         int64(txIndex),
+		Reason:          reason,
+	}
+	if err != nil {
+		rejection.Error = err.Error()
+	}
+	logInvalidPropBlock(app.Logger(), rejection)
+	app.rejectedProposals.Add(rejection)
+
+	telemetry.IncrCounter(1, "process_proposal", "rejected", code.String())
+	return reject()
 }
 
-func logInvalidPropBlockError(l log.Logger, h tmproto.Header, reason string, err error) {
-	l.Error(
-		rejectedPropBlockLog,
+func logInvalidPropBlock(l log.Logger, rejection *proposal.RejectedProposal) {
+	keyVals := []any{
 		"reason",
-		reason,
+		rejection.Reason,
+		"code",
+		rejection.Code.String(),
 		"proposer",
-		h.ProposerAddress,
-		"err",
-		err.Error(),
-	)
+		rejection.ProposerAddress,
+	}
+	if rejection.TxIndex >= 0 {
+		keyVals = append(keyVals, "tx_index", rejection.TxIndex)
+	}
+	if rejection.Error != "" {
+		keyVals = append(keyVals, "err", rejection.Error)
+	}
+	l.Error(rejectedPropBlockLog, keyVals...)
 }
 
 func reject() *abci.ResponseProcessProposal {
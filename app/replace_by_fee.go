@@ -0,0 +1,322 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+
+	"cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	apperr "github.com/celestiaorg/celestia-app/v4/app/errors"
+)
+
+const (
+	// EventTypeReplaceByFee is the type of the event emitted by CheckTx when a
+	// transaction replaces a pending transaction of the same signer and
+	// sequence.
+	EventTypeReplaceByFee = "replace_by_fee"
+	// AttributeKeyReplacedTxHash is the hash of the replaced transaction.
+	AttributeKeyReplacedTxHash = "replaced_tx_hash"
+
+	// replacedTxRetention is the number of blocks for which replaced
+	// transactions are remembered so that they are evicted when the mempool
+	// rechecks them.
+	replacedTxRetention = 100
+)
+
+// txNonce identifies the transactions of a signer that use the same sequence.
+type txNonce struct {
+	signer   string
+	sequence uint64
+}
+
+// pendingTx is a transaction that passed CheckTx.
+type pendingTx struct {
+	hash     []byte
+	priority int64
+}
+
+// replacedTx is a transaction that was replaced by fee.
+type replacedTx struct {
+	// height is the height at which the transaction was replaced.
+	height int64
+	nonce  txNonce
+	// replacement is the sdk transaction that replaced it.
+	replacement []byte
+}
+
+// pendingNonces indexes the transactions that passed CheckTx since the last
+// committed block by signer and sequence. The index is cleared when a block is
+// committed and repopulated as the remaining mempool transactions are
+// rechecked. It also records the replaced transactions by hash and the
+// responses of the replacements that were rechecked in their place since the
+// last committed block.
+type pendingNonces struct {
+	mtx       sync.Mutex
+	height    int64
+	txs       map[txNonce]pendingTx
+	replaced  map[string]replacedTx
+	rechecked map[string]*abci.ResponseCheckTx
+}
+
+func newPendingNonces() *pendingNonces {
+	return &pendingNonces{
+		txs:       make(map[txNonce]pendingTx),
+		replaced:  make(map[string]replacedTx),
+		rechecked: make(map[string]*abci.ResponseCheckTx),
+	}
+}
+
+// get returns the pending transaction using nonce as of height.
+func (p *pendingNonces) get(height int64, nonce txNonce) (pendingTx, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.reset(height)
+	tx, ok := p.txs[nonce]
+	return tx, ok
+}
+
+// set records the pending transaction using nonce as of height.
+func (p *pendingNonces) set(height int64, nonce txNonce, tx pendingTx) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.reset(height)
+	p.txs[nonce] = tx
+}
+
+// replace records the pending transaction using nonce as of height, whose
+// sdk transaction is txBytes, as the replacement of the transaction with hash
+// replacedHash.
+func (p *pendingNonces) replace(height int64, nonce txNonce, tx pendingTx, txBytes, replacedHash []byte) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.reset(height)
+	p.txs[nonce] = tx
+	p.replaced[string(replacedHash)] = replacedTx{height: height, nonce: nonce, replacement: txBytes}
+}
+
+// popReplaced returns the replacement of the transaction with the provided
+// hash, if it was replaced, and forgets about it.
+func (p *pendingNonces) popReplaced(hash []byte) (replacedTx, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	replaced, ok := p.replaced[string(hash)]
+	if ok {
+		delete(p.replaced, string(hash))
+	}
+	return replaced, ok
+}
+
+// setRechecked records the response of a replacement that was rechecked as of
+// height in place of the transaction it replaced.
+func (p *pendingNonces) setRechecked(height int64, nonce txNonce, tx pendingTx, res *abci.ResponseCheckTx) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.reset(height)
+	p.txs[nonce] = tx
+	p.rechecked[string(tx.hash)] = res
+}
+
+// popRechecked returns the response of the transaction with the provided hash
+// if it was already rechecked as of height and forgets about it.
+func (p *pendingNonces) popRechecked(height int64, hash []byte) (*abci.ResponseCheckTx, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.reset(height)
+	res, ok := p.rechecked[string(hash)]
+	if ok {
+		delete(p.rechecked, string(hash))
+	}
+	return res, ok
+}
+
+// reset clears the index if a block was committed since it was populated.
+func (p *pendingNonces) reset(height int64) {
+	if height != p.height {
+		clear(p.txs)
+		clear(p.rechecked)
+		p.height = height
+		for hash, replaced := range p.replaced {
+			if height-replaced.height > replacedTxRetention {
+				delete(p.replaced, hash)
+			}
+		}
+	}
+}
+
+// checkTxWithReplacement runs CheckTx on the sdk transaction of req. A
+// transaction that is rejected because its sequence was already used by a
+// pending transaction of the same signer is accepted as a replacement of that
+// transaction if its priority, as computed by the fee decorator, is strictly
+// higher. The response of an accepted replacement carries a replace_by_fee
+// event with the hash of the replaced transaction and the replaced
+// transaction is evicted from the mempool the next time it is rechecked, see
+// recheckReplacement. Until then, PrepareProposal drops whichever of the two
+// transactions comes second without affecting the other transactions of the
+// signer.
+func (app *App) checkTxWithReplacement(req *abci.RequestCheckTx, sdkTxBytes []byte) (*abci.ResponseCheckTx, error) {
+	if req.GetType() == abci.CheckTxType_Recheck {
+		hash := coretypes.Tx(sdkTxBytes).Hash()
+		if res, ok := app.pendingNonces.popRechecked(app.LastBlockHeight(), hash); ok {
+			return res, nil
+		}
+		if replaced, ok := app.pendingNonces.popReplaced(hash); ok {
+			return app.recheckReplacement(hash, sdkTxBytes, replaced)
+		}
+	}
+
+	res, err := app.BaseApp.CheckTx(&abci.RequestCheckTx{
+		Tx:   sdkTxBytes,
+		Type: req.GetType(),
+	})
+	if err != nil {
+		return res, err
+	}
+
+	sdkTx, nonce, ok := app.decodeTxNonce(sdkTxBytes)
+	if !ok {
+		return res, nil
+	}
+	height := app.LastBlockHeight()
+	if res.IsOK() {
+		app.pendingNonces.set(height, nonce, pendingTx{
+			hash:     coretypes.Tx(sdkTxBytes).Hash(),
+			priority: res.Priority,
+		})
+		return res, nil
+	}
+	// only new transactions can replace a pending transaction, the rechecked
+	// replacements are handled by recheckReplacement
+	if req.GetType() != abci.CheckTxType_New || !apperr.IsNonceMismatchCode(res.Code) {
+		return res, nil
+	}
+	replaced, ok := app.pendingNonces.get(height, nonce)
+	if !ok {
+		return res, nil
+	}
+
+	replacement, err := app.checkReplacement(sdkTx, sdkTxBytes, nonce)
+	if err != nil {
+		return responseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false), nil
+	}
+	if replacement.Priority <= replaced.priority {
+		err := errors.Wrapf(apperr.ErrReplacementUnderpriced, "priority %d must be higher than the priority %d of pending tx %X", replacement.Priority, replaced.priority, replaced.hash)
+		return responseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false), nil
+	}
+	app.pendingNonces.replace(height, nonce, pendingTx{
+		hash:     coretypes.Tx(sdkTxBytes).Hash(),
+		priority: replacement.Priority,
+	}, sdkTxBytes, replaced.hash)
+	replacement.Events = append(replacement.Events, abci.Event{
+		Type: EventTypeReplaceByFee,
+		Attributes: []abci.EventAttribute{
+			{Key: AttributeKeyReplacedTxHash, Value: fmt.Sprintf("%X", replaced.hash), Index: true},
+		},
+	})
+	return replacement, nil
+}
+
+// recheckReplacement rechecks the replacement of the replaced transaction
+// with the provided hash in place of the replaced transaction, which is
+// evicted. The replacement entered the mempool after the pending transactions
+// of the signer with later sequences so rechecking it in its own position
+// would leave the sequence of the signer behind them and evict them. The
+// response of the replacement is returned when the mempool rechecks it. If
+// the replacement no longer passes, the replaced transaction is rechecked
+// instead and the replacement is evicted as a nonce mismatch.
+func (app *App) recheckReplacement(hash, sdkTxBytes []byte, replaced replacedTx) (*abci.ResponseCheckTx, error) {
+	// the replacement may have been replaced in turn
+	for {
+		next, ok := app.pendingNonces.popReplaced(coretypes.Tx(replaced.replacement).Hash())
+		if !ok {
+			break
+		}
+		replaced.replacement = next.replacement
+	}
+	height := app.LastBlockHeight()
+	res, err := app.BaseApp.CheckTx(&abci.RequestCheckTx{Tx: replaced.replacement, Type: abci.CheckTxType_Recheck})
+	if err != nil || !res.IsOK() {
+		res, err := app.BaseApp.CheckTx(&abci.RequestCheckTx{Tx: sdkTxBytes, Type: abci.CheckTxType_Recheck})
+		if err == nil && res.IsOK() {
+			app.pendingNonces.set(height, replaced.nonce, pendingTx{hash: hash, priority: res.Priority})
+		}
+		return res, err
+	}
+	app.pendingNonces.setRechecked(height, replaced.nonce, pendingTx{
+		hash:     coretypes.Tx(replaced.replacement).Hash(),
+		priority: res.Priority,
+	}, res)
+	err = errors.Wrapf(apperr.ErrReplacementUnderpriced, "tx %X was replaced by fee", hash)
+	return responseCheckTxWithEvents(err, 0, 0, []abci.Event{}, false), nil
+}
+
+// checkReplacement runs the ante handler on the transaction against a branch of
+// the CheckTx state where the sequence of its signer is set to the sequence of
+// the transaction. The fees of the pending transactions of the signer,
+// including the one being replaced, are already deducted from that state so
+// the signer must be able to afford the replacement on top of them.
+func (app *App) checkReplacement(sdkTx sdk.Tx, sdkTxBytes []byte, nonce txNonce) (*abci.ResponseCheckTx, error) {
+	height := app.LastBlockHeight()
+	queryCtx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return nil, err
+	}
+	ctx, _ := app.NewContextLegacy(true, queryCtx.BlockHeader()).
+		WithBlockHeight(height + 1).
+		WithTxBytes(sdkTxBytes).
+		CacheContext()
+	ctx = ctx.WithConsensusParams(app.GetConsensusParams(ctx))
+
+	signer, err := sdk.AccAddressFromBech32(nonce.signer)
+	if err != nil {
+		return nil, err
+	}
+	acc := app.AccountKeeper.GetAccount(ctx, signer)
+	if acc == nil {
+		return nil, fmt.Errorf("account %s not found", nonce.signer)
+	}
+	if err := acc.SetSequence(nonce.sequence); err != nil {
+		return nil, err
+	}
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	newCtx, err := app.AnteHandler()(ctx, sdkTx, false)
+	if err != nil {
+		return nil, err
+	}
+	gasMeter := newCtx.GasMeter()
+	return &abci.ResponseCheckTx{
+		GasWanted: int64(gasMeter.Limit()),
+		GasUsed:   int64(gasMeter.GasConsumed()),
+		Priority:  newCtx.Priority(),
+		Events:    sdk.MarkEventsToIndex(newCtx.EventManager().ABCIEvents(), nil),
+	}, nil
+}
+
+// decodeTxNonce returns the decoded transaction along with the address and the
+// sequence of its first signer.
+func (app *App) decodeTxNonce(sdkTxBytes []byte) (sdk.Tx, txNonce, bool) {
+	sdkTx, err := app.encodingConfig.TxConfig.TxDecoder()(sdkTxBytes)
+	if err != nil {
+		return nil, txNonce{}, false
+	}
+	sigTx, ok := sdkTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, txNonce{}, false
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil || len(signers) == 0 {
+		return nil, txNonce{}, false
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil || len(sigs) == 0 {
+		return nil, txNonce{}, false
+	}
+	return sdkTx, txNonce{
+		signer:   sdk.AccAddress(signers[0]).String(),
+		sequence: sigs[0].Sequence,
+	}, true
+}
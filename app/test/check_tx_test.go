@@ -2,7 +2,9 @@ package app_test
 
 import (
 	"bytes"
+	"fmt"
 	"testing"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	coretypes "github.com/cometbft/cometbft/types"
@@ -271,3 +273,89 @@ func createSigner(t *testing.T, kr keyring.Keyring, accountName string, enc clie
 	require.NoError(t, err)
 	return signer
 }
+
+func TestCheckTxReplaceByFee(t *testing.T) {
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	accs := []string{"a"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accs...)
+	fetchedAcc := testutil.DirectQueryAccount(testApp, testfactory.GetAddress(kr, accs[0]))
+	signer := createSigner(t, kr, accs[0], enc.TxConfig, fetchedAcc.GetAccountNumber())
+
+	ns1, err := share.NewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	require.NoError(t, err)
+	blob, err := share.NewBlob(ns1, []byte("data"), appconsts.DefaultShareVersion, nil)
+	require.NoError(t, err)
+	// all the transactions are signed with the same sequence
+	pfbWithFee := func(fee uint64) []byte {
+		blobTx, _, err := signer.CreatePayForBlobs(accs[0], []*share.Blob{blob}, user.SetGasLimit(1e6), user.SetFee(fee))
+		require.NoError(t, err)
+		return blobTx
+	}
+	sdkTxHash := func(rawTx []byte) string {
+		btx, _, err := tx.UnmarshalBlobTx(rawTx)
+		require.NoError(t, err)
+		return fmt.Sprintf("%X", coretypes.Tx(btx.Tx).Hash())
+	}
+
+	original := pfbWithFee(1e4)
+	resp, err := testApp.CheckTx(&abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: original})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+
+	resp, err = testApp.CheckTx(&abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: pfbWithFee(1e4)})
+	require.NoError(t, err)
+	assert.Equal(t, apperr.ErrReplacementUnderpriced.ABCICode(), resp.Code, resp.Log)
+
+	replacement := pfbWithFee(2e4)
+	resp, err = testApp.CheckTx(&abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: replacement})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+	replacedTxHash := ""
+	for _, event := range resp.Events {
+		if event.Type != app.EventTypeReplaceByFee {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == app.AttributeKeyReplacedTxHash {
+				replacedTxHash = attr.Value
+			}
+		}
+	}
+	assert.Equal(t, sdkTxHash(original), replacedTxHash)
+
+	// the replacement must outbid the transaction it replaced, not the original
+	resp, err = testApp.CheckTx(&abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: pfbWithFee(1.5e4)})
+	require.NoError(t, err)
+	assert.Equal(t, apperr.ErrReplacementUnderpriced.ABCICode(), resp.Code, resp.Log)
+
+	// the pending transactions of the signer with a later sequence entered
+	// the mempool before the replacement
+	require.NoError(t, signer.SetSequence(accs[0], 1))
+	dependent := pfbWithFee(1e4)
+	resp, err = testApp.CheckTx(&abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: dependent})
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+
+	// the mempool rechecks the remaining transactions in order after a block
+	// is committed. The replaced transaction is evicted while the replacement
+	// takes its place so the dependent transaction stays.
+	_, err = testApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Time:   time.Now(),
+		Height: testApp.LastBlockHeight() + 1,
+	})
+	require.NoError(t, err)
+	_, err = testApp.Commit()
+	require.NoError(t, err)
+	for _, recheck := range []struct {
+		tx   []byte
+		code uint32
+	}{
+		{tx: original, code: apperr.ErrReplacementUnderpriced.ABCICode()},
+		{tx: dependent, code: abci.CodeTypeOK},
+		{tx: replacement, code: abci.CodeTypeOK},
+	} {
+		resp, err = testApp.CheckTx(&abci.RequestCheckTx{Type: abci.CheckTxType_Recheck, Tx: recheck.tx})
+		require.NoError(t, err)
+		assert.Equal(t, recheck.code, resp.Code, resp.Log)
+	}
+}
@@ -0,0 +1,72 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/app"
+	"github.com/celestiaorg/celestia-app/v4/app/encoding"
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v4/test/util"
+	"github.com/celestiaorg/celestia-app/v4/test/util/random"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+func TestDryRunBlock(t *testing.T) {
+	accounts := testfactory.GenerateAccounts(3)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSetAndMaxSquareSize(app.DefaultConsensusParams(), 8, accounts...)
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	blobTx := func(accountIndex int, size int, gasPrice float64) []byte {
+		signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, user.NewAccount(accounts[accountIndex], infos[accountIndex].AccountNum, infos[accountIndex].Sequence))
+		require.NoError(t, err)
+		blob, err := share.NewBlob(share.RandomBlobNamespace(), random.Bytes(size), appconsts.DefaultShareVersion, nil)
+		require.NoError(t, err)
+		gasLimit := blobtypes.DefaultEstimateGas([]uint32{uint32(size)})
+		tx, _, err := signer.CreatePayForBlobs(accounts[accountIndex], []*share.Blob{blob}, user.SetGasLimitAndGasPrice(gasLimit, gasPrice))
+		require.NoError(t, err)
+		return tx
+	}
+
+	// the low fee blob doesn't fit in the 8x8 square next to the high fee one
+	lowFee := blobTx(0, 45*share.ContinuationSparseShareContentSize, appconsts.DefaultMinGasPrice)
+	highFeeSize := 20 * share.ContinuationSparseShareContentSize
+	highFee := blobTx(1, highFeeSize, appconsts.DefaultMinGasPrice*10)
+	garbage := random.Bytes(100)
+
+	resp, err := testApp.DryRunBlock([][]byte{lowFee, garbage, highFee})
+	require.NoError(t, err)
+
+	require.Len(t, resp.IncludedTxs, 1)
+	included := resp.IncludedTxs[0]
+	assert.Equal(t, uint32(2), included.Index)
+	require.Len(t, included.Blobs, 1)
+	assert.Equal(t, uint32(share.SparseSharesNeeded(uint32(highFeeSize))), included.Blobs[0].End-included.Blobs[0].Start)
+
+	require.Len(t, resp.DroppedTxs, 2)
+	assert.Equal(t, uint32(0), resp.DroppedTxs[0].Index)
+	assert.Contains(t, resp.DroppedTxs[0].Reason, "does not fit")
+	assert.Equal(t, uint32(1), resp.DroppedTxs[1].Index)
+	assert.Contains(t, resp.DroppedTxs[1].Reason, "not decodable")
+
+	// the dry run produces the same block as PrepareProposal and doesn't
+	// change the state
+	prepareResp, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:    [][]byte{lowFee, highFee},
+		Height: testApp.LastBlockHeight() + 1,
+		Time:   time.Now(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{highFee}, prepareResp.Txs)
+	assert.Equal(t, prepareResp.SquareSize, resp.SquareSize)
+	assert.Equal(t, prepareResp.DataRootHash, resp.DataRoot)
+}
@@ -386,6 +386,147 @@ func TestPrepareProposalCappingNumberOfMessages(t *testing.T) {
 	}
 }
 
+func TestPrepareProposalOrdersByGasPrice(t *testing.T) {
+	accounts := testfactory.GenerateAccounts(4)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	blobTx := func(accountIndex int, sequence uint64, gasPrice float64) []byte {
+		signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, user.NewAccount(accounts[accountIndex], infos[accountIndex].AccountNum, sequence))
+		require.NoError(t, err)
+		blob, err := share.NewBlob(share.RandomBlobNamespace(), []byte{1, 2, 3}, appconsts.DefaultShareVersion, nil)
+		require.NoError(t, err)
+		gasLimit := blobtypes.DefaultEstimateGas([]uint32{uint32(len(blob.Data()))})
+		tx, _, err := signer.CreatePayForBlobs(accounts[accountIndex], []*share.Blob{blob}, user.SetGasLimitAndGasPrice(gasLimit, gasPrice))
+		require.NoError(t, err)
+		return tx
+	}
+
+	low := blobTx(0, infos[0].Sequence, appconsts.DefaultMinGasPrice)
+	high := blobTx(1, infos[1].Sequence, appconsts.DefaultMinGasPrice*10)
+	medium := blobTx(2, infos[2].Sequence, appconsts.DefaultMinGasPrice*5)
+	// the second tx of the signer has the highest gas price but must still be
+	// ordered after the first tx of the same signer.
+	firstOfSigner := blobTx(3, infos[3].Sequence, appconsts.DefaultMinGasPrice*2)
+	secondOfSigner := blobTx(3, infos[3].Sequence+1, appconsts.DefaultMinGasPrice*20)
+
+	resp, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:    [][]byte{secondOfSigner, low, firstOfSigner, medium, high},
+		Height: testApp.LastBlockHeight() + 1,
+		Time:   time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{high, medium, firstOfSigner, secondOfSigner, low}, resp.Txs)
+}
+
+func TestPrepareProposalPrefersHigherFeeBlobsWhenSquareIsFull(t *testing.T) {
+	accounts := testfactory.GenerateAccounts(4)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSetAndMaxSquareSize(app.DefaultConsensusParams(), 8, accounts...)
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	infos := queryAccountInfo(testApp, accounts, kr)
+
+	blobTx := func(accountIndex int, size int, gasPrice float64) []byte {
+		signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, user.NewAccount(accounts[accountIndex], infos[accountIndex].AccountNum, infos[accountIndex].Sequence))
+		require.NoError(t, err)
+		blob, err := share.NewBlob(share.RandomBlobNamespace(), random.Bytes(size), appconsts.DefaultShareVersion, nil)
+		require.NoError(t, err)
+		gasLimit := blobtypes.DefaultEstimateGas([]uint32{uint32(size)})
+		tx, _, err := signer.CreatePayForBlobs(accounts[accountIndex], []*share.Blob{blob}, user.SetGasLimitAndGasPrice(gasLimit, gasPrice))
+		require.NoError(t, err)
+		return tx
+	}
+
+	// a large, low fee blob that would take up most of the 8x8 square
+	largeLowFee := blobTx(0, 45*share.ContinuationSparseShareContentSize, appconsts.DefaultMinGasPrice)
+	smallHighFee := [][]byte{
+		blobTx(1, 10*share.ContinuationSparseShareContentSize, appconsts.DefaultMinGasPrice*10),
+		blobTx(2, 10*share.ContinuationSparseShareContentSize, appconsts.DefaultMinGasPrice*10),
+		blobTx(3, 10*share.ContinuationSparseShareContentSize, appconsts.DefaultMinGasPrice*10),
+	}
+
+	resp, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:    append([][]byte{largeLowFee}, smallHighFee...),
+		Height: testApp.LastBlockHeight() + 1,
+		Time:   time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, smallHighFee, resp.Txs)
+}
+
+// TestPrepareProposalKeepsEarlierBlobTxOfFailedSigner verifies that a normal tx
+// failing at a sequence doesn't drop a blob tx of the same signer with a lower
+// sequence even though normal txs are filtered first.
+func TestPrepareProposalKeepsEarlierBlobTxOfFailedSigner(t *testing.T) {
+	accounts := testfactory.GenerateAccounts(1)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	info := queryAccountInfo(testApp, accounts, kr)[0]
+
+	newSigner := func(sequence uint64) *user.Signer {
+		signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, user.NewAccount(accounts[0], info.AccountNum, sequence))
+		require.NoError(t, err)
+		return signer
+	}
+
+	blob, err := share.NewBlob(share.RandomBlobNamespace(), []byte{1, 2, 3}, appconsts.DefaultShareVersion, nil)
+	require.NoError(t, err)
+	gasLimit := blobtypes.DefaultEstimateGas([]uint32{uint32(len(blob.Data()))})
+	blobTx, _, err := newSigner(info.Sequence).CreatePayForBlobs(accounts[0], []*share.Blob{blob}, user.SetGasLimitAndGasPrice(gasLimit, appconsts.DefaultMinGasPrice))
+	require.NoError(t, err)
+
+	sendTx := func(sequence uint64) []byte {
+		addr := testfactory.GetAddress(kr, accounts[0])
+		msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10)))
+		rawTx, _, err := newSigner(sequence).CreateTx([]sdk.Msg{msg}, user.SetGasLimit(1000000), user.SetFee(10000))
+		require.NoError(t, err)
+		return rawTx
+	}
+	// the normal txs follow the blob tx so they fail the nonce check as normal
+	// txs are filtered before blob txs.
+	failingSendTx := sendTx(info.Sequence + 1)
+	skippedSendTx := sendTx(info.Sequence + 2)
+
+	resp, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:    [][]byte{failingSendTx, skippedSendTx, blobTx},
+		Height: testApp.LastBlockHeight() + 1,
+		Time:   time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{blobTx}, resp.Txs)
+}
+
+// TestPrepareProposalDropsReplacedTx verifies that of two txs of a signer
+// using the same sequence, the one with the higher fee is included and the
+// other one is dropped without dropping the next txs of the signer.
+func TestPrepareProposalDropsReplacedTx(t *testing.T) {
+	accounts := testfactory.GenerateAccounts(1)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	info := queryAccountInfo(testApp, accounts, kr)[0]
+
+	sendTx := func(sequence, fee uint64) []byte {
+		signer, err := user.NewSigner(kr, enc.TxConfig, testutil.ChainID, user.NewAccount(accounts[0], info.AccountNum, sequence))
+		require.NoError(t, err)
+		addr := testfactory.GetAddress(kr, accounts[0])
+		msg := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10)))
+		rawTx, _, err := signer.CreateTx([]sdk.Msg{msg}, user.SetGasLimit(1000000), user.SetFee(fee))
+		require.NoError(t, err)
+		return rawTx
+	}
+	original := sendTx(info.Sequence, 10000)
+	replacement := sendTx(info.Sequence, 20000)
+	next := sendTx(info.Sequence+1, 10000)
+
+	resp, err := testApp.PrepareProposal(&abci.RequestPrepareProposal{
+		Txs:    [][]byte{original, next, replacement},
+		Height: testApp.LastBlockHeight() + 1,
+		Time:   time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{replacement, next}, resp.Txs)
+}
+
 func queryAccountInfo(capp *app.App, accs []string, kr keyring.Keyring) []blobfactory.AccountInfo {
 	infos := make([]blobfactory.AccountInfo, len(accs))
 	for i, acc := range accs {
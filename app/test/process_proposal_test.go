@@ -18,6 +18,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v4/app"
 	"github.com/celestiaorg/celestia-app/v4/app/encoding"
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/proposal"
 	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v4/pkg/da"
 	"github.com/celestiaorg/celestia-app/v4/pkg/user"
@@ -197,6 +198,17 @@ func TestProcessProposal(t *testing.T) {
 			},
 			expectedResult: abci.ResponseProcessProposal_REJECT,
 		},
+		{
+			// the signature of both copies is valid against the initial
+			// state but the second copy reuses an already incremented nonce
+			name:  "duplicated blob tx",
+			input: validData(),
+			mutator: func(d *tmproto.Data) {
+				d.Txs = append(d.Txs, d.Txs[0])
+				d.Hash = calculateNewDataHash(t, d.Txs)
+			},
+			expectedResult: abci.ResponseProcessProposal_REJECT,
+		},
 		{
 			name: "tampered sequence start",
 			input: &tmproto.Data{
@@ -291,6 +303,13 @@ func TestProcessProposal(t *testing.T) {
 			})
 			require.NoError(t, err)
 			require.Equal(t, tc.expectedResult, res.Status, fmt.Sprintf("expected %v, got %v", tc.expectedResult, res.Status))
+
+			if tc.expectedResult == abci.ResponseProcessProposal_REJECT {
+				rejections := testApp.RejectedProposals().List(1)
+				require.Len(t, rejections, 1)
+				require.Equal(t, height, rejections[0].Height)
+				require.NotEqual(t, proposal.RejectionCode_REJECTION_CODE_UNSPECIFIED, rejections[0].Code)
+			}
 		})
 	}
 }
@@ -304,3 +323,24 @@ func calculateNewDataHash(t *testing.T, txs [][]byte) []byte {
 	require.NoError(t, err)
 	return dah.Hash()
 }
+
+// TestProcessProposalRecordsRejection verifies that rejecting a proposal
+// records the offending transaction.
+func TestProcessProposalRecordsRejection(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(true).WithBlockHeader(tmproto.Header{Height: 10, ProposerAddress: []byte("proposer")})
+
+	res, err := testApp.ProcessProposalHandler(ctx, &abci.RequestProcessProposal{
+		Height: 10,
+		Txs:    [][]byte{[]byte("not a transaction")},
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+
+	rejections := testApp.RejectedProposals().List(1)
+	require.Len(t, rejections, 1)
+	require.Equal(t, int64(10), rejections[0].Height)
+	require.Equal(t, []byte("proposer"), rejections[0].ProposerAddress)
+	require.Equal(t, proposal.RejectionCode_REJECTION_CODE_UNDECODABLE_TX, rejections[0].Code)
+	require.Equal(t, int64(0), rejections[0].TxIndex)
+}
@@ -0,0 +1,84 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	bankv1beta1 "cosmossdk.io/api/cosmos/bank/v1beta1"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	txconfig "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/app"
+	"github.com/celestiaorg/celestia-app/v4/app/encoding"
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v4/test/util"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testfactory"
+)
+
+// TestSignModes checks that the ante handler of the app accepts PFBs and
+// regular transactions signed in every sign mode supported by the signer.
+func TestSignModes(t *testing.T) {
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	accs := []string{"direct", "amino-json", "textual"}
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accs...)
+
+	// clients query the denom metadata from a node, here it is read directly
+	// from the bank keeper of the app.
+	metadataQueryFn := func(_ context.Context, denom string) (*bankv1beta1.Metadata, error) {
+		return txconfig.NewBankKeeperCoinMetadataQueryFn(testApp.BankKeeper)(testApp.NewContext(true), denom)
+	}
+	txConfig, err := encoding.EnableTextualSignMode(enc, metadataQueryFn)
+	require.NoError(t, err)
+
+	ns, err := share.NewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	require.NoError(t, err)
+	blob, err := share.NewBlob(ns, []byte("data"), appconsts.DefaultShareVersion, nil)
+	require.NoError(t, err)
+
+	modes := []signing.SignMode{
+		signing.SignMode_SIGN_MODE_DIRECT,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+		signing.SignMode_SIGN_MODE_TEXTUAL,
+	}
+	for i, mode := range modes {
+		t.Run(mode.String(), func(t *testing.T) {
+			acc := accs[i]
+			fetchedAcc := testutil.DirectQueryAccount(testApp, testfactory.GetAddress(kr, acc))
+			signer := createSigner(t, kr, acc, txConfig, fetchedAcc.GetAccountNumber())
+			require.NoError(t, signer.SetSignMode(mode))
+			require.Equal(t, mode, signer.SignMode())
+
+			blobTx, _, err := signer.CreatePayForBlobs(acc, []*share.Blob{blob}, user.SetGasLimit(1e6), user.SetFee(1e4))
+			require.NoError(t, err)
+			resp, err := testApp.CheckTx(&abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: blobTx})
+			require.NoError(t, err)
+			require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+			require.NoError(t, signer.IncrementSequence(acc))
+
+			msg := banktypes.NewMsgSend(signer.Account(acc).Address(), testfactory.GetAddress(kr, accs[0]), sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10)))
+			rawTx, tx, err := signer.CreateTx([]sdk.Msg{msg}, user.SetGasLimit(1e5), user.SetFee(1e3))
+			require.NoError(t, err)
+			sigs, err := tx.(authsigning.SigVerifiableTx).GetSignaturesV2()
+			require.NoError(t, err)
+			require.Equal(t, mode, sigs[0].Data.(*signing.SingleSignatureData).SignMode)
+			resp, err = testApp.CheckTx(&abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: rawTx})
+			require.NoError(t, err)
+			require.Equal(t, abci.CodeTypeOK, resp.Code, resp.Log)
+		})
+	}
+
+	t.Run("unsupported sign mode", func(t *testing.T) {
+		signer := createSigner(t, kr, accs[0], enc.TxConfig, 0)
+		require.Error(t, signer.SetSignMode(signing.SignMode_SIGN_MODE_TEXTUAL))
+		require.Equal(t, signing.SignMode_SIGN_MODE_DIRECT, signer.SignMode())
+	})
+}
@@ -0,0 +1,64 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	coretypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/app"
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	testutil "github.com/celestiaorg/celestia-app/v4/test/util"
+	"github.com/celestiaorg/celestia-app/v4/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testnode"
+)
+
+func TestSquareUtilization(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := testApp.NewUncachedContext(false, tmproto.Header{})
+	params := testApp.MinFeeKeeper.GetParams(ctx)
+	params.DynamicBlobBaseFee = true
+	testApp.MinFeeKeeper.SetParams(ctx, params)
+	maxSquareSize := testApp.MaxEffectiveSquareSize(ctx)
+
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	// blobs of different namespaces and sizes so that the square holds
+	// padding between them
+	namespaces := []share.Namespace{share.RandomBlobNamespace(), share.RandomBlobNamespace(), share.RandomBlobNamespace()}
+	txs := coretypes.Txs(blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, namespaces, []int{1000, 30000, 3000})).ToSliceOfBytes()
+
+	_, err = testApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Time:   time.Now(),
+		Height: testApp.LastBlockHeight() + 1,
+		Txs:    txs,
+	})
+	require.NoError(t, err)
+	_, err = testApp.Commit()
+	require.NoError(t, err)
+	utilization := testApp.MinFeeKeeper.GetSquareUtilization(testApp.NewUncachedContext(false, tmproto.Header{}))
+
+	// the utilization counts the shares of the square of the block up to the
+	// tail padding
+	dataSquare, err := square.Construct(txs, maxSquareSize, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	used := 0
+	for _, sh := range dataSquare {
+		if !sh.Namespace().IsTailPadding() {
+			used++
+		}
+	}
+	maxShares := int64(maxSquareSize * maxSquareSize)
+	assert.Equal(t, math.LegacyNewDec(int64(used)).QuoInt64(maxShares), utilization)
+	assert.True(t, utilization.IsPositive())
+	squareShares := int64(dataSquare.Size() * dataSquare.Size())
+	assert.True(t, utilization.LTE(math.LegacyNewDec(squareShares).QuoInt64(maxShares)), "utilization %s exceeds the square of width %d", utilization, dataSquare.Size())
+}
@@ -1,6 +1,7 @@
 package app_test
 
 import (
+	"io"
 	"sync"
 	"testing"
 	"time"
@@ -416,5 +417,27 @@ func (s *StandardSDKIntegrationTestSuite) TestGRPCQueries() {
 		})
 		require.NoError(t, err)
 		assert.Equal(t, resp.Status, "COMMITTED")
+
+		statuses, err := txClient.TxStatuses(s.cctx.GoContext(), &tx.TxStatusesRequest{
+			TxIds: []string{res.TxHash, dummyTxHash},
+		})
+		require.NoError(t, err)
+		require.Len(t, statuses.Statuses, 2)
+		assert.Equal(t, res.TxHash, statuses.Statuses[0].TxId)
+		assert.Equal(t, "COMMITTED", statuses.Statuses[0].Status.Status)
+		assert.Equal(t, resp.Height, statuses.Statuses[0].Status.Height)
+		assert.Equal(t, "UNKNOWN", statuses.Statuses[1].Status.Status)
+
+		// the subscription ends once the tx is no longer pending
+		stream, err := txClient.SubscribeTxStatus(s.cctx.GoContext(), &tx.SubscribeTxStatusRequest{
+			TxIds: []string{res.TxHash, res.TxHash},
+		})
+		require.NoError(t, err)
+		update, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, res.TxHash, update.Result.TxId)
+		assert.Equal(t, "COMMITTED", update.Result.Status.Status)
+		_, err = stream.Recv()
+		assert.ErrorIs(t, err, io.EOF)
 	})
 }
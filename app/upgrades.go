@@ -32,6 +32,7 @@ import (
 
 	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
 	minfeetypes "github.com/celestiaorg/celestia-app/v4/x/minfee/types"
+	nsregistrytypes "github.com/celestiaorg/celestia-app/v4/x/nsregistry/types"
 )
 
 // UpgradeName defines the on-chain upgrade name from v3 to v4.
@@ -139,6 +140,7 @@ func (app App) RegisterUpgradeHandlers() {
 				hyperlanetypes.ModuleName,
 				warptypes.ModuleName,
 				minfeetypes.StoreKey,
+				nsregistrytypes.StoreKey,
 			},
 			Deleted: []string{
 				crisistypes.StoreKey,
@@ -1,131 +1,437 @@
 package app
 
 import (
+	"container/heap"
+	"sort"
+
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	tmbytes "github.com/cometbft/cometbft/libs/bytes"
 	coretypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 
+	"github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/tx"
 
 	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
 )
 
-// separateTxs decodes raw tendermint txs into normal and blob txs.
-func separateTxs(_ client.TxConfig, rawTxs [][]byte) ([][]byte, []*tx.BlobTx) {
-	normalTxs := make([][]byte, 0, len(rawTxs))
-	blobTxs := make([]*tx.BlobTx, 0, len(rawTxs))
-	for _, rawTx := range rawTxs {
+// prioritizedTx is a decoded transaction along with the information needed to
+// order it by fee priority.
+type prioritizedTx struct {
+	// rawTx is the transaction as it was received (a BlobTx for blob txs).
+	rawTx []byte
+	// sdkTx is the decoded sdk transaction. For blob txs this is the decoded
+	// inner transaction.
+	sdkTx sdk.Tx
+	// blobTx is nil for normal transactions.
+	blobTx *tx.BlobTx
+	// signer is the first signer of the transaction. Transactions of the same
+	// signer are kept in nonce order.
+	signer string
+	// sequence is the sequence of the first signature of the transaction.
+	sequence uint64
+	// signatures are the signers of the transaction along with the sequence
+	// of their signature.
+	signatures []txSignature
+	// gasPrice is the fee in utia divided by the gas limit of the transaction.
+	gasPrice math.LegacyDec
+	// index is the position of the transaction in the original list and is
+	// used to break ties.
+	index int
+}
+
+func (ptx *prioritizedTx) txBytes() []byte {
+	if ptx.blobTx != nil {
+		return ptx.blobTx.Tx
+	}
+	return ptx.rawTx
+}
+
+func (ptx *prioritizedTx) hash() tmbytes.HexBytes {
+	return tmbytes.HexBytes(coretypes.Tx(ptx.txBytes()).Hash())
+}
+
+// txSignature is a signer of a transaction and the sequence of its signature.
+type txSignature struct {
+	signer   string
+	sequence uint64
+}
+
+// droppedTx is a transaction that was removed from the proposal.
+type droppedTx struct {
+	// index is the position of the transaction in the original list.
+	index  int
+	hash   tmbytes.HexBytes
+	reason string
+}
+
+// decodeTxs decodes raw tendermint txs into normal and blob txs. Txs that can
+// not be decoded are logged and dropped.
+func decodeTxs(logger log.Logger, dec sdk.TxDecoder, rawTxs [][]byte) (normalTxs, blobTxs []*prioritizedTx, dropped []droppedTx) {
+	normalTxs = make([]*prioritizedTx, 0, len(rawTxs))
+	blobTxs = make([]*prioritizedTx, 0, len(rawTxs))
+	for idx, rawTx := range rawTxs {
+		ptx := &prioritizedTx{rawTx: rawTx, index: idx}
 		bTx, isBlob, err := tx.UnmarshalBlobTx(rawTx)
 		if isBlob {
 			if err != nil {
 				panic(err)
 			}
-			blobTxs = append(blobTxs, bTx)
+			ptx.blobTx = bTx
+		}
+
+		sdkTx, err := dec(ptx.txBytes())
+		if err != nil {
+			logger.Error("decoding already checked transaction", "tx", ptx.hash(), "error", err)
+			dropped = append(dropped, droppedTx{index: idx, hash: ptx.hash(), reason: "tx is not decodable: " + err.Error()})
+			continue
+		}
+		ptx.sdkTx = sdkTx
+		ptx.signatures = txSignatures(sdkTx)
+		if len(ptx.signatures) > 0 {
+			ptx.signer, ptx.sequence = ptx.signatures[0].signer, ptx.signatures[0].sequence
+		}
+		ptx.gasPrice = txGasPrice(sdkTx)
+
+		if isBlob {
+			blobTxs = append(blobTxs, ptx)
 		} else {
-			normalTxs = append(normalTxs, rawTx)
+			normalTxs = append(normalTxs, ptx)
 		}
 	}
-	return normalTxs, blobTxs
+	return normalTxs, blobTxs, dropped
+}
+
+// txSignatures returns the signers of the transaction along with the sequence
+// of their signature. If they can not be determined, no signatures are
+// returned and the transaction is ordered on its own.
+func txSignatures(sdkTx sdk.Tx) []txSignature {
+	sigTx, ok := sdkTx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil
+	}
+	signatures := make([]txSignature, 0, min(len(signers), len(sigs)))
+	for i := 0; i < len(signers) && i < len(sigs); i++ {
+		signatures = append(signatures, txSignature{signer: string(signers[i]), sequence: sigs[i].Sequence})
+	}
+	return signatures
+}
+
+// txGasPrice returns the gas price of the transaction in utia. Transactions
+// that are not fee txs or have no gas limit have a gas price of zero.
+func txGasPrice(sdkTx sdk.Tx) math.LegacyDec {
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() == 0 {
+		return math.LegacyZeroDec()
+	}
+	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
+	return math.LegacyNewDecFromInt(fee).Quo(math.LegacyNewDecFromInt(math.NewIntFromUint64(feeTx.GetGas())))
+}
+
+// prioritizeTxs orders the transactions by descending gas price while keeping
+// the transactions of each signer in nonce order. A transaction is only
+// considered once all the transactions of the same signer with a lower
+// sequence have been ordered, so a high fee transaction queued behind a low fee
+// one of the same signer inherits its position.
+func prioritizeTxs(txs []*prioritizedTx) []*prioritizedTx {
+	queues := make(map[string][]*prioritizedTx)
+	h := make(signerQueues, 0, len(txs))
+	for _, ptx := range txs {
+		if ptx.signer == "" {
+			// transactions without a known signer are not bound to any nonce
+			// order and are queued on their own.
+			h = append(h, []*prioritizedTx{ptx})
+			continue
+		}
+		queues[ptx.signer] = append(queues[ptx.signer], ptx)
+	}
+	for _, queue := range queues {
+		sort.SliceStable(queue, func(i, j int) bool {
+			if queue[i].sequence != queue[j].sequence {
+				return queue[i].sequence < queue[j].sequence
+			}
+			// a transaction replacing another one with the same sequence
+			// pays a higher gas price and is tried first.
+			if !queue[i].gasPrice.Equal(queue[j].gasPrice) {
+				return queue[i].gasPrice.GT(queue[j].gasPrice)
+			}
+			return queue[i].index < queue[j].index
+		})
+		h = append(h, queue)
+	}
+	heap.Init(&h)
+
+	ordered := make([]*prioritizedTx, 0, len(txs))
+	for h.Len() > 0 {
+		queue := h[0]
+		ordered = append(ordered, queue[0])
+		if len(queue) == 1 {
+			heap.Pop(&h)
+			continue
+		}
+		h[0] = queue[1:]
+		heap.Fix(&h, 0)
+	}
+	return ordered
+}
+
+// signerQueues is a max heap of per signer transaction queues ordered by the
+// gas price of the first transaction in each queue.
+type signerQueues [][]*prioritizedTx
+
+func (q signerQueues) Len() int { return len(q) }
+
+func (q signerQueues) Less(i, j int) bool {
+	a, b := q[i][0], q[j][0]
+	if !a.gasPrice.Equal(b.gasPrice) {
+		return a.gasPrice.GT(b.gasPrice)
+	}
+	return a.index < b.index
+}
+
+func (q signerQueues) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *signerQueues) Push(x any) { *q = append(*q, x.([]*prioritizedTx)) }
+
+func (q *signerQueues) Pop() any {
+	old := *q
+	n := len(old)
+	item := old[n-1]
+	*q = old[:n-1]
+	return item
 }
 
 // FilterTxs applies the antehandler to all proposed transactions and removes
-// transactions that return an error.
+// transactions that return an error, exceed the message limits or no longer
+// fit in a square of maxSquareSize.
+//
+// Transactions are ordered by gas price so that the proposer fills the square
+// with the transactions that pay the most per unit of gas. Since the gas of a
+// PFB scales with the size of its blobs, this approximates the most fees per
+// share. The transactions of a single signer are always kept in nonce order and
+// once a transaction of a signer fails the antehandler, the transactions of
+// that signer with a higher sequence are dropped as well. Transactions using a
+// sequence that was already used by an included transaction, such as the ones
+// replaced by fee, are dropped without affecting the other transactions of the
+// signer.
 //
 // Side-effect: arranges all normal transactions before all blob transactions.
-func FilterTxs(logger log.Logger, ctx sdk.Context, handler sdk.AnteHandler, txConfig client.TxConfig, txs [][]byte) [][]byte {
-	normalTxs, blobTxs := separateTxs(txConfig, txs)
-	normalTxs, ctx = filterStdTxs(logger, txConfig.TxDecoder(), ctx, handler, normalTxs)
-	blobTxs, _ = filterBlobTxs(logger, txConfig.TxDecoder(), ctx, handler, blobTxs)
-	return append(normalTxs, encodeBlobTxs(blobTxs)...)
+func FilterTxs(logger log.Logger, ctx sdk.Context, handler sdk.AnteHandler, txConfig client.TxConfig, txs [][]byte, maxSquareSize int) [][]byte {
+	included, _ := filterTxs(logger, ctx, handler, txConfig, txs, maxSquareSize)
+	rawTxs := make([][]byte, len(included))
+	for i, ptx := range included {
+		rawTxs[i] = ptx.rawTx
+	}
+	return rawTxs
+}
+
+// filterTxs implements FilterTxs and additionally returns the transactions
+// that were dropped along with the reason.
+func filterTxs(logger log.Logger, ctx sdk.Context, handler sdk.AnteHandler, txConfig client.TxConfig, txs [][]byte, maxSquareSize int) ([]*prioritizedTx, []droppedTx) {
+	builder, err := square.NewBuilder(maxSquareSize, appconsts.SubtreeRootThreshold)
+	if err != nil {
+		panic(err)
+	}
+
+	normalTxs, blobTxs, dropped := decodeTxs(logger, txConfig.TxDecoder(), txs)
+	f := &txFilter{
+		logger:          logger,
+		handler:         handler,
+		builder:         builder,
+		failedSequences: make(map[string]uint64),
+		nextSequences:   make(map[string]uint64),
+		dropped:         dropped,
+	}
+	f.filterStdTxs(ctx, prioritizeTxs(normalTxs))
+	f.filterBlobTxs(ctx, prioritizeTxs(blobTxs))
+	return f.included, f.dropped
+}
+
+// txFilter holds the state shared across filtering the normal and the blob
+// transactions of a proposal.
+type txFilter struct {
+	logger  log.Logger
+	handler sdk.AnteHandler
+	builder *square.Builder
+	// failedSequences tracks the lowest sequence of the transactions that
+	// failed the antehandler for each signer. Any transaction of these signers
+	// with a higher sequence would have an invalid nonce.
+	failedSequences map[string]uint64
+	// nextSequences tracks the sequence following the highest sequence of
+	// the included transactions for each signer.
+	nextSequences      map[string]uint64
+	nonPFBMessageCount int
+	pfbMessageCount    int
+	included           []*prioritizedTx
+	dropped            []droppedTx
 }
 
 // filterStdTxs applies the provided antehandler to each transaction and removes
 // transactions that return an error. Panics are caught by the checkTxValidity
 // function used to apply the ante handler.
-func filterStdTxs(logger log.Logger, dec sdk.TxDecoder, ctx sdk.Context, handler sdk.AnteHandler, txs [][]byte) ([][]byte, sdk.Context) {
-	n := 0
-	nonPFBMessageCount := 0
-	for _, tx := range txs {
-		sdkTx, err := dec(tx)
-		if err != nil {
-			logger.Error("decoding already checked transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()), "error", err)
+func (f *txFilter) filterStdTxs(ctx sdk.Context, txs []*prioritizedTx) {
+	for _, ptx := range txs {
+		if f.followsFailedTx(ptx) {
+			f.logger.Debug("skipping tx because a previous tx of the signer failed", "tx", ptx.hash())
+			f.drop(ptx, failedSignerReason)
 			continue
 		}
 
-		// Set the tx size on the context before calling the AnteHandler
-		ctx = ctx.WithTxBytes(tx)
-
-		msgTypes := msgTypes(sdkTx)
-		if nonPFBMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxNonPFBMessages {
-			logger.Debug("skipping tx because the max non PFB message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()))
+		if f.nonPFBMessageCount+len(ptx.sdkTx.GetMsgs()) > appconsts.MaxNonPFBMessages {
+			f.logger.Debug("skipping tx because the max non PFB message count was reached", "tx", ptx.hash())
+			f.drop(ptx, "max non PFB message count reached")
 			continue
 		}
-		nonPFBMessageCount += len(sdkTx.GetMsgs())
 
-		ctx, err = handler(ctx, sdkTx, false)
+		included, err := f.apply(ctx, ptx, func() bool { return f.builder.AppendTx(ptx.rawTx) })
 		// either the transaction is invalid (ie incorrect nonce) and we
 		// simply want to remove this tx, or we're catching a panic from one
 		// of the anteHandlers which is logged.
 		if err != nil {
-			logger.Error(
+			f.logger.Error(
 				"filtering already checked transaction",
-				"tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()),
+				"tx", ptx.hash(),
 				"error", err,
-				"msgs", msgTypes,
+				"msgs", msgTypes(ptx.sdkTx),
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_std_txs")
+			f.fail(ptx, err.Error())
 			continue
 		}
-		txs[n] = tx
-		n++
-
+		if included {
+			f.nonPFBMessageCount += len(ptx.sdkTx.GetMsgs())
+		}
 	}
-
-	return txs[:n], ctx
 }
 
 // filterBlobTxs applies the provided antehandler to each transaction
 // and removes transactions that return an error. Panics are caught by the checkTxValidity
 // function used to apply the ante handler.
-func filterBlobTxs(logger log.Logger, dec sdk.TxDecoder, ctx sdk.Context, handler sdk.AnteHandler, txs []*tx.BlobTx) ([]*tx.BlobTx, sdk.Context) {
-	n := 0
-	pfbMessageCount := 0
-	for _, tx := range txs {
-		sdkTx, err := dec(tx.Tx)
-		if err != nil {
-			logger.Error("decoding already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err)
+func (f *txFilter) filterBlobTxs(ctx sdk.Context, txs []*prioritizedTx) {
+	for _, ptx := range txs {
+		if f.followsFailedTx(ptx) {
+			f.logger.Debug("skipping blob tx because a previous tx of the signer failed", "tx", ptx.hash())
+			f.drop(ptx, failedSignerReason)
 			continue
 		}
 
-		// Set the tx size on the context before calling the AnteHandler
-		ctx = ctx.WithTxBytes(tx.Tx)
-
-		if pfbMessageCount+len(sdkTx.GetMsgs()) > appconsts.MaxPFBMessages {
-			logger.Debug("skipping tx because the max pfb message count was reached", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()))
+		if f.pfbMessageCount+len(ptx.sdkTx.GetMsgs()) > appconsts.MaxPFBMessages {
+			f.logger.Debug("skipping tx because the max pfb message count was reached", "tx", ptx.hash())
+			f.drop(ptx, "max PFB message count reached")
 			continue
 		}
-		pfbMessageCount += len(sdkTx.GetMsgs())
 
-		ctx, err = handler(ctx, sdkTx, false)
+		included, err := f.apply(ctx, ptx, func() bool { return f.builder.AppendBlobTx(ptx.blobTx) })
 		// either the transaction is invalid (ie incorrect nonce) and we
 		// simply want to remove this tx, or we're catching a panic from one
 		// of the anteHandlers which is logged.
 		if err != nil {
-			logger.Error(
-				"filtering already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err,
+			f.logger.Error(
+				"filtering already checked blob transaction", "tx", ptx.hash(), "error", err,
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_blob_txs")
+			f.fail(ptx, err.Error())
 			continue
 		}
-		txs[n] = tx
-		n++
+		if included {
+			f.pfbMessageCount += len(ptx.sdkTx.GetMsgs())
+		}
+	}
+}
+
+// apply runs the antehandler for a transaction on a branch of the state and
+// then tries to fit the transaction in the square. The state changes are only
+// written back to ctx if both succeed. Transactions that don't fit in the
+// square are not treated as errors, they are logged and dropped.
+func (f *txFilter) apply(ctx sdk.Context, ptx *prioritizedTx, appendToSquare func() bool) (bool, error) {
+	// Set the tx size on the context before calling the AnteHandler
+	cacheCtx, write := ctx.WithTxBytes(ptx.txBytes()).CacheContext()
+	if _, err := f.handler(cacheCtx, ptx.sdkTx, false); err != nil {
+		return false, err
+	}
 
+	if !appendToSquare() {
+		f.logger.Debug("skipping tx because it does not fit in the square", "tx", ptx.hash())
+		telemetry.IncrCounter(1, "prepare_proposal", "square_full")
+		f.drop(ptx, "tx does not fit in the square")
+		return false, nil
 	}
 
-	return txs[:n], ctx
+	write()
+	f.included = append(f.included, ptx)
+	for _, sig := range ptx.signatures {
+		f.nextSequences[sig.signer] = max(f.nextSequences[sig.signer], sig.sequence+1)
+	}
+	return true, nil
+}
+
+const (
+	// failedSignerReason is the drop reason of transactions that follow a
+	// failed transaction of one of their signers.
+	failedSignerReason = "a previous tx of the signer failed"
+	// usedSequenceReason is the drop reason of transactions whose sequence
+	// was already used by an included transaction.
+	usedSequenceReason = "sequence already used by an included tx"
+)
+
+// followsFailedTx returns true if one of the signers of the transaction has a
+// failed transaction with a lower sequence.
+func (f *txFilter) followsFailedTx(ptx *prioritizedTx) bool {
+	for _, sig := range ptx.signatures {
+		if failed, ok := f.failedSequences[sig.signer]; ok && sig.sequence > failed {
+			return true
+		}
+	}
+	return false
+}
+
+// usesIncludedSequence returns true if the transaction has signatures and all
+// of them use a sequence already used by an included transaction of the same
+// signer.
+func (f *txFilter) usesIncludedSequence(ptx *prioritizedTx) bool {
+	for _, sig := range ptx.signatures {
+		if next, ok := f.nextSequences[sig.signer]; !ok || sig.sequence >= next {
+			return false
+		}
+	}
+	return len(ptx.signatures) > 0
+}
+
+// drop records the transaction as dropped.
+func (f *txFilter) drop(ptx *prioritizedTx, reason string) {
+	f.dropped = append(f.dropped, droppedTx{index: ptx.index, hash: ptx.hash(), reason: reason})
+}
+
+// fail records the transaction as dropped after failing the antehandler and
+// marks the sequence of each of its signers so that their transactions with a
+// higher sequence are skipped. Transactions dropped for lack of space in the
+// square or the message limits don't invalidate later transactions as these
+// fail the antehandler on their own. Neither do transactions whose sequence
+// was already used by an included transaction, as the sequence of the signer
+// was incremented by the included one.
+func (f *txFilter) fail(ptx *prioritizedTx, reason string) {
+	if f.usesIncludedSequence(ptx) {
+		f.drop(ptx, usedSequenceReason)
+		return
+	}
+	f.drop(ptx, reason)
+	for _, sig := range ptx.signatures {
+		if failed, ok := f.failedSequences[sig.signer]; !ok || sig.sequence < failed {
+			f.failedSequences[sig.signer] = sig.sequence
+		}
+	}
 }
 
 func msgTypes(sdkTx sdk.Tx) []string {
@@ -136,15 +442,3 @@ func msgTypes(sdkTx sdk.Tx) []string {
 	}
 	return msgNames
 }
-
-func encodeBlobTxs(blobTxs []*tx.BlobTx) [][]byte {
-	txs := make([][]byte, len(blobTxs))
-	var err error
-	for i, blobTx := range blobTxs {
-		txs[i], err = tx.MarshalBlobTx(blobTx.Tx, blobTx.Blobs...)
-		if err != nil {
-			panic(err)
-		}
-	}
-	return txs
-}
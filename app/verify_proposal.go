@@ -0,0 +1,163 @@
+package app
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+
+	"github.com/celestiaorg/celestia-app/v4/app/ante"
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/proposal"
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+// verifiedTx is the result of the stateless checks performed on a single
+// transaction of a proposal.
+type verifiedTx struct {
+	// tx is the sdk transaction. For blob transactions this excludes the
+	// blobs.
+	tx       []byte
+	sdkTx    sdk.Tx
+	isBlobTx bool
+	// reason is non-empty if the transaction failed a stateless check. code
+	// classifies the failure and err is the underlying error, if any.
+	reason string
+	code   proposal.RejectionCode
+	err    error
+	// panicked is the value recovered if the stateless checks panicked.
+	panicked any
+}
+
+func (v verifiedTx) isValid() bool {
+	return v.reason == "" && v.panicked == nil
+}
+
+// preVerifyTxs runs the stateless checks of ProcessProposal for all txs
+// concurrently: decoding, validating blob txs (which includes recomputing the
+// share commitments) and verifying the signatures of signers whose public key
+// is already known. Signatures that are valid are recorded in sigCache so that
+// the ante handler can skip verifying them again. The results are returned in
+// the same order as txs so that the caller can reproduce the exact outcome of
+// checking each transaction sequentially.
+func (app *App) preVerifyTxs(ctx sdk.Context, txs [][]byte, sigCache *ante.SignatureCache) []verifiedTx {
+	defer telemetry.MeasureSince(time.Now(), "process_proposal", "pre_verify")
+
+	verified := make([]verifiedTx, len(txs))
+	parallelFor(len(txs), func(idx int) {
+		defer func() {
+			if r := recover(); r != nil {
+				verified[idx] = verifiedTx{panicked: r}
+			}
+		}()
+		verified[idx] = app.verifyTxStateless(idx, txs[idx])
+	})
+
+	// Reading from the store is not safe to do concurrently, so the signer
+	// accounts are looked up sequentially from a branch of the state at the
+	// start of the block.
+	lookupCtx, _ := ctx.CacheContext()
+	lookupCtx = lookupCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	accounts := make([][]sdk.AccountI, len(txs))
+	for idx, vtx := range verified {
+		if !vtx.isValid() {
+			continue
+		}
+		signerAccounts, err := ante.GetSignerAccounts(lookupCtx, app.AccountKeeper, vtx.sdkTx)
+		if err != nil {
+			continue
+		}
+		accounts[idx] = signerAccounts
+	}
+
+	signModeHandler := app.GetTxConfig().SignModeHandler()
+	parallelFor(len(txs), func(idx int) {
+		if accounts[idx] == nil {
+			return
+		}
+		// A signature that can't be verified here is verified again by the
+		// ante handler, which handles the failure.
+		defer func() {
+			_ = recover()
+		}()
+		sigCache.Verify(ctx, signModeHandler, verified[idx].tx, verified[idx].sdkTx, accounts[idx])
+	})
+
+	return verified
+}
+
+// verifyTxStateless performs the checks of ProcessProposal that do not depend
+// on state for the transaction at index idx.
+func (app *App) verifyTxStateless(idx int, rawTx []byte) verifiedTx {
+	vtx := verifiedTx{tx: rawTx}
+	blobTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+	if isBlobTx {
+		if err != nil {
+			vtx.reason = fmt.Sprintf("err with blob tx %d", idx)
+			vtx.code = proposal.RejectionCode_REJECTION_CODE_INVALID_BLOB_TX
+			vtx.err = err
+			return vtx
+		}
+		vtx.tx = blobTx.Tx
+	}
+	vtx.isBlobTx = isBlobTx
+
+	sdkTx, err := app.encodingConfig.TxConfig.TxDecoder()(vtx.tx)
+	if err != nil {
+		// An error here means that a tx was included in the block that is not decodable.
+		vtx.reason = fmt.Sprintf("tx %d is not decodable", idx)
+		vtx.code = proposal.RejectionCode_REJECTION_CODE_UNDECODABLE_TX
+		return vtx
+	}
+	vtx.sdkTx = sdkTx
+
+	if !isBlobTx {
+		if _, has := hasPFB(sdkTx.GetMsgs()); has {
+			// A non-blob tx has a PFB, which is invalid
+			vtx.reason = fmt.Sprintf("tx %d has PFB but is not a blob tx", idx)
+			vtx.code = proposal.RejectionCode_REJECTION_CODE_PFB_IN_NON_BLOB_TX
+		}
+		return vtx
+	}
+
+	// validate the blobTx. This is the same validation used in CheckTx ensuring
+	// - there is one PFB
+	// - that each blob has a valid namespace
+	// - that the sizes match
+	// - that the namespaces match between blob and PFB
+	// - that the share commitment is correct
+	if err := blobtypes.ValidateBlobTx(app.encodingConfig.TxConfig, blobTx, appconsts.SubtreeRootThreshold, appconsts.LatestVersion); err != nil {
+		vtx.reason = fmt.Sprintf("invalid blob tx %d", idx)
+		vtx.code = proposal.RejectionCode_REJECTION_CODE_INVALID_BLOB_TX
+		vtx.err = err
+	}
+	return vtx
+}
+
+// parallelFor calls fn for every index in [0, n) using a bounded number of
+// goroutines. It returns once all calls have completed.
+func parallelFor(n int, fn func(idx int)) {
+	workers := min(runtime.NumCPU(), n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for range workers {
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				fn(idx)
+			}
+		}()
+	}
+	for idx := 0; idx < n; idx++ {
+		indexes <- idx
+	}
+	close(indexes)
+	wg.Wait()
+}
@@ -0,0 +1,20 @@
+package app
+
+import (
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelFor(t *testing.T) {
+	for _, n := range []int{0, 1, 7, 1000} {
+		calls := make([]atomic.Int32, n)
+		parallelFor(n, func(idx int) {
+			calls[idx].Add(1)
+		})
+		for idx := range calls {
+			require.Equal(t, int32(1), calls[idx].Load(), "index %d", idx)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package da
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// DefaultEDSCacheMaxBytes is the default memory cap of the EDSCache. It fits
+// four extended data squares of the largest supported size.
+var DefaultEDSCacheMaxBytes = uint64(4 * maxExtendedSquareWidth * maxExtendedSquareWidth * share.ShareSize)
+
+// EDSCache is a bounded, in-memory cache of recently built extended data
+// squares and their data availability headers, keyed by data root. When
+// adding an entry would exceed the memory cap, the least recently used
+// entries are evicted. A nil *EDSCache is a valid cache that never stores
+// anything.
+type EDSCache struct {
+	mtx       sync.Mutex
+	maxBytes  uint64
+	usedBytes uint64
+	entries   map[string]*list.Element
+	// lru orders the entries from most to least recently used.
+	lru *list.List
+}
+
+type edsCacheEntry struct {
+	dataRoot string
+	// sharesHash is the hash of the original data square shares. It is used
+	// to verify that a cached entry was built from the requested square.
+	sharesHash [sha256.Size]byte
+	eds        *rsmt2d.ExtendedDataSquare
+	dah        DataAvailabilityHeader
+	size       uint64
+}
+
+// NewEDSCache returns a cache that holds at most maxBytes of extended data
+// squares. It returns nil, a disabled cache, if maxBytes is zero.
+func NewEDSCache(maxBytes uint64) *EDSCache {
+	if maxBytes == 0 {
+		return nil
+	}
+	return &EDSCache{
+		maxBytes: maxBytes,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+	}
+}
+
+// Add stores the extended data square eds and its header dah that were built
+// from the original data square shares. Squares that are larger than the
+// memory cap are not stored.
+func (c *EDSCache) Add(shares [][]byte, eds *rsmt2d.ExtendedDataSquare, dah DataAvailabilityHeader) {
+	if c == nil {
+		return
+	}
+	entry := &edsCacheEntry{
+		dataRoot:   string(dah.Hash()),
+		sharesHash: hashShares(shares),
+		eds:        eds,
+		dah:        dah,
+		size:       edsSize(eds),
+	}
+	if entry.size > c.maxBytes {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if elem, ok := c.entries[entry.dataRoot]; ok {
+		c.lru.MoveToFront(elem)
+		return
+	}
+	for c.usedBytes+entry.size > c.maxBytes {
+		c.evictOldest()
+	}
+	c.entries[entry.dataRoot] = c.lru.PushFront(entry)
+	c.usedBytes += entry.size
+	c.updateGauges()
+}
+
+// Get returns the extended data square and header for the original data
+// square shares if they are cached under dataRoot.
+func (c *EDSCache) Get(dataRoot []byte, shares [][]byte) (*rsmt2d.ExtendedDataSquare, DataAvailabilityHeader, bool) {
+	if c == nil {
+		return nil, DataAvailabilityHeader{}, false
+	}
+	c.mtx.Lock()
+	elem, ok := c.entries[string(dataRoot)]
+	if ok {
+		c.lru.MoveToFront(elem)
+	}
+	c.mtx.Unlock()
+	if !ok {
+		telemetry.IncrCounter(1, "eds_cache", "miss")
+		return nil, DataAvailabilityHeader{}, false
+	}
+
+	entry := elem.Value.(*edsCacheEntry)
+	sharesHash := hashShares(shares)
+	if !bytes.Equal(entry.sharesHash[:], sharesHash[:]) {
+		telemetry.IncrCounter(1, "eds_cache", "miss")
+		return nil, DataAvailabilityHeader{}, false
+	}
+	telemetry.IncrCounter(1, "eds_cache", "hit")
+	return entry.eds, entry.dah, true
+}
+
+// Len returns the number of cached extended data squares.
+func (c *EDSCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.lru.Len()
+}
+
+// evictOldest removes the least recently used entry. The caller must hold the
+// lock.
+func (c *EDSCache) evictOldest() {
+	elem := c.lru.Back()
+	if elem == nil {
+		return
+	}
+	entry := c.lru.Remove(elem).(*edsCacheEntry)
+	delete(c.entries, entry.dataRoot)
+	c.usedBytes -= entry.size
+	telemetry.IncrCounter(1, "eds_cache", "evicted")
+}
+
+// updateGauges reports the current size of the cache. The caller must hold
+// the lock.
+func (c *EDSCache) updateGauges() {
+	telemetry.SetGauge(float32(c.usedBytes), "eds_cache", "bytes")
+	telemetry.SetGauge(float32(c.lru.Len()), "eds_cache", "entries")
+}
+
+func hashShares(shares [][]byte) [sha256.Size]byte {
+	h := sha256.New()
+	for _, sh := range shares {
+		h.Write(sh)
+	}
+	var sum [sha256.Size]byte
+	copy(sum[:], h.Sum(nil))
+	return sum
+}
+
+// edsSize returns the approximate memory used by an extended data square.
+func edsSize(eds *rsmt2d.ExtendedDataSquare) uint64 {
+	width := uint64(eds.Width())
+	return width * width * share.ShareSize
+}
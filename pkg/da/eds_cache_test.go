@@ -0,0 +1,75 @@
+package da
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEDSCache(t *testing.T) {
+	type square struct {
+		shares [][]byte
+		dah    DataAvailabilityHeader
+	}
+	newSquare := func(size int) square {
+		shares := generateShares(size * size)
+		eds, err := ExtendShares(shares)
+		require.NoError(t, err)
+		dah, err := NewDataAvailabilityHeader(eds)
+		require.NoError(t, err)
+		return square{shares: shares, dah: dah}
+	}
+	add := func(cache *EDSCache, sq square) {
+		eds, err := ExtendShares(sq.shares)
+		require.NoError(t, err)
+		cache.Add(sq.shares, eds, sq.dah)
+	}
+
+	first, second, third := newSquare(4), newSquare(4), newSquare(4)
+	// room for exactly two 4x4 original squares
+	cache := NewEDSCache(2 * 8 * 8 * 512)
+
+	add(cache, first)
+	add(cache, second)
+	require.Equal(t, 2, cache.Len())
+
+	eds, dah, ok := cache.Get(first.dah.Hash(), first.shares)
+	require.True(t, ok)
+	assert.Equal(t, first.dah.Hash(), dah.Hash())
+	assert.Equal(t, first.shares, eds.FlattenedODS())
+
+	// shares that don't belong to the cached square are a miss
+	_, _, ok = cache.Get(first.dah.Hash(), second.shares)
+	assert.False(t, ok)
+
+	// first was used more recently than second so second is evicted
+	add(cache, third)
+	assert.Equal(t, 2, cache.Len())
+	_, _, ok = cache.Get(second.dah.Hash(), second.shares)
+	assert.False(t, ok)
+	_, _, ok = cache.Get(first.dah.Hash(), first.shares)
+	assert.True(t, ok)
+	_, _, ok = cache.Get(third.dah.Hash(), third.shares)
+	assert.True(t, ok)
+
+	// squares larger than the memory cap are never stored
+	add(cache, newSquare(8))
+	assert.Equal(t, 2, cache.Len())
+}
+
+func TestDisabledEDSCache(t *testing.T) {
+	cache := NewEDSCache(0)
+	require.Nil(t, cache)
+
+	shares := generateShares(4)
+	eds, err := ExtendShares(shares)
+	require.NoError(t, err)
+	dah, err := NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	cache.Add(shares, eds, dah)
+	_, _, ok := cache.Get(dah.Hash(), shares)
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len())
+}
@@ -0,0 +1,98 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+	"github.com/celestiaorg/rsmt2d"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/pkg/da"
+	"github.com/celestiaorg/celestia-app/v4/pkg/inclusion"
+)
+
+// ErrBlobNotFound is returned by NewBlobProof if the block has no blob with
+// the namespace and share commitment.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// BlobProof is a blob included in a block with the proof of its shares to the
+// data root of the block.
+type BlobProof struct {
+	Blob *share.Blob
+	// ShareRange is the range of shares of the blob in the original data
+	// square.
+	ShareRange share.Range
+	ShareProof ShareProof
+	// DataRoot is the data root of the square built from the transactions.
+	DataRoot []byte
+}
+
+// NewBlobProof builds the square of the block transactions txs, locates the
+// blob of the namespace whose share commitment, computed from the subtree
+// roots of the extended square, is commitment and returns it with the proof of
+// its shares to the data root.
+func NewBlobProof(txs [][]byte, namespace share.Namespace, commitment []byte) (*BlobProof, error) {
+	builder, err := square.NewBuilder(appconsts.DefaultSquareSizeUpperBound, appconsts.SubtreeRootThreshold, txs...)
+	if err != nil {
+		return nil, err
+	}
+	dataSquare, err := builder.Export()
+	if err != nil {
+		return nil, err
+	}
+
+	// keep the subtree roots of the rows around to compute the commitments
+	// of the blobs without rebuilding their trees.
+	cacher := inclusion.NewSubtreeCacher(uint64(dataSquare.Size()))
+	eds, err := rsmt2d.ComputeExtendedDataSquare(share.ToBytes(dataSquare), appconsts.DefaultCodec(), cacher.Constructor)
+	if err != nil {
+		return nil, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, err
+	}
+
+	for txIndex, rawTx := range txs {
+		btx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlob || err != nil {
+			continue
+		}
+		for blobIndex, blob := range btx.Blobs {
+			if !blob.Namespace().Equals(namespace) {
+				continue
+			}
+			start, err := builder.FindBlobStartingIndex(txIndex, blobIndex)
+			if err != nil {
+				return nil, err
+			}
+			length, err := builder.BlobShareLength(txIndex, blobIndex)
+			if err != nil {
+				return nil, err
+			}
+			blobCommitment, err := inclusion.GetCommitment(cacher, dah, start, length, appconsts.SubtreeRootThreshold)
+			if err != nil {
+				return nil, err
+			}
+			if !bytes.Equal(blobCommitment, commitment) {
+				continue
+			}
+
+			shareRange := share.NewRange(start, start+length)
+			shareProof, err := NewShareInclusionProofFromEDS(eds, namespace, shareRange)
+			if err != nil {
+				return nil, err
+			}
+			return &BlobProof{
+				Blob:       blob,
+				ShareRange: shareRange,
+				ShareProof: shareProof,
+				DataRoot:   dah.Hash(),
+			}, nil
+		}
+	}
+	return nil, ErrBlobNotFound
+}
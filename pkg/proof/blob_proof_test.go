@@ -0,0 +1,78 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	coretypes "github.com/cometbft/cometbft/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/pkg/da"
+	"github.com/celestiaorg/celestia-app/v4/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v4/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v4/test/util/random"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testnode"
+)
+
+func TestNewBlobProof(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+
+	ns1 := share.RandomBlobNamespace()
+	ns2 := share.RandomBlobNamespace()
+	blobTxs := blobfactory.RandBlobTxsWithNamespacesAndSigner(signer, []share.Namespace{ns1, ns2, ns1}, []int{1000, 20000, 3000})
+	txs := append(coretypes.Txs{blobfactory.GenerateRandomRawSendTx(random.New(), signer)}, blobTxs...).ToSliceOfBytes()
+
+	dataSquare, err := square.Construct(txs, appconsts.DefaultSquareSizeUpperBound, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	for _, rawTx := range txs[1:] {
+		btx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+		require.True(t, isBlob)
+		require.NoError(t, err)
+		blob := btx.Blobs[0]
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		require.NoError(t, err)
+
+		blobProof, err := proof.NewBlobProof(txs, blob.Namespace(), commitment)
+		require.NoError(t, err)
+		assert.Equal(t, blob.Data(), blobProof.Blob.Data())
+		assert.Equal(t, dah.Hash(), blobProof.DataRoot)
+		require.NoError(t, blobProof.ShareProof.Validate(dah.Hash()))
+
+		// the proven shares are the shares of the blob
+		shares, err := blob.ToShares()
+		require.NoError(t, err)
+		require.Len(t, blobProof.ShareProof.Data, len(shares))
+		assert.Equal(t, len(shares), blobProof.ShareRange.End-blobProof.ShareRange.Start)
+		for i, sh := range shares {
+			assert.Equal(t, sh.ToBytes(), blobProof.ShareProof.Data[i])
+		}
+	}
+
+	// the commitment must match a blob of the namespace
+	commitment, err := inclusion.CreateCommitment(mustBlob(t, txs[2]), merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+	require.NoError(t, err)
+	_, err = proof.NewBlobProof(txs, ns1, commitment)
+	require.ErrorIs(t, err, proof.ErrBlobNotFound)
+	_, err = proof.NewBlobProof(txs, ns2, []byte("unknown"))
+	require.ErrorIs(t, err, proof.ErrBlobNotFound)
+}
+
+func mustBlob(t *testing.T, rawTx []byte) *share.Blob {
+	btx, isBlob, err := blobtx.UnmarshalBlobTx(rawTx)
+	require.True(t, isBlob)
+	require.NoError(t, err)
+	return btx.Blobs[0]
+}
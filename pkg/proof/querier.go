@@ -15,6 +15,7 @@ import (
 	"github.com/celestiaorg/go-square/v2/share"
 
 	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/pkg/da"
 )
 
 const TxInclusionQueryPath = "txInclusionProof"
@@ -71,6 +72,19 @@ const ShareInclusionQueryPath = "shareInclusionProof"
 // be appended to the path. Example path for proving the set of shares [3, 5]:
 // custom/shareInclusionProof/3/5
 func QueryShareInclusionProof(_ sdk.Context, path []string, req *abci.RequestQuery) ([]byte, error) {
+	return queryShareInclusionProof(nil, path, req)
+}
+
+// NewQueryShareInclusionProof returns a QueryShareInclusionProof querier that
+// reuses the extended data square of the block from the cache, instead of
+// erasure coding it again, if it was recently built by this node.
+func NewQueryShareInclusionProof(cache *da.EDSCache) func(sdk.Context, []string, *abci.RequestQuery) ([]byte, error) {
+	return func(_ sdk.Context, path []string, req *abci.RequestQuery) ([]byte, error) {
+		return queryShareInclusionProof(cache, path, req)
+	}
+}
+
+func queryShareInclusionProof(cache *da.EDSCache, path []string, req *abci.RequestQuery) ([]byte, error) {
 	// parse the share range from the path
 	if len(path) != 2 {
 		return nil, fmt.Errorf("expected query path length: 2 actual: %d ", len(path))
@@ -113,9 +127,19 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req *abci.RequestQue
 		return nil, err
 	}
 
+	// the data hash of the header is the data root of the block
+	shares := share.ToBytes(dataSquare)
+	eds, _, ok := cache.Get(pbb.Header.DataHash, shares)
+	if !ok {
+		eds, err = da.ExtendShares(shares)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	shareRange := share.NewRange(begin, end)
 	// create and marshal the share inclusion proof, which we return in the form of []byte
-	shareProof, err := NewShareInclusionProof(dataSquare, nID, shareRange)
+	shareProof, err := NewShareInclusionProofFromEDS(eds, nID, shareRange)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,109 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultAccountWatchInterval is how often WatchAccounts looks for new keys
+// and funded accounts by default.
+const DefaultAccountWatchInterval = 10 * time.Second
+
+// AccountCallback is called with a copy of an account once it is loaded by
+// the TxClient, i.e. once the TxClient can sign with it.
+type AccountCallback func(acc *Account)
+
+// WithAccountCallback registers a callback called when an account is loaded
+// after the client is created, either by LoadNewAccounts or lazily when a
+// transaction is first signed by the account. Callbacks are called in a new
+// goroutine so they may use the client.
+func WithAccountCallback(callback AccountCallback) Option {
+	return func(c *TxClient) {
+		c.accountCallbacks = append(c.accountCallbacks, callback)
+	}
+}
+
+// LoadNewAccounts loads the accounts of the keys of the signer backend that
+// are not loaded yet and exist in state. SetupTxClient skips the keys whose
+// accounts don't exist yet, for example because they have not been funded, and
+// keys may be added to the backend after the client is created. It returns the
+// accounts that were loaded.
+func (client *TxClient) LoadNewAccounts(ctx context.Context) ([]*Account, error) {
+	keys, err := client.signer.backend.List()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving keys: %w", err)
+	}
+
+	client.mtx.Lock()
+	pending := make([]*KeyInfo, 0, len(keys))
+	for _, key := range keys {
+		if _, exists := client.signer.accounts[key.Name]; !exists {
+			pending = append(pending, key)
+		}
+	}
+	client.mtx.Unlock()
+
+	loaded := make([]*Account, 0, len(pending))
+	for _, key := range pending {
+		accNum, sequence, err := QueryAccount(ctx, client.grpc, client.registry, key.Address())
+		if status.Code(err) == codes.NotFound {
+			continue
+		}
+		if err != nil {
+			return loaded, fmt.Errorf("querying account %s: %w", key.Name, err)
+		}
+
+		client.mtx.Lock()
+		// the account may have been loaded by a transaction in the meantime
+		if _, exists := client.signer.accounts[key.Name]; exists {
+			client.mtx.Unlock()
+			continue
+		}
+		err = client.loadAccount(NewAccount(key.Name, accNum, sequence))
+		client.mtx.Unlock()
+		if err != nil {
+			return loaded, err
+		}
+		loaded = append(loaded, client.Account(key.Name))
+	}
+	return loaded, nil
+}
+
+// WatchAccounts calls LoadNewAccounts every interval until the context is
+// cancelled, so that new keys of the signer backend and newly funded accounts
+// become usable without restarting the client. Errors are transient, for
+// example when the node is unreachable, so they don't stop the watch. It
+// returns the context error.
+func (client *TxClient) WatchAccounts(ctx context.Context, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.New("interval must be positive")
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		_, _ = client.LoadNewAccounts(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// loadAccount adds the account to the signer and notifies the account
+// callbacks. The caller must hold the lock of the client.
+func (client *TxClient) loadAccount(acc *Account) error {
+	if err := client.signer.AddAccount(acc); err != nil {
+		return err
+	}
+	for _, callback := range client.accountCallbacks {
+		go callback(acc.Copy())
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v4/app/params"
+	"github.com/celestiaorg/celestia-app/v4/pkg/user"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testnode"
+)
+
+func TestLoadNewAccounts(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	loadedAccounts := make(chan *user.Account, 2)
+	_, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration,
+		user.WithAccountCallback(func(acc *user.Account) { loadedAccounts <- acc }))
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	newKey := func(name string) sdk.AccAddress {
+		path := hd.CreateHDPath(sdk.CoinType, 0, 0).String()
+		record, _, err := ctx.Keyring.NewMnemonic(name, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+		require.NoError(t, err)
+		addr, err := record.GetAddress()
+		require.NoError(t, err)
+		return addr
+	}
+	fund := func(addr sdk.AccAddress) {
+		msg := bank.NewMsgSend(txClient.DefaultAddress(), addr, sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 1e6)))
+		_, err := txClient.SubmitTx(subCtx, []sdk.Msg{msg})
+		require.NoError(t, err)
+	}
+
+	addr := newKey("new")
+	loaded, err := txClient.LoadNewAccounts(subCtx)
+	require.NoError(t, err)
+	require.Empty(t, loaded, "the account doesn't exist until it is funded")
+	require.Nil(t, txClient.Account("new"))
+
+	fund(addr)
+	loaded, err = txClient.LoadNewAccounts(subCtx)
+	require.NoError(t, err)
+	require.Len(t, loaded, 1)
+	require.Equal(t, "new", loaded[0].Name())
+	require.Equal(t, addr, (<-loadedAccounts).Address())
+
+	// the watcher picks up keys added while it runs
+	watchCtx, stopWatch := context.WithCancel(subCtx)
+	defer stopWatch()
+	go func() { _ = txClient.WatchAccounts(watchCtx, 100*time.Millisecond) }()
+	addr = newKey("watched")
+	fund(addr)
+	select {
+	case acc := <-loadedAccounts:
+		require.Equal(t, "watched", acc.Name())
+	case <-subCtx.Done():
+		t.Fatal("the watched account was not loaded")
+	}
+}
@@ -0,0 +1,77 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/grpc"
+)
+
+// DefaultBlobParamsRefreshInterval is the default time for which the TxClient
+// reuses the params of the blob module before querying them again.
+const DefaultBlobParamsRefreshInterval = 5 * time.Minute
+
+// WithBlobParamsRefreshInterval sets the time for which the client reuses the
+// params of the blob module, which the gas of the PFBs is estimated with. The
+// params are also queried again after the node rejects a PFB for its gas or
+// fee.
+func WithBlobParamsRefreshInterval(interval time.Duration) Option {
+	return func(c *TxClient) {
+		c.blobParams.interval = interval
+	}
+}
+
+// queryCache caches the result of a query so that it isn't repeated for every
+// PFB. The client caches the params of the blob module, which only change
+// through governance, and the blob base fee, which changes at most once per
+// block and so is cached for the duration of a block.
+type queryCache[T any] struct {
+	mtx      sync.Mutex
+	interval time.Duration
+	query    func(ctx context.Context, conn *grpc.ClientConn) (T, error)
+	value    T
+	// fetched is the time of the query. It is zero if the value was never
+	// queried or was invalidated.
+	fetched time.Time
+}
+
+// get returns the cached value, querying it if it is older than the refresh
+// interval. The lock is held during the query so that concurrent callers
+// share a single query.
+func (c *queryCache[T]) get(ctx context.Context, conn *grpc.ClientConn) (T, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if !c.fetched.IsZero() && time.Since(c.fetched) < c.interval {
+		return c.value, nil
+	}
+	value, err := c.query(ctx, conn)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	c.value = value
+	c.fetched = time.Now()
+	return value, nil
+}
+
+// invalidate makes the next get query the value.
+func (c *queryCache[T]) invalidate() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.fetched = time.Time{}
+}
+
+// isGasRejection returns true if the broadcast was rejected because of the
+// gas limit or the fee of the transaction, which happens to PFBs priced with
+// outdated blob params or blob base fee.
+func isGasRejection(err error) bool {
+	var broadcastErr *BroadcastTxError
+	if !errors.As(err, &broadcastErr) {
+		return false
+	}
+	return broadcastErr.Code == sdkerrors.ErrOutOfGas.ABCICode() ||
+		broadcastErr.Code == sdkerrors.ErrInsufficientFee.ABCICode()
+}
@@ -0,0 +1,71 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+type mockBlobQueryServer struct {
+	types.UnimplementedQueryServer
+	calls atomic.Int32
+}
+
+func (m *mockBlobQueryServer) Params(context.Context, *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	m.calls.Add(1)
+	return &types.QueryParamsResponse{Params: types.DefaultParams()}, nil
+}
+
+func TestQueryCache(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	grpcServer := grpc.NewServer()
+	server := &mockBlobQueryServer{}
+	types.RegisterQueryServer(grpcServer, server)
+	go func() {
+		if err := grpcServer.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			panic(err)
+		}
+	}()
+	t.Cleanup(grpcServer.Stop)
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, conn.Close()) })
+
+	cache := &queryCache[types.Params]{interval: time.Hour, query: QueryBlobParams}
+	for range 3 {
+		params, err := cache.get(context.Background(), conn)
+		require.NoError(t, err)
+		assert.Equal(t, types.DefaultParams(), params)
+	}
+	assert.EqualValues(t, 1, server.calls.Load())
+
+	cache.invalidate()
+	_, err = cache.get(context.Background(), conn)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, server.calls.Load())
+
+	// the params are queried again once they are older than the interval
+	cache.interval = 0
+	_, err = cache.get(context.Background(), conn)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, server.calls.Load())
+}
+
+func TestIsGasRejection(t *testing.T) {
+	assert.True(t, isGasRejection(&BroadcastTxError{Code: sdkerrors.ErrOutOfGas.ABCICode()}))
+	assert.True(t, isGasRejection(&BroadcastTxError{Code: sdkerrors.ErrInsufficientFee.ABCICode()}))
+	assert.False(t, isGasRejection(&BroadcastTxError{Code: sdkerrors.ErrMempoolIsFull.ABCICode()}))
+	assert.False(t, isGasRejection(errors.New("connection refused")))
+}
@@ -0,0 +1,272 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	tmservice "github.com/cosmos/cosmos-sdk/client/grpc/cmtservice"
+	"google.golang.org/grpc"
+
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	blobtx "github.com/celestiaorg/go-square/v2/tx"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+// blobTxOverhead is the number of bytes reserved in a blob transaction for the
+// PFB transaction and the encoding of the blob.
+const blobTxOverhead = 2048
+
+// Manifest describes a payload split into blobs by SubmitChunkedPayload. It
+// holds everything needed to retrieve the blobs and verify the reassembled
+// payload, see ReadChunkedPayload.
+type Manifest struct {
+	// Size is the size of the payload in bytes.
+	Size uint64 `json:"size"`
+	// Hash is the sha256 hash of the payload.
+	Hash []byte `json:"hash"`
+	// Chunks are the blobs holding the payload in order.
+	Chunks []Chunk `json:"chunks"`
+}
+
+// Chunk is a blob holding a part of a payload.
+type Chunk struct {
+	// Height is the height of the block that includes the blob.
+	Height     int64           `json:"height"`
+	TxHash     string          `json:"tx_hash"`
+	Namespace  share.Namespace `json:"namespace"`
+	Commitment []byte          `json:"commitment"`
+	Size       uint32          `json:"size"`
+}
+
+type ChunkOption func(cfg *chunkConfig)
+
+type chunkConfig struct {
+	chunkSize int
+	account   string
+	txOpts    []TxOption
+}
+
+// WithChunkSize sets the size of the blobs a payload is split into instead of
+// the largest size that fits in a data square.
+func WithChunkSize(size int) ChunkOption {
+	return func(cfg *chunkConfig) {
+		cfg.chunkSize = size
+	}
+}
+
+// WithChunkAccount sets the account that pays for the blobs instead of the
+// default account of the client.
+func WithChunkAccount(account string) ChunkOption {
+	return func(cfg *chunkConfig) {
+		cfg.account = account
+	}
+}
+
+// WithChunkTxOptions sets the options of every PFB paying for a chunk.
+func WithChunkTxOptions(opts ...TxOption) ChunkOption {
+	return func(cfg *chunkConfig) {
+		cfg.txOpts = append(cfg.txOpts, opts...)
+	}
+}
+
+// MaxBlobSize returns the size of the largest blob a single PFB can pay for
+// in a data square of width maxSquareSize. The first row of the square is left
+// to the transactions so that the blob can be aligned on the next row, and the
+// blob transaction must not exceed the max tx size.
+func MaxBlobSize(maxSquareSize int) int {
+	maxSquareSize = min(maxSquareSize, appconsts.DefaultSquareSizeUpperBound)
+	blobShares := maxSquareSize * (maxSquareSize - 1)
+	return min(share.AvailableBytesFromSparseShares(blobShares), appconsts.DefaultMaxTxSize-blobTxOverhead)
+}
+
+// QueryMaxBlobSize returns the size of the largest blob a single PFB can pay
+// for given the current values of the GovMaxSquareSize and MaxBlobSize
+// parameters.
+func QueryMaxBlobSize(ctx context.Context, grpcConn *grpc.ClientConn) (int, error) {
+	params, err := QueryBlobParams(ctx, grpcConn)
+	if err != nil {
+		return 0, err
+	}
+	return maxBlobSizeWithParams(params), nil
+}
+
+// maxBlobSizeWithParams returns the size of the largest blob a single PFB can
+// pay for given the params of the blob module.
+func maxBlobSizeWithParams(params types.Params) int {
+	maxBlobSize := MaxBlobSize(int(params.GovMaxSquareSize))
+	if params.MaxBlobSize != 0 {
+		maxBlobSize = min(maxBlobSize, int(params.MaxBlobSize))
+	}
+	return maxBlobSize
+}
+
+// SplitPayload splits payload into blobs of the provided namespace holding at
+// most chunkSize bytes each.
+func SplitPayload(namespace share.Namespace, payload []byte, chunkSize int) ([]*share.Blob, error) {
+	if chunkSize <= 0 {
+		return nil, errors.New("chunk size must be positive")
+	}
+	if len(payload) == 0 {
+		return nil, errors.New("payload is empty")
+	}
+	blobs := make([]*share.Blob, 0, (len(payload)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(payload); start += chunkSize {
+		end := min(start+chunkSize, len(payload))
+		blob, err := share.NewV0Blob(namespace, payload[start:end])
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil
+}
+
+// SubmitChunkedPayload splits payload into blobs that fit in a data square,
+// submits every blob in its own PFB, and returns the manifest of the payload
+// once all the PFBs are committed. The PFBs are broadcast with consecutive
+// sequences before any of them is confirmed so that they are included in
+// consecutive blocks. By default the blobs are as large as the blob params
+// cached by the client allow.
+func (client *TxClient) SubmitChunkedPayload(ctx context.Context, namespace share.Namespace, payload []byte, opts ...ChunkOption) (*Manifest, error) {
+	cfg := &chunkConfig{account: client.defaultAccount}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.chunkSize == 0 {
+		params, err := client.blobParams.get(ctx, client.grpc)
+		if err != nil {
+			return nil, err
+		}
+		cfg.chunkSize = maxBlobSizeWithParams(params)
+	}
+
+	blobs, err := SplitPayload(namespace, payload, cfg.chunkSize)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256(payload)
+	manifest := &Manifest{
+		Size:   uint64(len(payload)),
+		Hash:   hash[:],
+		Chunks: make([]Chunk, len(blobs)),
+	}
+	for i, blob := range blobs {
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.BroadcastPayForBlobWithAccount(ctx, cfg.account, []*share.Blob{blob}, cfg.txOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("broadcasting chunk %d of %d: %w", i+1, len(blobs), err)
+		}
+		manifest.Chunks[i] = Chunk{
+			TxHash:     resp.TxHash,
+			Namespace:  namespace,
+			Commitment: commitment,
+			Size:       uint32(len(blob.Data())),
+		}
+	}
+
+	for i := range manifest.Chunks {
+		resp, err := client.ConfirmTx(ctx, manifest.Chunks[i].TxHash)
+		if err != nil {
+			return nil, fmt.Errorf("confirming chunk %d of %d: %w", i+1, len(blobs), err)
+		}
+		// the transaction may have been resubmitted under another hash
+		manifest.Chunks[i].TxHash = resp.TxHash
+		manifest.Chunks[i].Height = resp.Height
+	}
+	return manifest, nil
+}
+
+// BlobGetter retrieves a blob included in a block.
+type BlobGetter interface {
+	GetBlob(ctx context.Context, height int64, namespace share.Namespace, commitment []byte) (*share.Blob, error)
+}
+
+// nodeBlobGetter retrieves blobs from the blocks served by a consensus node.
+type nodeBlobGetter struct {
+	conn *grpc.ClientConn
+}
+
+// NewNodeBlobGetter returns a BlobGetter that reads the blob transactions of
+// the blocks served by the consensus node at conn. The node must not have
+// pruned the blocks.
+func NewNodeBlobGetter(conn *grpc.ClientConn) BlobGetter {
+	return &nodeBlobGetter{conn: conn}
+}
+
+func (g *nodeBlobGetter) GetBlob(ctx context.Context, height int64, namespace share.Namespace, commitment []byte) (*share.Blob, error) {
+	resp, err := tmservice.NewServiceClient(g.conn).GetBlockByHeight(ctx, &tmservice.GetBlockByHeightRequest{Height: height})
+	if err != nil {
+		return nil, fmt.Errorf("getting block %d: %w", height, err)
+	}
+	for _, rawTx := range resp.SdkBlock.Data.Txs {
+		bTx, isBlobTx, err := blobtx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx || err != nil {
+			continue
+		}
+		for _, blob := range bTx.Blobs {
+			if !blob.Namespace().Equals(namespace) {
+				continue
+			}
+			blobCommitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+			if err != nil {
+				return nil, err
+			}
+			if bytes.Equal(blobCommitment, commitment) {
+				return blob, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("blob with commitment %X not found at height %d", commitment, height)
+}
+
+// ReadChunkedPayload retrieves the blobs of the manifest with getter and
+// reassembles the payload. The commitment of every blob, and the size and the
+// hash of the payload, are verified against the manifest. The manifest is not
+// trusted: the payload grows as the chunks are verified rather than being
+// allocated upfront from the sizes of the manifest.
+func ReadChunkedPayload(ctx context.Context, getter BlobGetter, manifest *Manifest) ([]byte, error) {
+	var chunksSize uint64
+	for _, chunk := range manifest.Chunks {
+		chunksSize += uint64(chunk.Size)
+	}
+	if chunksSize != manifest.Size {
+		return nil, fmt.Errorf("chunks hold %d bytes, expected %d", chunksSize, manifest.Size)
+	}
+
+	var payload []byte
+	for i, chunk := range manifest.Chunks {
+		blob, err := getter.GetBlob(ctx, chunk.Height, chunk.Namespace, chunk.Commitment)
+		if err != nil {
+			return nil, fmt.Errorf("getting chunk %d of %d: %w", i+1, len(manifest.Chunks), err)
+		}
+		commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(commitment, chunk.Commitment) {
+			return nil, fmt.Errorf("chunk %d has commitment %X, expected %X", i+1, commitment, chunk.Commitment)
+		}
+		if len(blob.Data()) != int(chunk.Size) {
+			return nil, fmt.Errorf("chunk %d has %d bytes, expected %d", i+1, len(blob.Data()), chunk.Size)
+		}
+		payload = append(payload, blob.Data()...)
+	}
+
+	if uint64(len(payload)) != manifest.Size {
+		return nil, fmt.Errorf("payload has %d bytes, expected %d", len(payload), manifest.Size)
+	}
+	if hash := sha256.Sum256(payload); !bytes.Equal(hash[:], manifest.Hash) {
+		return nil, fmt.Errorf("payload has hash %X, expected %X", hash, manifest.Hash)
+	}
+	return payload, nil
+}
@@ -0,0 +1,90 @@
+package user_test
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/pkg/user"
+	"github.com/celestiaorg/celestia-app/v4/test/util/random"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testnode"
+)
+
+func TestMaxBlobSize(t *testing.T) {
+	// a 64x64 square leaves 63 rows to the blob
+	assert.Equal(t, share.AvailableBytesFromSparseShares(64*63), user.MaxBlobSize(64))
+	// larger squares are bounded by the max tx size
+	assert.Less(t, user.MaxBlobSize(appconsts.DefaultSquareSizeUpperBound), appconsts.DefaultMaxTxSize)
+	assert.Equal(t, user.MaxBlobSize(appconsts.DefaultSquareSizeUpperBound), user.MaxBlobSize(1024))
+}
+
+func TestSplitPayload(t *testing.T) {
+	ns := share.RandomBlobNamespace()
+	payload := random.Bytes(2500)
+
+	blobs, err := user.SplitPayload(ns, payload, 1000)
+	require.NoError(t, err)
+	require.Len(t, blobs, 3)
+	assert.Len(t, blobs[2].Data(), 500)
+	var reassembled []byte
+	for _, blob := range blobs {
+		assert.Equal(t, ns, blob.Namespace())
+		reassembled = append(reassembled, blob.Data()...)
+	}
+	assert.Equal(t, payload, reassembled)
+
+	_, err = user.SplitPayload(ns, nil, 1000)
+	assert.Error(t, err)
+	_, err = user.SplitPayload(ns, payload, 0)
+	assert.Error(t, err)
+}
+
+func TestSubmitChunkedPayload(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	_, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), 2*time.Minute)
+	defer cancel()
+
+	ns := share.RandomBlobNamespace()
+	payload := random.Bytes(100_000)
+	manifest, err := txClient.SubmitChunkedPayload(subCtx, ns, payload, user.WithChunkSize(30_000))
+	require.NoError(t, err)
+	require.Len(t, manifest.Chunks, 4)
+	for i, chunk := range manifest.Chunks {
+		require.NotZero(t, chunk.Height)
+		if i > 0 {
+			require.GreaterOrEqual(t, chunk.Height, manifest.Chunks[i-1].Height)
+		}
+	}
+
+	// the manifest is shared as JSON with the readers
+	manifestJSON, err := json.Marshal(manifest)
+	require.NoError(t, err)
+	var decoded user.Manifest
+	require.NoError(t, json.Unmarshal(manifestJSON, &decoded))
+
+	getter := user.NewNodeBlobGetter(ctx.GRPCClient)
+	reassembled, err := user.ReadChunkedPayload(subCtx, getter, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, payload, reassembled)
+
+	decoded.Hash = make([]byte, len(decoded.Hash))
+	_, err = user.ReadChunkedPayload(subCtx, getter, &decoded)
+	require.Error(t, err)
+
+	// a manifest claiming more bytes than its chunks hold is rejected before
+	// any chunk is retrieved
+	decoded.Size = math.MaxUint64
+	_, err = user.ReadChunkedPayload(subCtx, getter, &decoded)
+	require.ErrorContains(t, err, "chunks hold")
+}
@@ -0,0 +1,236 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	multisigtypes "github.com/cosmos/cosmos-sdk/crypto/types/multisig"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// partialSignMode is the sign mode of partial signatures. Unlike the direct
+// sign mode, the sign bytes don't depend on the signer infos of the other
+// signers so every party can sign independently.
+var partialSignMode = signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+
+// CreateUnsignedTx forms a transaction from the provided messages without
+// signing it. Unlike CreateTx, the messages may have several signers, for
+// example a fee payer and a message signer, or be signed by a multisig account.
+// The transaction is signed by collecting a partial signature from every
+// signer, see CreatePartialSignature, and combining them with
+// CombineSignatures.
+func (s *Signer) CreateUnsignedTx(msgs []sdktypes.Msg, opts ...TxOption) (authsigning.Tx, error) {
+	builder, err := s.txBuilder(msgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return builder.GetTx(), nil
+}
+
+// EncodeTxJSON encodes a transaction, for example an unsigned transaction to
+// share with the other signers, as JSON.
+func (s *Signer) EncodeTxJSON(tx sdktypes.Tx) ([]byte, error) {
+	return s.enc.TxJSONEncoder()(tx)
+}
+
+// DecodeTxJSON decodes a transaction encoded by EncodeTxJSON.
+func (s *Signer) DecodeTxJSON(txJSON []byte) (authsigning.Tx, error) {
+	tx, err := s.enc.TxJSONDecoder()(txJSON)
+	if err != nil {
+		return nil, err
+	}
+	authTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return nil, errors.New("not an authsigning transaction")
+	}
+	return authTx, nil
+}
+
+// CreatePartialSignature signs tx on behalf of account with the key keyName.
+// For a regular account, keyName is the name of the account. For a multisig
+// account, keyName is the name of the key of one of its members and the
+// signatures of enough members must be combined with CombineMultisigSignatures.
+// The account number and the sequence of account are used, so the account must
+// have been added to the signer.
+func (s *Signer) CreatePartialSignature(tx authsigning.Tx, account, keyName string) (signing.SignatureV2, error) {
+	acc, exists := s.accounts[account]
+	if !exists {
+		return signing.SignatureV2{}, fmt.Errorf("account %s not found", account)
+	}
+	key, err := s.backend.Key(keyName)
+	if err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("retrieving key %s: %w", keyName, err)
+	}
+	if keyName != account && !isMultisigMember(acc.pubKey, key.PubKey) {
+		return signing.SignatureV2{}, fmt.Errorf("key %s can't sign for account %s", keyName, account)
+	}
+
+	bytesToSign, err := s.signBytes(tx, acc, acc.sequence, partialSignMode)
+	if err != nil {
+		return signing.SignatureV2{}, err
+	}
+	signature, err := s.backend.Sign(keyName, bytesToSign, partialSignMode)
+	if err != nil {
+		return signing.SignatureV2{}, fmt.Errorf("error signing bytes: %w", err)
+	}
+	return signing.SignatureV2{
+		PubKey: key.PubKey,
+		Data: &signing.SingleSignatureData{
+			SignMode:  partialSignMode,
+			Signature: signature,
+		},
+		Sequence: acc.sequence,
+	}, nil
+}
+
+// CombineMultisigSignatures combines the partial signatures of the members of
+// the multisig account into the signature of the account. At least as many
+// members as the threshold of the account must have signed.
+func (s *Signer) CombineMultisigSignatures(account string, sigs ...signing.SignatureV2) (signing.SignatureV2, error) {
+	acc, exists := s.accounts[account]
+	if !exists {
+		return signing.SignatureV2{}, fmt.Errorf("account %s not found", account)
+	}
+	multisigPubKey, ok := acc.pubKey.(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return signing.SignatureV2{}, fmt.Errorf("account %s is not a multisig account", account)
+	}
+	if len(sigs) < int(multisigPubKey.Threshold) {
+		return signing.SignatureV2{}, fmt.Errorf("got %d signatures for account %s but its threshold is %d", len(sigs), account, multisigPubKey.Threshold)
+	}
+
+	data := multisigtypes.NewMultisig(len(multisigPubKey.GetPubKeys()))
+	for _, sig := range sigs {
+		if sig.Sequence != acc.sequence {
+			return signing.SignatureV2{}, fmt.Errorf("signature of %s has sequence %d, expected %d", sdktypes.AccAddress(sig.PubKey.Address()), sig.Sequence, acc.sequence)
+		}
+		if err := multisigtypes.AddSignatureV2(data, sig, multisigPubKey.GetPubKeys()); err != nil {
+			return signing.SignatureV2{}, fmt.Errorf("adding signature of %s: %w", sdktypes.AccAddress(sig.PubKey.Address()), err)
+		}
+	}
+	return signing.SignatureV2{
+		PubKey:   multisigPubKey,
+		Data:     data,
+		Sequence: acc.sequence,
+	}, nil
+}
+
+// CombineSignatures sets the signatures of every signer of tx and returns the
+// encoded signed transaction. The signatures can be provided in any order;
+// they are ordered like the signers of the transaction.
+func (s *Signer) CombineSignatures(tx authsigning.Tx, sigs ...signing.SignatureV2) ([]byte, error) {
+	signers, err := tx.GetSigners()
+	if err != nil {
+		return nil, fmt.Errorf("error getting signers: %w", err)
+	}
+	bySigner := make(map[string]signing.SignatureV2, len(sigs))
+	for _, sig := range sigs {
+		bySigner[sdktypes.AccAddress(sig.PubKey.Address()).String()] = sig
+	}
+	ordered := make([]signing.SignatureV2, len(signers))
+	for i, signer := range signers {
+		sig, ok := bySigner[sdktypes.AccAddress(signer).String()]
+		if !ok {
+			return nil, fmt.Errorf("missing signature of %s", sdktypes.AccAddress(signer))
+		}
+		ordered[i] = sig
+	}
+
+	builder, err := s.enc.WrapTxBuilder(tx)
+	if err != nil {
+		return nil, err
+	}
+	if err := builder.SetSignatures(ordered...); err != nil {
+		return nil, fmt.Errorf("error setting signatures: %w", err)
+	}
+	return s.EncodeTx(builder.GetTx())
+}
+
+// MarshalSignatures encodes partial signatures as JSON so that they can be
+// shared with the party combining them.
+func (s *Signer) MarshalSignatures(sigs ...signing.SignatureV2) ([]byte, error) {
+	return s.enc.MarshalSignatureJSON(sigs)
+}
+
+// UnmarshalSignatures decodes signatures encoded by MarshalSignatures.
+func (s *Signer) UnmarshalSignatures(sigsJSON []byte) ([]signing.SignatureV2, error) {
+	return s.enc.UnmarshalSignatureJSON(sigsJSON)
+}
+
+// isMultisigMember returns true if pubKey is one of the keys of the multisig
+// public key.
+func isMultisigMember(multisigPubKey, pubKey cryptotypes.PubKey) bool {
+	multisigKey, ok := multisigPubKey.(*multisig.LegacyAminoPubKey)
+	if !ok {
+		return false
+	}
+	for _, member := range multisigKey.GetPubKeys() {
+		if member.Equals(pubKey) {
+			return true
+		}
+	}
+	return false
+}
+
+// SubmitSignedTx broadcasts a transaction signed outside of the TxClient, for
+// example with CombineSignatures, and confirms it.
+func (client *TxClient) SubmitSignedTx(ctx context.Context, txBytes []byte) (*TxResponse, error) {
+	resp, err := client.BroadcastSignedTx(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	return client.ConfirmTx(ctx, resp.TxHash)
+}
+
+// BroadcastSignedTx broadcasts a transaction signed outside of the TxClient.
+// The sequence of every signer of the transaction that is an account of the
+// TxClient is moved past the transaction and the transaction is tracked under
+// the first of them so that ConfirmTx handles its eviction.
+func (client *TxClient) BroadcastSignedTx(ctx context.Context, txBytes []byte) (*sdktypes.TxResponse, error) {
+	client.submitMtx.Lock()
+	defer client.submitMtx.Unlock()
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	tx, err := client.signer.DecodeTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, errors.New("not a signed transaction")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.broadcast(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	tracked := false
+	var trackErr error
+	for _, sig := range sigs {
+		acc := client.signer.AccountByAddress(sdktypes.AccAddress(sig.PubKey.Address()))
+		if acc == nil || sig.Sequence < acc.Sequence() {
+			continue
+		}
+		if !tracked {
+			trackErr = client.trackTx(resp.TxHash, acc.Name(), sig.Sequence)
+			tracked = true
+		}
+		if err := client.signer.SetSequence(acc.Name(), sig.Sequence+1); err != nil {
+			return nil, fmt.Errorf("setting sequence: %w", err)
+		}
+	}
+	// the transaction was broadcast so the response is returned even if it
+	// couldn't be tracked.
+	return resp, trackErr
+}
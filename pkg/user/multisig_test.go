@@ -0,0 +1,108 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/multisig"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v4/app/params"
+	"github.com/celestiaorg/celestia-app/v4/pkg/user"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testnode"
+)
+
+func TestMultiSignerTxs(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	enc, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+	signer := txClient.Signer()
+	opts := []user.TxOption{user.SetGasLimit(200_000), user.SetFee(2_000)}
+	coins := sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10))
+
+	t.Run("multisig account", func(t *testing.T) {
+		pubKeys := make([]cryptotypes.PubKey, 0, 3)
+		for _, name := range []string{"a", "b", "c"} {
+			record, err := ctx.Keyring.Key(name)
+			require.NoError(t, err)
+			pubKey, err := record.GetPubKey()
+			require.NoError(t, err)
+			pubKeys = append(pubKeys, pubKey)
+		}
+		record, err := ctx.Keyring.SaveMultisig("multi", multisig.NewLegacyAminoPubKey(2, pubKeys))
+		require.NoError(t, err)
+		multiAddr, err := record.GetAddress()
+		require.NoError(t, err)
+
+		fund := bank.NewMsgSend(txClient.DefaultAddress(), multiAddr, sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 1e6)))
+		_, err = txClient.SubmitTx(subCtx, []sdk.Msg{fund})
+		require.NoError(t, err)
+		accNum, seq, err := user.QueryAccount(subCtx, ctx.GRPCClient, enc.InterfaceRegistry, multiAddr)
+		require.NoError(t, err)
+		require.NoError(t, signer.AddAccount(user.NewAccount("multi", accNum, seq)))
+
+		msg := bank.NewMsgSend(multiAddr, testfactory.GetAddress(ctx.Keyring, "a"), coins)
+		unsignedTx, err := signer.CreateUnsignedTx([]sdk.Msg{msg}, opts...)
+		require.NoError(t, err)
+		// the unsigned transaction and the partial signatures are exported
+		// for the party combining them
+		txJSON, err := signer.EncodeTxJSON(unsignedTx)
+		require.NoError(t, err)
+		unsignedTx, err = signer.DecodeTxJSON(txJSON)
+		require.NoError(t, err)
+
+		_, err = signer.CreatePartialSignature(unsignedTx, "multi", "multi")
+		require.Error(t, err, "a multisig key can't sign")
+		sigA, err := signer.CreatePartialSignature(unsignedTx, "multi", "a")
+		require.NoError(t, err)
+		sigC, err := signer.CreatePartialSignature(unsignedTx, "multi", "c")
+		require.NoError(t, err)
+		sigsJSON, err := signer.MarshalSignatures(sigA, sigC)
+		require.NoError(t, err)
+		sigs, err := signer.UnmarshalSignatures(sigsJSON)
+		require.NoError(t, err)
+
+		_, err = signer.CombineMultisigSignatures("multi", sigs[0])
+		require.Error(t, err, "below threshold")
+		multiSig, err := signer.CombineMultisigSignatures("multi", sigs...)
+		require.NoError(t, err)
+		txBytes, err := signer.CombineSignatures(unsignedTx, multiSig)
+		require.NoError(t, err)
+
+		resp, err := txClient.SubmitSignedTx(subCtx, txBytes)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+		require.Equal(t, seq+1, txClient.Account("multi").Sequence())
+	})
+
+	t.Run("fee payer and message signer", func(t *testing.T) {
+		feePayer := testfactory.GetAddress(ctx.Keyring, "b")
+		msg := bank.NewMsgSend(testfactory.GetAddress(ctx.Keyring, "a"), testfactory.GetAddress(ctx.Keyring, "c"), coins)
+		unsignedTx, err := signer.CreateUnsignedTx([]sdk.Msg{msg}, append(opts, user.SetFeePayer(feePayer))...)
+		require.NoError(t, err)
+
+		sigA, err := signer.CreatePartialSignature(unsignedTx, "a", "a")
+		require.NoError(t, err)
+		_, err = signer.CombineSignatures(unsignedTx, sigA)
+		require.Error(t, err, "missing the signature of the fee payer")
+		sigB, err := signer.CreatePartialSignature(unsignedTx, "b", "b")
+		require.NoError(t, err)
+		txBytes, err := signer.CombineSignatures(unsignedTx, sigB, sigA)
+		require.NoError(t, err)
+
+		seqA, seqB := txClient.Account("a").Sequence(), txClient.Account("b").Sequence()
+		resp, err := txClient.SubmitSignedTx(subCtx, txBytes)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+		require.Equal(t, seqA+1, txClient.Account("a").Sequence())
+		require.Equal(t, seqB+1, txClient.Account("b").Sequence())
+	})
+}
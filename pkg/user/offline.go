@@ -0,0 +1,205 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/core"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+// OfflineSigner builds and signs transactions without a connection to a node,
+// for example on an air-gapped machine. The caller supplies the chain ID, the
+// account numbers and sequences of the accounts, and the gas price. The signed
+// transactions are submitted later by a Broadcaster.
+//
+// Every signed transaction increments the sequence of its signer so that
+// consecutive transactions are signed with consecutive sequences. The
+// transactions must be broadcast in the order in which they were signed.
+// OfflineSigner is not thread-safe.
+type OfflineSigner struct {
+	signer     *Signer
+	gasPrice   float64
+	blobParams types.Params
+}
+
+// NewOfflineSigner returns an OfflineSigner signing with the keys of backend
+// for the provided accounts, created with NewAccount, on the chain chainID.
+// Fees are computed from gasPrice, in utia per unit of gas, unless they are
+// set with SetFee.
+func NewOfflineSigner(backend SignerBackend, encCfg client.TxConfig, chainID string, gasPrice float64, accounts ...*Account) (*OfflineSigner, error) {
+	if gasPrice < 0 {
+		return nil, fmt.Errorf("gas price %f must not be negative", gasPrice)
+	}
+	signer, err := NewSignerWithBackend(backend, encCfg, chainID, accounts...)
+	if err != nil {
+		return nil, err
+	}
+	return &OfflineSigner{signer: signer, gasPrice: gasPrice, blobParams: types.DefaultParams()}, nil
+}
+
+// SetBlobParams sets the blob params that the gas limits of PFBs are estimated
+// with. They default to the default blob params and should be set to the
+// params of the chain, for example queried with QueryBlobParams, if it prices
+// blobs differently.
+func (s *OfflineSigner) SetBlobParams(params types.Params) {
+	s.blobParams = params
+}
+
+// CreatePayForBlobs signs a PFB paying for blobs with account and returns the
+// encoded BlobTx. The gas limit defaults to the estimate of
+// types.EstimateGasWithParams under the blob params set with SetBlobParams and
+// can be overwritten with SetGasLimit.
+func (s *OfflineSigner) CreatePayForBlobs(account string, blobs []*share.Blob, opts ...TxOption) ([]byte, error) {
+	gasLimit := types.EstimateGasWithParams(s.blobParams, blobs, appconsts.DefaultTxSizeCostPerByte)
+	// prepend the gas limit, so it can be overwritten in case the user has
+	// specified it.
+	opts = append([]TxOption{SetGasLimit(gasLimit)}, opts...)
+	// the fee depends on the gas limit set by the options
+	builder, err := s.signer.txBuilder(nil, opts...)
+	if err != nil {
+		return nil, err
+	}
+	opts = append([]TxOption{SetFee(s.fee(builder.GetTx().GetGas()))}, opts...)
+
+	blobTx, _, err := s.signer.CreatePayForBlobs(account, blobs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.signer.IncrementSequence(account); err != nil {
+		return nil, fmt.Errorf("increment sequencing: %w", err)
+	}
+	return blobTx, nil
+}
+
+// CreateTx signs a transaction of msgs and returns the encoded transaction.
+// The gas can't be estimated without a connection to a node so the gas limit
+// must be set with SetGasLimit.
+func (s *OfflineSigner) CreateTx(msgs []sdktypes.Msg, opts ...TxOption) ([]byte, error) {
+	builder, err := s.signer.txBuilder(msgs, opts...)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit := builder.GetTx().GetGas()
+	if gasLimit == 0 {
+		return nil, errors.New("gas limit must be set to sign a transaction offline")
+	}
+	if builder.GetTx().GetFee().AmountOf(appconsts.BondDenom).IsZero() {
+		builder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewIntFromUint64(s.fee(gasLimit)))))
+	}
+
+	account, _, err := s.signer.signTransaction(builder)
+	if err != nil {
+		return nil, err
+	}
+	txBytes, err := s.signer.EncodeTx(builder.GetTx())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.signer.IncrementSequence(account); err != nil {
+		return nil, fmt.Errorf("increment sequencing: %w", err)
+	}
+	return txBytes, nil
+}
+
+// fee returns the fee paying the gas price for gasLimit.
+func (s *OfflineSigner) fee(gasLimit uint64) uint64 {
+	return uint64(math.Ceil(s.gasPrice * float64(gasLimit)))
+}
+
+// Signer returns the signer of the OfflineSigner, for example to add accounts
+// or set the sign mode.
+func (s *OfflineSigner) Signer() *Signer {
+	return s.signer
+}
+
+// Broadcaster submits transactions signed elsewhere, for example by an
+// OfflineSigner, and confirms them. It doesn't hold any key.
+type Broadcaster struct {
+	conn     *grpc.ClientConn
+	pollTime time.Duration
+}
+
+// NewBroadcaster returns a Broadcaster submitting transactions to the node at
+// conn.
+func NewBroadcaster(conn *grpc.ClientConn) *Broadcaster {
+	return &Broadcaster{conn: conn, pollTime: DefaultPollTime}
+}
+
+// SetPollTime sets the interval at which ConfirmTx polls the status of a
+// transaction.
+func (b *Broadcaster) SetPollTime(pollTime time.Duration) {
+	b.pollTime = pollTime
+}
+
+// SubmitTx broadcasts an encoded transaction or BlobTx and confirms it.
+func (b *Broadcaster) SubmitTx(ctx context.Context, txBytes []byte) (*TxResponse, error) {
+	resp, err := b.BroadcastTx(ctx, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	return b.ConfirmTx(ctx, resp.TxHash)
+}
+
+// BroadcastTx broadcasts an encoded transaction or BlobTx. It does not confirm
+// that the transaction has been committed on chain.
+func (b *Broadcaster) BroadcastTx(ctx context.Context, txBytes []byte) (*sdktypes.TxResponse, error) {
+	return broadcastRawTx(ctx, b.conn, txBytes)
+}
+
+// ConfirmTx polls the status of the transaction until it is committed, it is
+// evicted or rejected, or the context is cancelled. Unlike TxClient.ConfirmTx,
+// an evicted transaction is not resubmitted since the Broadcaster can't sign.
+func (b *Broadcaster) ConfirmTx(ctx context.Context, txHash string) (*TxResponse, error) {
+	txClient := tx.NewTxClient(b.conn)
+
+	pollTicker := time.NewTicker(b.pollTime)
+	defer pollTicker.Stop()
+
+	for {
+		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
+		if err != nil {
+			return nil, err
+		}
+
+		switch resp.Status {
+		case core.TxStatusPending:
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-pollTicker.C:
+				continue
+			}
+		case core.TxStatusCommitted:
+			if resp.ExecutionCode != abci.CodeTypeOK {
+				return nil, &ExecutionError{
+					TxHash:   txHash,
+					Code:     resp.ExecutionCode,
+					ErrorLog: resp.Error,
+				}
+			}
+			return &TxResponse{
+				Height: resp.Height,
+				TxHash: txHash,
+				Code:   resp.ExecutionCode,
+			}, nil
+		case core.TxStatusEvicted:
+			return nil, fmt.Errorf("tx %s was evicted from the mempool and must be broadcast again", txHash)
+		default:
+			return nil, fmt.Errorf("transaction with hash %s not found; it was likely rejected", txHash)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/app/params"
+	"github.com/celestiaorg/celestia-app/v4/pkg/user"
+	"github.com/celestiaorg/celestia-app/v4/test/util/random"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testnode"
+)
+
+func TestOfflineSigning(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	enc, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	// the account number, the sequence and the chain ID are exported from
+	// the online client and supplied to the offline signer
+	acc := txClient.Account("b")
+	offlineSigner, err := user.NewOfflineSigner(
+		user.NewKeyringBackend(ctx.Keyring),
+		enc.TxConfig,
+		txClient.Signer().ChainID(),
+		0.002,
+		user.NewAccount("b", acc.AccountNumber(), acc.Sequence()),
+	)
+	require.NoError(t, err)
+
+	blob, err := share.NewV0Blob(share.RandomBlobNamespace(), random.Bytes(1000))
+	require.NoError(t, err)
+	blobTx, err := offlineSigner.CreatePayForBlobs("b", []*share.Blob{blob})
+	require.NoError(t, err)
+
+	msg := bank.NewMsgSend(acc.Address(), testfactory.GetAddress(ctx.Keyring, "a"), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	_, err = offlineSigner.CreateTx([]sdk.Msg{msg})
+	require.Error(t, err, "gas limit can't be estimated offline")
+	sendTx, err := offlineSigner.CreateTx([]sdk.Msg{msg}, user.SetGasLimit(100_000))
+	require.NoError(t, err)
+	require.Equal(t, acc.Sequence()+2, offlineSigner.Signer().Account("b").Sequence())
+
+	broadcaster := user.NewBroadcaster(ctx.GRPCClient)
+	broadcaster.SetPollTime(100 * time.Millisecond)
+	for _, txBytes := range [][]byte{blobTx, sendTx} {
+		resp, err := broadcaster.SubmitTx(subCtx, txBytes)
+		require.NoError(t, err)
+		require.EqualValues(t, 0, resp.Code)
+		require.NotZero(t, resp.Height)
+	}
+}
@@ -0,0 +1,409 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"cosmossdk.io/x/feegrant"
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+const (
+	// DefaultLaneFunding is the amount of utia sent to every lane account
+	// that doesn't exist yet or, when lanes use a fee grant, the spend limit
+	// of the allowance granted to every lane.
+	DefaultLaneFunding = 1_000_000
+	// DefaultLaneFeeGrantDuration is how long the allowance granted to every
+	// lane is valid for when lanes use a fee grant.
+	DefaultLaneFeeGrantDuration = 7 * 24 * time.Hour
+	// DefaultLaneNamePrefix is the prefix of the keyring names of the lane
+	// accounts.
+	DefaultLaneNamePrefix = "lane"
+)
+
+type ParallelOption func(client *ParallelTxClient)
+
+// WithLaneFunding sets the amount of utia the primary account sends to every
+// lane account that doesn't exist yet or, when lanes use a fee grant, the
+// spend limit of the allowance granted to every lane.
+func WithLaneFunding(amount uint64) ParallelOption {
+	return func(c *ParallelTxClient) {
+		c.funding = amount
+	}
+}
+
+// WithLaneFeeGrant makes the primary account pay the fees of the lanes through
+// a fee grant instead of funding them. Lane accounts that don't exist yet are
+// created by the grant. The allowance of every lane is limited to the lane
+// funding and expires after duration. Expired allowances are granted again
+// when the lanes are set up.
+func WithLaneFeeGrant(duration time.Duration) ParallelOption {
+	return func(c *ParallelTxClient) {
+		c.feeGrant = true
+		c.feeGrantDuration = duration
+	}
+}
+
+// WithLaneMnemonic sets the mnemonic the lane keys missing from the keyring
+// are derived from. Lane i uses the key of account i+1 of the mnemonic so
+// that the key of account 0, usually the primary account, isn't reused. If
+// no mnemonic is set and keys are missing, a new one is generated and
+// returned by LaneMnemonic.
+func WithLaneMnemonic(mnemonic string) ParallelOption {
+	return func(c *ParallelTxClient) {
+		c.mnemonic = mnemonic
+	}
+}
+
+// WithLaneNamePrefix sets the prefix of the keyring names of the lane
+// accounts. The lanes are named <prefix>-0, <prefix>-1 and so on.
+func WithLaneNamePrefix(prefix string) ParallelOption {
+	return func(c *ParallelTxClient) {
+		c.namePrefix = prefix
+	}
+}
+
+// WithLaneOptions sets the options of the TxClient of every lane. The lanes
+// inherit the default gas price and the poll time of the primary client before
+// these options are applied.
+func WithLaneOptions(opts ...Option) ParallelOption {
+	return func(c *ParallelTxClient) {
+		c.laneOptions = append(c.laneOptions, opts...)
+	}
+}
+
+// ParallelTxClient submits transactions across a set of lane accounts. Every
+// lane is a TxClient with a single account that keeps its own sequence, so
+// transactions signed by different lanes are broadcast concurrently instead of
+// one at a time. Throughput scales with the number of lanes. ParallelTxClient
+// is thread-safe.
+type ParallelTxClient struct {
+	primary *TxClient
+	// lanes holds the lanes that are not broadcasting a transaction.
+	lanes     chan *TxClient
+	allLanes  []*TxClient
+	laneNames []string
+
+	funding          uint64
+	feeGrant         bool
+	feeGrantDuration time.Duration
+	mnemonic         string
+	namePrefix       string
+	laneOptions      []Option
+}
+
+// SetupParallelTxClient sets up numLanes lanes whose accounts are funded, or
+// granted an allowance, by the default account of the primary client. Lane
+// keys missing from the signer backend of the primary client are derived from
+// the lane mnemonic if the backend is a keyring and lane accounts missing from
+// state are funded in a single transaction. Lane accounts that already exist
+// are assumed to be funded.
+func SetupParallelTxClient(
+	ctx context.Context,
+	primary *TxClient,
+	numLanes int,
+	options ...ParallelOption,
+) (*ParallelTxClient, error) {
+	if numLanes <= 0 {
+		return nil, errors.New("number of lanes must be positive")
+	}
+	client := &ParallelTxClient{
+		primary:          primary,
+		lanes:            make(chan *TxClient, numLanes),
+		laneNames:        make([]string, numLanes),
+		funding:          DefaultLaneFunding,
+		feeGrantDuration: DefaultLaneFeeGrantDuration,
+		namePrefix:       DefaultLaneNamePrefix,
+	}
+	for _, opt := range options {
+		opt(client)
+	}
+
+	backend := primary.signer.backend
+	addresses := make([]sdktypes.AccAddress, numLanes)
+	for i := range numLanes {
+		name := fmt.Sprintf("%s-%d", client.namePrefix, i)
+		addr, err := client.laneAddress(backend, name, i)
+		if err != nil {
+			return nil, err
+		}
+		client.laneNames[i] = name
+		addresses[i] = addr
+	}
+
+	if err := client.fundLanes(ctx, addresses); err != nil {
+		return nil, fmt.Errorf("funding lanes: %w", err)
+	}
+
+	laneOptions := append([]Option{
+		WithDefaultGasPrice(primary.defaultGasPrice),
+		WithPollTime(primary.pollTime),
+	}, client.laneOptions...)
+	for i, name := range client.laneNames {
+		accNum, seqNum, err := QueryAccount(ctx, primary.grpc, primary.registry, addresses[i])
+		if err != nil {
+			return nil, fmt.Errorf("querying lane account %s: %w", name, err)
+		}
+		signer, err := NewSignerWithBackend(backend, primary.signer.enc, primary.signer.chainID, NewAccount(name, accNum, seqNum))
+		if err != nil {
+			return nil, fmt.Errorf("creating signer of lane %s: %w", name, err)
+		}
+		signer.signMode = primary.signer.signMode
+		opts := append([]Option{WithDefaultAccount(name)}, laneOptions...)
+		lane, err := NewTxClient(primary.cdc, signer, primary.grpc, primary.registry, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if err := lane.ReconcileTxTracker(ctx); err != nil {
+			return nil, fmt.Errorf("reconciling tx tracker of lane %s: %w", name, err)
+		}
+		client.lanes <- lane
+		client.allLanes = append(client.allLanes, lane)
+	}
+	return client, nil
+}
+
+// laneAddress returns the address of the key name of the lane at index,
+// deriving the key from the lane mnemonic if it isn't known to the backend
+// and the backend is a keyring.
+func (client *ParallelTxClient) laneAddress(backend SignerBackend, name string, index int) (sdktypes.AccAddress, error) {
+	if key, err := backend.Key(name); err == nil {
+		return key.Address(), nil
+	}
+	kb, ok := backend.(*keyringBackend)
+	if !ok {
+		return nil, fmt.Errorf("creating key %s: %w", name, errNoKeyring)
+	}
+	path := hd.CreateHDPath(sdktypes.CoinType, uint32(index+1), 0).String()
+	var (
+		record *keyring.Record
+		err    error
+	)
+	if client.mnemonic == "" {
+		record, client.mnemonic, err = kb.keys.NewMnemonic(name, keyring.English, path, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	} else {
+		record, err = kb.keys.NewAccount(name, client.mnemonic, keyring.DefaultBIP39Passphrase, path, hd.Secp256k1)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("creating key %s: %w", name, err)
+	}
+	return record.GetAddress()
+}
+
+// fundLanes funds the lane accounts that don't exist yet or, if lanes use a fee
+// grant, grants an allowance to the lane accounts that don't have one from the
+// primary account.
+func (client *ParallelTxClient) fundLanes(ctx context.Context, addresses []sdktypes.AccAddress) error {
+	granter := client.primary.DefaultAddress()
+	msgs := make([]sdktypes.Msg, 0, len(addresses))
+	for _, addr := range addresses {
+		_, _, err := QueryAccount(ctx, client.primary.grpc, client.primary.registry, addr)
+		exists := err == nil
+
+		if client.feeGrant {
+			if exists {
+				granted, expired, err := client.allowance(ctx, granter, addr)
+				if err != nil {
+					return err
+				}
+				if granted && !expired {
+					continue
+				}
+				if granted {
+					revoke := feegrant.NewMsgRevokeAllowance(granter, addr)
+					msgs = append(msgs, &revoke)
+				}
+			}
+			expiration := time.Now().Add(client.feeGrantDuration)
+			allowance := &feegrant.BasicAllowance{
+				SpendLimit: sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, int64(client.funding))),
+				Expiration: &expiration,
+			}
+			msg, err := feegrant.NewMsgGrantAllowance(allowance, granter, addr)
+			if err != nil {
+				return fmt.Errorf("creating fee grant: %w", err)
+			}
+			msgs = append(msgs, msg)
+			continue
+		}
+
+		if !exists {
+			amount := sdktypes.NewCoins(sdktypes.NewInt64Coin(appconsts.BondDenom, int64(client.funding)))
+			msgs = append(msgs, banktypes.NewMsgSend(granter, addr, amount))
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+	_, err := client.primary.SubmitTx(ctx, msgs)
+	return err
+}
+
+// allowance returns whether granter granted an allowance to grantee and
+// whether it has expired.
+func (client *ParallelTxClient) allowance(ctx context.Context, granter, grantee sdktypes.AccAddress) (granted, expired bool, err error) {
+	resp, err := feegrant.NewQueryClient(client.primary.grpc).Allowances(ctx, &feegrant.QueryAllowancesRequest{
+		Grantee: grantee.String(),
+	})
+	if err != nil {
+		return false, false, fmt.Errorf("querying allowances of %s: %w", grantee, err)
+	}
+	for _, grant := range resp.Allowances {
+		if grant.Granter != granter.String() {
+			continue
+		}
+		var allowance feegrant.FeeAllowanceI
+		if err := client.primary.registry.UnpackAny(grant.Allowance, &allowance); err != nil {
+			return false, false, fmt.Errorf("decoding allowance of %s: %w", grantee, err)
+		}
+		expiresAt, err := allowance.ExpiresAt()
+		if err != nil {
+			return false, false, err
+		}
+		return true, expiresAt != nil && !expiresAt.After(time.Now()), nil
+	}
+	return false, false, nil
+}
+
+// SubmitPayForBlob forms a transaction from the provided blobs, signs it with
+// the first available lane, and submits it to the chain. The lane is released
+// as soon as the transaction is broadcast so that it can sign the next
+// transaction while this one is confirmed. TxOptions may be provided to set the
+// fee and gas limit.
+func (client *ParallelTxClient) SubmitPayForBlob(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
+	resp, lane, err := client.broadcastPayForBlob(ctx, blobs, opts)
+	if err != nil && !isTxTrackingError(err) {
+		return nil, err
+	}
+	return lane.ConfirmTx(ctx, resp.TxHash)
+}
+
+// BroadcastPayForBlob forms a transaction from the provided blobs, signs it
+// with the first available lane, and broadcasts it. It does not confirm that
+// the transaction has been committed; use ConfirmTx for that.
+func (client *ParallelTxClient) BroadcastPayForBlob(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	resp, _, err := client.broadcastPayForBlob(ctx, blobs, opts)
+	return resp, err
+}
+
+func (client *ParallelTxClient) broadcastPayForBlob(ctx context.Context, blobs []*share.Blob, opts []TxOption) (*sdktypes.TxResponse, *TxClient, error) {
+	return client.withLane(ctx, func(lane *TxClient) (*sdktypes.TxResponse, error) {
+		if client.feeGrant {
+			feeGrantOpts, err := client.payForBlobFeeGrantOptions(ctx, lane, blobs)
+			if err != nil {
+				return nil, err
+			}
+			// prepend the fee grant params, so they can be overwritten in case
+			// the user has specified them.
+			opts = append(feeGrantOpts, opts...)
+		}
+		return lane.BroadcastPayForBlob(ctx, blobs, opts...)
+	})
+}
+
+// payForBlobFeeGrantOptions returns the options of a PFB of the lane paid by
+// the fee grant of the primary account. The gas limit is estimated by the node
+// because using the fee grant consumes gas and the fee is paid at the gas
+// price of the lane, or the blob base fee if it is higher.
+func (client *ParallelTxClient) payForBlobFeeGrantOptions(ctx context.Context, lane *TxClient, blobs []*share.Blob) ([]TxOption, error) {
+	granter := SetFeeGranter(client.primary.DefaultAddress())
+	msg, err := types.NewMsgPayForBlobs(lane.DefaultAddress().String(), appconsts.LatestVersion, blobs...)
+	if err != nil {
+		return nil, err
+	}
+	gasLimit, err := lane.EstimateGas(ctx, []sdktypes.Msg{msg}, granter)
+	if err != nil {
+		return nil, fmt.Errorf("estimating gas: %w", err)
+	}
+	blobBaseFee, err := lane.blobBaseFee.get(ctx, lane.grpc)
+	if err != nil {
+		return nil, err
+	}
+	fee := uint64(math.Ceil(max(lane.gasPrice(), blobBaseFee) * float64(gasLimit)))
+	return []TxOption{SetGasLimit(gasLimit), SetFee(fee), granter}, nil
+}
+
+// SubmitTx signs the messages returned by newMsgs for the address of the
+// first available lane and submits them to the chain. The lane is released as
+// soon as the transaction is broadcast. If lanes use a fee grant, the fee is
+// paid by the primary account. TxOptions may be provided to set the fee and
+// gas limit.
+func (client *ParallelTxClient) SubmitTx(ctx context.Context, newMsgs func(signer sdktypes.AccAddress) []sdktypes.Msg, opts ...TxOption) (*TxResponse, error) {
+	resp, lane, err := client.broadcastTx(ctx, newMsgs, opts)
+	if err != nil && !isTxTrackingError(err) {
+		return nil, err
+	}
+	return lane.ConfirmTx(ctx, resp.TxHash)
+}
+
+// BroadcastTx signs the messages returned by newMsgs for the address of the
+// first available lane and broadcasts them. It does not confirm that the
+// transaction has been committed; use ConfirmTx for that.
+func (client *ParallelTxClient) BroadcastTx(ctx context.Context, newMsgs func(signer sdktypes.AccAddress) []sdktypes.Msg, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	resp, _, err := client.broadcastTx(ctx, newMsgs, opts)
+	return resp, err
+}
+
+func (client *ParallelTxClient) broadcastTx(ctx context.Context, newMsgs func(signer sdktypes.AccAddress) []sdktypes.Msg, opts []TxOption) (*sdktypes.TxResponse, *TxClient, error) {
+	return client.withLane(ctx, func(lane *TxClient) (*sdktypes.TxResponse, error) {
+		if client.feeGrant {
+			opts = append([]TxOption{SetFeeGranter(client.primary.DefaultAddress())}, opts...)
+		}
+		return lane.BroadcastTx(ctx, newMsgs(lane.DefaultAddress()), opts...)
+	})
+}
+
+// withLane calls broadcast with the first available lane and releases the
+// lane once it returns. It returns the lane along with the response.
+func (client *ParallelTxClient) withLane(ctx context.Context, broadcast func(lane *TxClient) (*sdktypes.TxResponse, error)) (*sdktypes.TxResponse, *TxClient, error) {
+	var lane *TxClient
+	select {
+	case <-ctx.Done():
+		return nil, nil, ctx.Err()
+	case lane = <-client.lanes:
+	}
+	defer func() {
+		client.lanes <- lane
+	}()
+	resp, err := broadcast(lane)
+	return resp, lane, err
+}
+
+// ConfirmTx confirms a transaction broadcast by one of the lanes. The lane
+// that broadcast the transaction follows its resubmissions, if any.
+func (client *ParallelTxClient) ConfirmTx(ctx context.Context, txHash string) (*TxResponse, error) {
+	for _, lane := range client.allLanes {
+		if lane.isTracked(txHash) {
+			return lane.ConfirmTx(ctx, txHash)
+		}
+	}
+	return client.primary.ConfirmTx(ctx, txHash)
+}
+
+// Primary returns the client that funds the lanes.
+func (client *ParallelTxClient) Primary() *TxClient {
+	return client.primary
+}
+
+// LaneMnemonic returns the mnemonic the lane keys were derived from. It is
+// empty if all the lane keys already existed and no mnemonic was set. It must
+// be stored to recover the lane accounts if the keyring isn't persistent.
+func (client *ParallelTxClient) LaneMnemonic() string {
+	return client.mnemonic
+}
+
+// Lanes returns the keyring names of the lane accounts.
+func (client *ParallelTxClient) Lanes() []string {
+	return append([]string(nil), client.laneNames...)
+}
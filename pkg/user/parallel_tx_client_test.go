@@ -0,0 +1,98 @@
+package user_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bank "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v4/pkg/user"
+	"github.com/celestiaorg/celestia-app/v4/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v4/test/util/random"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testnode"
+)
+
+func TestParallelTxClient(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	_, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration)
+
+	for _, tc := range []struct {
+		name     string
+		prefix   string
+		feeGrant bool
+	}{
+		{name: "funded lanes", prefix: "funded"},
+		{name: "fee granted lanes", prefix: "granted", feeGrant: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			options := []user.ParallelOption{user.WithLaneNamePrefix(tc.prefix)}
+			if tc.feeGrant {
+				options = append(options, user.WithLaneFeeGrant(user.DefaultLaneFeeGrantDuration))
+			}
+			subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+			defer cancel()
+
+			client, err := user.SetupParallelTxClient(subCtx, txClient, 3, options...)
+			require.NoError(t, err)
+			require.Len(t, client.Lanes(), 3)
+			// the lane keys were derived from a new mnemonic
+			require.NotEmpty(t, client.LaneMnemonic())
+
+			var wg sync.WaitGroup
+			errs := make(chan error, 9)
+			for range 9 {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					blobs := blobfactory.ManyRandBlobs(random.New(), 1e3)
+					_, err := client.SubmitPayForBlob(subCtx, blobs)
+					errs <- err
+				}()
+			}
+			wg.Wait()
+			close(errs)
+			for err := range errs {
+				require.NoError(t, err)
+			}
+
+			if !tc.feeGrant {
+				resp, err := client.SubmitTx(subCtx, func(signer sdk.AccAddress) []sdk.Msg {
+					return []sdk.Msg{bank.NewMsgSend(signer, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1)))}
+				})
+				require.NoError(t, err)
+				require.Equal(t, abci.CodeTypeOK, resp.Code)
+			}
+
+			for _, name := range client.Lanes() {
+				record, err := ctx.Keyring.Key(name)
+				require.NoError(t, err)
+				addr, err := record.GetAddress()
+				require.NoError(t, err)
+				resp, err := bank.NewQueryClient(ctx.GRPCClient).Balance(subCtx, &bank.QueryBalanceRequest{
+					Address: addr.String(),
+					Denom:   appconsts.BondDenom,
+				})
+				require.NoError(t, err)
+				if tc.feeGrant {
+					// the fees of fee granted lanes are paid by the primary account
+					require.True(t, resp.Balance.IsZero())
+				} else {
+					require.True(t, resp.Balance.Amount.LT(sdkmath.NewInt(user.DefaultLaneFunding)))
+				}
+			}
+
+			// setting up the lanes again reuses the existing accounts
+			_, err = user.SetupParallelTxClient(subCtx, txClient, 3, options...)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -10,7 +10,7 @@ import (
 
 func TestPruningInTxTracker(t *testing.T) {
 	txClient := &TxClient{
-		txTracker: make(map[string]txInfo),
+		txTracker: NewMemTxTracker(),
 	}
 	numTransactions := 10
 
@@ -20,31 +20,53 @@ func TestPruningInTxTracker(t *testing.T) {
 	for i := 0; i < numTransactions; i++ {
 		// 5 transactions will be pruned
 		if i%2 == 0 {
-			txClient.txTracker["tx"+fmt.Sprint(i)] = txInfo{
-				signer:   "signer" + fmt.Sprint(i),
-				sequence: uint64(i),
-				timestamp: time.Now().
+			require.NoError(t, txClient.txTracker.Add(TrackedTx{
+				TxHash:   "tx" + fmt.Sprint(i),
+				Signer:   "signer" + fmt.Sprint(i),
+				Sequence: uint64(i),
+				Timestamp: time.Now().
 					Add(-10 * time.Minute),
-			}
+			}))
 			txsToBePruned++
 		} else {
-			txClient.txTracker["tx"+fmt.Sprint(i)] = txInfo{
-				signer:   "signer" + fmt.Sprint(i),
-				sequence: uint64(i),
-				timestamp: time.Now().
+			require.NoError(t, txClient.txTracker.Add(TrackedTx{
+				TxHash:   "tx" + fmt.Sprint(i),
+				Signer:   "signer" + fmt.Sprint(i),
+				Sequence: uint64(i),
+				Timestamp: time.Now().
 					Add(-5 * time.Minute),
-			}
+			}))
 			txsNotReadyToBePruned++
 		}
 	}
 
-	txTrackerBeforePruning := len(txClient.txTracker)
+	trackedTxs, err := txClient.txTracker.List()
+	require.NoError(t, err)
+	txTrackerBeforePruning := len(trackedTxs)
 
 	// All transactions were indexed
-	require.Equal(t, numTransactions, len(txClient.txTracker))
-	txClient.pruneTxTracker()
+	require.Equal(t, numTransactions, txTrackerBeforePruning)
+	require.NoError(t, txClient.pruneTxTracker())
 	// Prunes the transactions that are 10 minutes old
 	// 5 transactions will be pruned
+	trackedTxs, err = txClient.txTracker.List()
+	require.NoError(t, err)
 	require.Equal(t, txsToBePruned, txTrackerBeforePruning-txsToBePruned)
-	require.Equal(t, len(txClient.txTracker), txsNotReadyToBePruned)
+	require.Equal(t, len(trackedTxs), txsNotReadyToBePruned)
+
+	// the tracker isn't pruned again until txTrackerPruningFrequency elapsed
+	require.NoError(t, txClient.txTracker.Add(TrackedTx{
+		TxHash:    "old",
+		Timestamp: time.Now().Add(-time.Hour),
+	}))
+	require.NoError(t, txClient.pruneTxTracker())
+	_, exists, err := txClient.txTracker.Get("old")
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	txClient.lastPruned = time.Now().Add(-txTrackerPruningFrequency)
+	require.NoError(t, txClient.pruneTxTracker())
+	_, exists, err = txClient.txTracker.Get("old")
+	require.NoError(t, err)
+	require.False(t, exists)
 }
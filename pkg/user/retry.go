@@ -0,0 +1,434 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/cometbft/cometbft/rpc/core"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	apperrors "github.com/celestiaorg/celestia-app/v4/app/errors"
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/tx"
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+)
+
+// RetryPolicy configures how the TxClient resubmits transactions that were
+// rejected at broadcast because of a sequence mismatch, or evicted or rejected
+// from the mempool after they were broadcast.
+type RetryPolicy struct {
+	// MaxRetries is the number of times a transaction is resubmitted before
+	// giving up.
+	MaxRetries int
+	// GasPriceMultiplier is applied to the gas price of a transaction every
+	// time it is resubmitted after it left the mempool. Values lower than 1
+	// keep the gas price unchanged.
+	GasPriceMultiplier float64
+	// EstimateGasPrice raises the gas price of a resubmitted transaction to
+	// the price estimated by the gas estimation service for GasPricePriority,
+	// if it is higher.
+	EstimateGasPrice bool
+	GasPricePriority gasestimation.TxPriority
+}
+
+// DefaultRetryPolicy returns a policy that resubmits a transaction up to three
+// times, increasing its gas price by 10% every time it left the mempool.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:         3,
+		GasPriceMultiplier: 1.1,
+	}
+}
+
+// WithRetryPolicy enables resubmitting transactions according to the provided
+// policy. When a transaction is rejected at broadcast because of a sequence
+// mismatch, it is signed again with the sequence expected by the node. When a
+// transaction is evicted or rejected from the mempool, it is signed again with
+// the same sequence and a higher gas price and resubmitted along with the
+// other transactions of the same signer that left the mempool, in sequence
+// order. ConfirmTx follows the resubmitted transactions and only fails once
+// the policy is exhausted.
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *TxClient) {
+		c.retryPolicy = &policy
+	}
+}
+
+// WithFeeBumping keeps what is needed to sign the submitted transactions again
+// so that their fee can be raised with BumpFee while they are pending. It is
+// implied by WithRetryPolicy.
+func WithFeeBumping() Option {
+	return func(c *TxClient) {
+		c.feeBumping = true
+	}
+}
+
+// errRetriesExhausted is returned when a transaction can't be resubmitted
+// anymore.
+var errRetriesExhausted = errors.New("retries exhausted")
+
+// submission holds what is needed to sign a transaction again.
+type submission struct {
+	account string
+	// blobs are set for PayForBlobs transactions, msgs for the others.
+	blobs    []*share.Blob
+	msgs     []sdktypes.Msg
+	opts     []TxOption
+	gasLimit uint64
+	// fee is the fee in utia.
+	fee     uint64
+	retries int
+	// timestamp is the time the transaction was last broadcast.
+	timestamp time.Time
+}
+
+// txOptions returns the options of the submission with its current gas limit
+// and fee.
+func (s *submission) txOptions() []TxOption {
+	opts := make([]TxOption, 0, len(s.opts)+2)
+	opts = append(opts, s.opts...)
+	return append(opts, SetGasLimit(s.gasLimit), SetFee(s.fee))
+}
+
+// replacement is the hash of the transaction a resubmitted transaction was
+// replaced by.
+type replacement struct {
+	txHash    string
+	timestamp time.Time
+}
+
+// gasLimitAndFee returns the gas limit and the utia fee set by opts.
+func (client *TxClient) gasLimitAndFee(opts []TxOption) (uint64, uint64, error) {
+	txBuilder, err := client.signer.txBuilder(nil, opts...)
+	if err != nil {
+		return 0, 0, err
+	}
+	fee := txBuilder.GetTx().GetFee().AmountOf(appconsts.BondDenom)
+	if !fee.IsUint64() {
+		return 0, 0, fmt.Errorf("fee %s overflows uint64", fee)
+	}
+	return txBuilder.GetTx().GetGas(), fee.Uint64(), nil
+}
+
+// keepsSubmissions returns true if the submissions have to be kept to be signed
+// again after they were broadcast.
+func (client *TxClient) keepsSubmissions() bool {
+	return client.retryPolicy != nil || client.feeBumping
+}
+
+// signSubmission signs the submission with the current sequence of its
+// account.
+func (client *TxClient) signSubmission(sub *submission) ([]byte, error) {
+	if sub.blobs != nil {
+		txBytes, _, err := client.signer.createPayForBlobs(client.signUnlocked, sub.account, sub.blobs, sub.txOptions()...)
+		return txBytes, err
+	}
+	txBuilder, err := client.signer.txBuilder(sub.msgs, sub.txOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := client.signer.signTransactionWith(txBuilder, client.signUnlocked); err != nil {
+		return nil, err
+	}
+	return client.signer.EncodeTx(txBuilder.GetTx())
+}
+
+// submit signs the submission and broadcasts it. If a retry policy is set and
+// the node reports a sequence mismatch, the sequence of the account is set to
+// the one expected by the node and the submission is signed and broadcast
+// again.
+func (client *TxClient) submit(ctx context.Context, sub *submission) (*sdktypes.TxResponse, error) {
+	for {
+		txBytes, err := client.signSubmission(sub)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.broadcastTx(ctx, txBytes, sub.account)
+		if resp != nil {
+			if client.keepsSubmissions() {
+				sub.timestamp = time.Now()
+				client.submissions[resp.TxHash] = sub
+			}
+			return resp, err
+		}
+
+		expected, ok := client.expectedSequence(err)
+		if !ok || sub.retries >= client.retryPolicy.MaxRetries {
+			return nil, err
+		}
+		sub.retries++
+		if err := client.signer.SetSequence(sub.account, expected); err != nil {
+			return nil, fmt.Errorf("setting sequence: %w", err)
+		}
+	}
+}
+
+// expectedSequence returns the sequence expected by the node if err is a
+// sequence mismatch and a retry policy is set.
+func (client *TxClient) expectedSequence(err error) (uint64, bool) {
+	if client.retryPolicy == nil {
+		return 0, false
+	}
+	var broadcastErr *BroadcastTxError
+	if !errors.As(err, &broadcastErr) || !apperrors.IsNonceMismatchCode(broadcastErr.Code) {
+		return 0, false
+	}
+	expected, err := apperrors.ParseExpectedSequence(broadcastErr.ErrorLog)
+	return expected, err == nil
+}
+
+// resolveReplacement returns the hash of the transaction that last replaced
+// txHash, or txHash if it wasn't resubmitted.
+func (client *TxClient) resolveReplacement(txHash string) string {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	return client.latestReplacement(txHash)
+}
+
+// latestReplacement is resolveReplacement for callers holding the lock.
+func (client *TxClient) latestReplacement(txHash string) string {
+	for {
+		replaced, ok := client.replacements[txHash]
+		if !ok {
+			return txHash
+		}
+		txHash = replaced.txHash
+	}
+}
+
+// replacedTxs returns the hashes of the transactions that txHash replaced,
+// directly or through other replacements, from the most recent one.
+func (client *TxClient) replacedTxs(txHash string) []string {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	replacedBy := make(map[string]string, len(client.replacements))
+	for replacedHash, replaced := range client.replacements {
+		replacedBy[replaced.txHash] = replacedHash
+	}
+	var txHashes []string
+	for {
+		replacedHash, ok := replacedBy[txHash]
+		if !ok {
+			return txHashes
+		}
+		txHashes = append(txHashes, replacedHash)
+		txHash = replacedHash
+	}
+}
+
+// committedReplacedTx returns the hash and the status of the transaction that
+// txHash replaced and that was committed in its place, if any.
+func (client *TxClient) committedReplacedTx(ctx context.Context, txHash string) (string, *tx.TxStatusResponse, bool) {
+	replaced := client.replacedTxs(txHash)
+	if len(replaced) == 0 {
+		return "", nil, false
+	}
+	statuses, err := client.queryTxStatuses(ctx, replaced)
+	if err != nil {
+		return "", nil, false
+	}
+	for _, replacedHash := range replaced {
+		if status, ok := statuses[replacedHash]; ok && status.Status == core.TxStatusCommitted {
+			return replacedHash, status, true
+		}
+	}
+	return "", nil, false
+}
+
+// resubmit resubmits txHash, which is no longer in the mempool, along with
+// the other transactions of the same signer that are no longer in the mempool.
+// Every transaction keeps its sequence so that they are resubmitted in order.
+// It returns the hash of the transaction replacing txHash.
+func (client *TxClient) resubmit(ctx context.Context, txHash string) (string, error) {
+	gasPrice := client.estimateResubmissionGasPrice(ctx, txHash)
+
+	client.submitMtx.Lock()
+	defer client.submitMtx.Unlock()
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	// the transaction may have been resubmitted along with another one since
+	// its status was queried.
+	if replaced, ok := client.replacements[txHash]; ok {
+		return replaced.txHash, nil
+	}
+	trackedTx, exists, err := client.txTracker.Get(txHash)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := client.submissions[txHash]; !ok || !exists {
+		return "", fmt.Errorf("tx %s can't be resubmitted: %w", txHash, errRetriesExhausted)
+	}
+
+	trackedTxs, err := client.txTracker.List()
+	if err != nil {
+		return "", err
+	}
+	txHashes := make([]string, 0)
+	for _, t := range trackedTxs {
+		if _, ok := client.submissions[t.TxHash]; ok && t.Signer == trackedTx.Signer && t.TxHash != txHash {
+			txHashes = append(txHashes, t.TxHash)
+		}
+	}
+	statuses, err := client.queryTxStatuses(ctx, txHashes)
+	if err != nil {
+		return "", err
+	}
+	dropped := []TrackedTx{trackedTx}
+	for _, t := range trackedTxs {
+		status, ok := statuses[t.TxHash]
+		if ok && status.Status != core.TxStatusPending && status.Status != core.TxStatusCommitted {
+			dropped = append(dropped, t)
+		}
+	}
+	sort.Slice(dropped, func(i, j int) bool { return dropped[i].Sequence < dropped[j].Sequence })
+
+	// the transactions are signed again with their own sequence after which
+	// the sequence of the signer is restored.
+	next := client.signer.accounts[trackedTx.Signer].Sequence()
+	var resubmitErr error
+	for _, droppedTx := range dropped {
+		if _, err := client.resubmitTx(ctx, droppedTx, gasPrice); err != nil && droppedTx.TxHash == txHash {
+			resubmitErr = err
+		}
+	}
+	if err := client.signer.SetSequence(trackedTx.Signer, next); err != nil {
+		return "", fmt.Errorf("setting sequence: %w", err)
+	}
+
+	if replaced, ok := client.replacements[txHash]; ok {
+		return replaced.txHash, nil
+	}
+	return "", resubmitErr
+}
+
+// resubmitTx signs the tracked transaction again with its sequence and a
+// higher gas price, at least estimatedGasPrice, and broadcasts it.
+func (client *TxClient) resubmitTx(ctx context.Context, trackedTx TrackedTx, estimatedGasPrice float64) (*sdktypes.TxResponse, error) {
+	sub, ok := client.submissions[trackedTx.TxHash]
+	if !ok {
+		return nil, fmt.Errorf("tx %s: %w", trackedTx.TxHash, errRetriesExhausted)
+	}
+	if err := client.nextAttempt(sub, estimatedGasPrice); err != nil {
+		return nil, fmt.Errorf("tx %s: %w", trackedTx.TxHash, err)
+	}
+	return client.replaceTx(ctx, trackedTx, sub)
+}
+
+// nextAttempt counts a new attempt to submit the submission and raises its
+// fee accordingly. It returns errRetriesExhausted once the retry policy
+// doesn't allow another attempt.
+func (client *TxClient) nextAttempt(sub *submission, estimatedGasPrice float64) error {
+	if sub.retries >= client.retryPolicy.MaxRetries {
+		return errRetriesExhausted
+	}
+	sub.retries++
+	client.raiseFee(sub, estimatedGasPrice)
+	return nil
+}
+
+// replaceTx signs the submission of the tracked transaction again with the
+// sequence of the tracked transaction and broadcasts it. The replacement takes
+// over the submission and ConfirmTx follows it when asked to confirm the
+// tracked transaction. The sequence of the signer is left after the tracked
+// transaction; callers are responsible for restoring it.
+func (client *TxClient) replaceTx(ctx context.Context, trackedTx TrackedTx, sub *submission) (*sdktypes.TxResponse, error) {
+	if err := client.signer.SetSequence(trackedTx.Signer, trackedTx.Sequence); err != nil {
+		return nil, fmt.Errorf("setting sequence: %w", err)
+	}
+	txBytes, err := client.signSubmission(sub)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.broadcastTx(ctx, txBytes, sub.account)
+	if resp == nil {
+		return nil, err
+	}
+	delete(client.submissions, trackedTx.TxHash)
+	sub.timestamp = time.Now()
+	client.submissions[resp.TxHash] = sub
+	client.replacements[trackedTx.TxHash] = replacement{txHash: resp.TxHash, timestamp: time.Now()}
+	client.replaceThrottledTx(trackedTx.TxHash, resp.TxHash)
+	if deleteErr := client.txTracker.Delete(trackedTx.TxHash); deleteErr != nil {
+		return resp, deleteErr
+	}
+	return resp, err
+}
+
+// raiseFee raises the fee of a submission that is about to be resubmitted
+// according to the retry policy, and at least to estimatedGasPrice.
+func (client *TxClient) raiseFee(sub *submission, estimatedGasPrice float64) {
+	multiplier := max(1, client.retryPolicy.GasPriceMultiplier)
+	fee := uint64(math.Ceil(float64(sub.fee) * multiplier))
+	sub.fee = max(fee, uint64(math.Ceil(estimatedGasPrice*float64(sub.gasLimit))))
+}
+
+// estimateResubmissionGasPrice returns the gas price estimated for the
+// resubmission of txHash if the retry policy asks for it, zero otherwise. The
+// estimate is also used for the other transactions of the signer resubmitted
+// along with txHash. It is called before taking the locks so that the
+// round-trip to the gas estimation service doesn't hold up the submissions of
+// the other accounts.
+func (client *TxClient) estimateResubmissionGasPrice(ctx context.Context, txHash string) float64 {
+	if !client.retryPolicy.EstimateGasPrice {
+		return 0
+	}
+	client.mtx.Lock()
+	sub, ok := client.submissions[txHash]
+	client.mtx.Unlock()
+	if !ok {
+		return 0
+	}
+	blobSizes := make([]uint32, len(sub.blobs))
+	for i, blob := range sub.blobs {
+		blobSizes[i] = uint32(len(blob.Data()))
+	}
+	resp, err := client.gasEstimationClient.EstimateGasPrice(ctx, &gasestimation.EstimateGasPriceRequest{
+		TxPriority: client.retryPolicy.GasPricePriority,
+		BlobSizes:  blobSizes,
+	})
+	// the multiplied fee is used if the estimation fails.
+	if err != nil {
+		return 0
+	}
+	return resp.EstimatedGasPrice
+}
+
+// queryTxStatuses returns the status of the provided transactions indexed by
+// their hash.
+func (client *TxClient) queryTxStatuses(ctx context.Context, txHashes []string) (map[string]*tx.TxStatusResponse, error) {
+	txClient := tx.NewTxClient(client.grpc)
+	statuses := make(map[string]*tx.TxStatusResponse, len(txHashes))
+	for start := 0; start < len(txHashes); start += tx.MaxTxStatusesBatchSize {
+		batch := txHashes[start:min(start+tx.MaxTxStatusesBatchSize, len(txHashes))]
+		resp, err := txClient.TxStatuses(ctx, &tx.TxStatusesRequest{TxIds: batch})
+		if err != nil {
+			return nil, fmt.Errorf("querying tx statuses: %w", err)
+		}
+		for _, result := range resp.Statuses {
+			statuses[result.TxId] = result.Status
+		}
+	}
+	return statuses, nil
+}
+
+// pruneRetries removes the submissions and the replacements older than
+// txTrackerPruningInterval, like the tx tracker does for the transactions.
+func (client *TxClient) pruneRetries() {
+	for txHash, sub := range client.submissions {
+		if time.Since(sub.timestamp) >= txTrackerPruningInterval {
+			delete(client.submissions, txHash)
+		}
+	}
+	for txHash, replaced := range client.replacements {
+		if time.Since(replaced.timestamp) >= txTrackerPruningInterval {
+			delete(client.replacements, txHash)
+		}
+	}
+}
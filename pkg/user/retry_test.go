@@ -0,0 +1,59 @@
+package user
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpectedSequence(t *testing.T) {
+	mismatch := &BroadcastTxError{
+		Code:     sdkerrors.ErrWrongSequence.ABCICode(),
+		ErrorLog: "account sequence mismatch, expected 5, got 4: incorrect account sequence",
+	}
+
+	client := &TxClient{}
+	_, ok := client.expectedSequence(mismatch)
+	assert.False(t, ok, "no retry policy")
+
+	client.retryPolicy = &RetryPolicy{MaxRetries: 1}
+	expected, ok := client.expectedSequence(mismatch)
+	require.True(t, ok)
+	assert.Equal(t, uint64(5), expected)
+
+	_, ok = client.expectedSequence(&BroadcastTxError{Code: sdkerrors.ErrInsufficientFee.ABCICode(), ErrorLog: "insufficient fee"})
+	assert.False(t, ok)
+}
+
+func TestRaiseFee(t *testing.T) {
+	client := &TxClient{retryPolicy: &RetryPolicy{GasPriceMultiplier: 1.5}}
+	sub := &submission{gasLimit: 1000, fee: 101}
+	client.raiseFee(sub, 0)
+	assert.Equal(t, uint64(152), sub.fee)
+
+	// a multiplier lower than 1 keeps the fee
+	client.retryPolicy.GasPriceMultiplier = 0.5
+	client.raiseFee(sub, 0)
+	assert.Equal(t, uint64(152), sub.fee)
+
+	// the fee is raised to the estimated gas price if it is higher
+	client.raiseFee(sub, 0.2)
+	assert.Equal(t, uint64(200), sub.fee)
+}
+
+func TestRetryAttempts(t *testing.T) {
+	client := &TxClient{retryPolicy: &RetryPolicy{MaxRetries: 3, GasPriceMultiplier: 2}}
+	sub := &submission{gasLimit: 1000, fee: 1000}
+
+	// every attempt doubles the fee until the retries are exhausted
+	fees := make([]uint64, 0)
+	for client.nextAttempt(sub, 0) == nil {
+		fees = append(fees, sub.fee)
+	}
+	assert.Equal(t, []uint64{2000, 4000, 8000}, fees)
+	assert.Equal(t, 3, sub.retries)
+	require.ErrorIs(t, client.nextAttempt(sub, 0), errRetriesExhausted)
+	assert.Equal(t, uint64(8000), sub.fee)
+}
@@ -26,14 +26,15 @@ import (
 var defaultSignMode = signing.SignMode_SIGN_MODE_DIRECT
 
 // Signer is struct for building and signing Celestia transactions
-// It supports multiple accounts wrapping a Keyring.
+// It supports multiple accounts wrapping a SignerBackend.
 // NOTE: All transactions may only have a single signer
 // Signer is not thread-safe.
 type Signer struct {
-	keys         keyring.Keyring
+	backend      SignerBackend
 	enc          client.TxConfig
 	addressCodec address.Codec
 	chainID      string
+	signMode     signing.SignMode
 	// set of accounts that the signer can manage. Should match the keys on the keyring
 	accounts            map[string]*Account
 	addressToAccountMap map[string]string
@@ -43,9 +44,16 @@ type Signer struct {
 // There must be at least one account in the keyring
 // The first account provided will be set as the default
 func NewSigner(keys keyring.Keyring, encCfg client.TxConfig, chainID string, accounts ...*Account) (*Signer, error) {
+	return NewSignerWithBackend(NewKeyringBackend(keys), encCfg, chainID, accounts...)
+}
+
+// NewSignerWithBackend returns a new signer signing with the keys of the
+// provided backend.
+func NewSignerWithBackend(backend SignerBackend, encCfg client.TxConfig, chainID string, accounts ...*Account) (*Signer, error) {
 	s := &Signer{
-		keys:                keys,
+		backend:             backend,
 		chainID:             chainID,
+		signMode:            defaultSignMode,
 		enc:                 encCfg,
 		addressCodec:        addresscodec.NewBech32Codec(params.Bech32PrefixAccAddr),
 		accounts:            make(map[string]*Account),
@@ -75,12 +83,17 @@ func (s *Signer) CreateTx(msgs []sdktypes.Msg, opts ...TxOption) ([]byte, authsi
 }
 
 func (s *Signer) SignTx(msgs []sdktypes.Msg, opts ...TxOption) (authsigning.Tx, string, uint64, error) {
+	return s.signTx(s.backend.Sign, msgs, opts...)
+}
+
+// signTx is SignTx signing with sign.
+func (s *Signer) signTx(sign SignFunc, msgs []sdktypes.Msg, opts ...TxOption) (authsigning.Tx, string, uint64, error) {
 	txBuilder, err := s.txBuilder(msgs, opts...)
 	if err != nil {
 		return nil, "", 0, err
 	}
 
-	signer, sequence, err := s.signTransaction(txBuilder)
+	signer, sequence, err := s.signTransactionWith(txBuilder, sign)
 	if err != nil {
 		return nil, "", 0, err
 	}
@@ -89,6 +102,11 @@ func (s *Signer) SignTx(msgs []sdktypes.Msg, opts ...TxOption) (authsigning.Tx,
 }
 
 func (s *Signer) CreatePayForBlobs(accountName string, blobs []*share.Blob, opts ...TxOption) ([]byte, uint64, error) {
+	return s.createPayForBlobs(s.backend.Sign, accountName, blobs, opts...)
+}
+
+// createPayForBlobs is CreatePayForBlobs signing with sign.
+func (s *Signer) createPayForBlobs(sign SignFunc, accountName string, blobs []*share.Blob, opts ...TxOption) ([]byte, uint64, error) {
 	acc, exists := s.accounts[accountName]
 	if !exists {
 		return nil, 0, fmt.Errorf("account %s not found", accountName)
@@ -104,7 +122,7 @@ func (s *Signer) CreatePayForBlobs(accountName string, blobs []*share.Blob, opts
 		return nil, 0, err
 	}
 
-	tx, _, sequence, err := s.SignTx([]sdktypes.Msg{msg}, opts...)
+	tx, _, sequence, err := s.signTx(sign, []sdktypes.Msg{msg}, opts...)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -118,6 +136,26 @@ func (s *Signer) CreatePayForBlobs(accountName string, blobs []*share.Blob, opts
 	return blobTx, sequence, err
 }
 
+// SetSignMode sets the sign mode of the transactions signed by the signer. The
+// default is SIGN_MODE_DIRECT. Hardware wallets and custodians may require
+// SIGN_MODE_LEGACY_AMINO_JSON or SIGN_MODE_TEXTUAL. The mode must be supported
+// by the TxConfig of the signer: SIGN_MODE_TEXTUAL is only supported by a
+// TxConfig created with encoding.EnableTextualSignMode.
+func (s *Signer) SetSignMode(mode signing.SignMode) error {
+	for _, supported := range s.enc.SignModeHandler().SupportedModes() {
+		if int32(supported) == int32(mode) {
+			s.signMode = mode
+			return nil
+		}
+	}
+	return fmt.Errorf("sign mode %s is not supported by the tx config", mode)
+}
+
+// SignMode returns the sign mode of the transactions signed by the signer.
+func (s *Signer) SignMode() signing.SignMode {
+	return s.signMode
+}
+
 func (s *Signer) EncodeTx(tx sdktypes.Tx) ([]byte, error) {
 	return s.enc.TxEncoder()(tx)
 }
@@ -214,23 +252,14 @@ func (s *Signer) AddAccount(acc *Account) error {
 		return errors.New("account is nil")
 	}
 
-	record, err := s.keys.Key(acc.name)
+	key, err := s.backend.Key(acc.name)
 	if err != nil {
 		return fmt.Errorf("retrieving key for account %s: %w", acc.name, err)
 	}
 
-	addr, err := record.GetAddress()
-	if err != nil {
-		return fmt.Errorf("getting address for key %s: %w", acc.pubKey, err)
-	}
-
-	pk, err := record.GetPubKey()
-	if err != nil {
-		return fmt.Errorf("getting public key for account %s: %w", acc.name, err)
-	}
-
+	addr := key.Address()
 	acc.address = addr
-	acc.pubKey = pk
+	acc.pubKey = key.PubKey
 	s.accounts[acc.name] = acc
 
 	addrStr, err := s.addressCodec.BytesToString(addr)
@@ -242,51 +271,82 @@ func (s *Signer) AddAccount(acc *Account) error {
 	return nil
 }
 
-// Keyring exposes the signers underlying keyring
+// Keyring exposes the signers underlying keyring. It returns nil if the
+// signer doesn't use a keyring backend.
 func (s *Signer) Keyring() keyring.Keyring {
-	return s.keys
+	if kb, ok := s.backend.(*keyringBackend); ok {
+		return kb.keys
+	}
+	return nil
+}
+
+// Backend exposes the signers underlying backend
+func (s *Signer) Backend() SignerBackend {
+	return s.backend
 }
 
 func (s *Signer) signTransaction(builder client.TxBuilder) (string, uint64, error) {
+	return s.signTransactionWith(builder, s.backend.Sign)
+}
+
+// signTransactionWith signs the transaction with sign. The account fields are
+// read before sign is called so that sign can run without the lock of the
+// caller guarding the signer.
+func (s *Signer) signTransactionWith(builder client.TxBuilder, sign SignFunc) (string, uint64, error) {
 	account, err := s.findAccount(builder)
 	if err != nil {
 		return "", 0, err
 	}
+	name, pubKey, sequence := account.name, account.pubKey, account.sequence
 
 	// a dry run of the signing data
 	err = builder.SetSignatures(signing.SignatureV2{
 		Data: &signing.SingleSignatureData{
-			SignMode:  defaultSignMode,
+			SignMode:  s.signMode,
 			Signature: nil,
 		},
-		PubKey:   account.pubKey,
-		Sequence: account.sequence,
+		PubKey:   pubKey,
+		Sequence: sequence,
 	})
 	if err != nil {
 		return "", 0, fmt.Errorf("error setting draft signatures: %w", err)
 	}
 
-	signature, err := s.createSignature(builder, account, account.sequence)
+	signature, err := s.createSignature(builder, account, sequence, sign)
 	if err != nil {
 		return "", 0, fmt.Errorf("error creating signature: %w", err)
 	}
 
 	err = builder.SetSignatures(signing.SignatureV2{
 		Data: &signing.SingleSignatureData{
-			SignMode:  defaultSignMode,
+			SignMode:  s.signMode,
 			Signature: signature,
 		},
-		PubKey:   account.pubKey,
-		Sequence: account.sequence,
+		PubKey:   pubKey,
+		Sequence: sequence,
 	})
 	if err != nil {
 		return "", 0, fmt.Errorf("error setting signatures: %w", err)
 	}
 
-	return account.name, account.sequence, nil
+	return name, sequence, nil
 }
 
-func (s *Signer) createSignature(builder client.TxBuilder, account *Account, sequence uint64) ([]byte, error) {
+func (s *Signer) createSignature(builder client.TxBuilder, account *Account, sequence uint64, sign SignFunc) ([]byte, error) {
+	bytesToSign, err := s.signBytes(builder.GetTx(), account, sequence, s.signMode)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := sign(account.name, bytesToSign, s.signMode)
+	if err != nil {
+		return nil, fmt.Errorf("error signing bytes: %w", err)
+	}
+
+	return signature, nil
+}
+
+// signBytes returns the bytes the account signs in the provided sign mode.
+func (s *Signer) signBytes(tx authsigning.Tx, account *Account, sequence uint64, signMode signing.SignMode) ([]byte, error) {
 	addrStr, err := s.addressCodec.BytesToString(account.address)
 	if err != nil {
 		return nil, fmt.Errorf("error converting address to string: %w", err)
@@ -300,16 +360,11 @@ func (s *Signer) createSignature(builder client.TxBuilder, account *Account, seq
 		PubKey:        account.pubKey,
 	}
 
-	bytesToSign, err := authsigning.GetSignBytesAdapter(context.Background(), s.enc.SignModeHandler(), defaultSignMode, signerData, builder.GetTx())
+	bytesToSign, err := authsigning.GetSignBytesAdapter(context.Background(), s.enc.SignModeHandler(), signMode, signerData, tx)
 	if err != nil {
 		return nil, fmt.Errorf("error getting sign bytes: %w", err)
 	}
-	signature, _, err := s.keys.Sign(account.name, bytesToSign, defaultSignMode)
-	if err != nil {
-		return nil, fmt.Errorf("error signing bytes: %w", err)
-	}
-
-	return signature, nil
+	return bytesToSign, nil
 }
 
 // txBuilder returns the default sdk Tx builder using the celestia-app encoding config
@@ -0,0 +1,395 @@
+package user
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+)
+
+// KeyInfo describes a key a SignerBackend can sign with.
+type KeyInfo struct {
+	Name   string
+	PubKey cryptotypes.PubKey
+}
+
+// Address returns the account address of the key.
+func (k *KeyInfo) Address() sdktypes.AccAddress {
+	return sdktypes.AccAddress(k.PubKey.Address())
+}
+
+// SignerBackend holds the keys of a Signer and signs with them. It allows the
+// keys to live outside of the process building the transactions.
+type SignerBackend interface {
+	// List returns the keys the backend can sign with.
+	List() ([]*KeyInfo, error)
+	// Key returns the key with the provided name.
+	Key(name string) (*KeyInfo, error)
+	// Sign signs bytesToSign with the key with the provided name.
+	Sign(name string, bytesToSign []byte, signMode signing.SignMode) ([]byte, error)
+}
+
+var (
+	_ SignerBackend = &keyringBackend{}
+	_ SignerBackend = &remoteBackend{}
+	_ SignerBackend = &callbackBackend{}
+)
+
+// keyByAddress returns the key of the backend with the provided address.
+func keyByAddress(backend SignerBackend, address sdktypes.AccAddress) (*KeyInfo, error) {
+	if kb, ok := backend.(*keyringBackend); ok {
+		record, err := kb.keys.KeyByAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		return keyInfoFromRecord(record)
+	}
+	keys, err := backend.List()
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.Address().Equals(address) {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("key with address %s not found", address)
+}
+
+// keyringBackend is the default SignerBackend. It signs with the keys of a
+// keyring.
+type keyringBackend struct {
+	keys keyring.Keyring
+}
+
+// NewKeyringBackend returns a SignerBackend signing with the keys of the
+// provided keyring.
+func NewKeyringBackend(keys keyring.Keyring) SignerBackend {
+	return &keyringBackend{keys: keys}
+}
+
+func (b *keyringBackend) List() ([]*KeyInfo, error) {
+	records, err := b.keys.List()
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*KeyInfo, len(records))
+	for i, record := range records {
+		if keys[i], err = keyInfoFromRecord(record); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+func (b *keyringBackend) Key(name string) (*KeyInfo, error) {
+	record, err := b.keys.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	return keyInfoFromRecord(record)
+}
+
+func (b *keyringBackend) Sign(name string, bytesToSign []byte, signMode signing.SignMode) ([]byte, error) {
+	signature, _, err := b.keys.Sign(name, bytesToSign, signMode)
+	return signature, err
+}
+
+func keyInfoFromRecord(record *keyring.Record) (*KeyInfo, error) {
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return nil, fmt.Errorf("getting public key of %s: %w", record.Name, err)
+	}
+	return &KeyInfo{Name: record.Name, PubKey: pubKey}, nil
+}
+
+// SignFunc signs bytesToSign with the key with the provided name.
+type SignFunc func(name string, bytesToSign []byte, signMode signing.SignMode) ([]byte, error)
+
+// callbackBackend signs by calling a function of the application, for example
+// one delegating to a hardware wallet or a KMS.
+type callbackBackend struct {
+	keys []*KeyInfo
+	sign SignFunc
+}
+
+// NewCallbackBackend returns a SignerBackend that signs with the provided keys
+// by calling sign.
+func NewCallbackBackend(keys []*KeyInfo, sign SignFunc) SignerBackend {
+	return &callbackBackend{keys: keys, sign: sign}
+}
+
+func (b *callbackBackend) List() ([]*KeyInfo, error) {
+	return b.keys, nil
+}
+
+func (b *callbackBackend) Key(name string) (*KeyInfo, error) {
+	for _, key := range b.keys {
+		if key.Name == name {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("key %s not found", name)
+}
+
+func (b *callbackBackend) Sign(name string, bytesToSign []byte, signMode signing.SignMode) ([]byte, error) {
+	if _, err := b.Key(name); err != nil {
+		return nil, err
+	}
+	return b.sign(name, bytesToSign, signMode)
+}
+
+const (
+	// RemoteSignerKeysPath lists the keys of a remote signer.
+	RemoteSignerKeysPath = "/keys"
+	// RemoteSignerSignPath signs with a key of a remote signer.
+	RemoteSignerSignPath = "/sign"
+	// DefaultRemoteSignerTimeout bounds every request to a remote signer.
+	DefaultRemoteSignerTimeout = 30 * time.Second
+	// MaxRemoteSignRequestSize bounds the body of a request to
+	// RemoteSignerSignPath. The sign bytes of a transaction are smaller than
+	// the transaction so it fits the base64 encoded sign bytes of the largest
+	// transaction along with the other fields of the request.
+	MaxRemoteSignRequestSize = appconsts.DefaultMaxTxSize*4/3 + 1024
+)
+
+// RemoteKey is a key of a remote signer. Only secp256k1 keys are supported.
+type RemoteKey struct {
+	Name string `json:"name"`
+	// PubKey is the compressed secp256k1 public key.
+	PubKey []byte `json:"pub_key"`
+}
+
+// RemoteKeysResponse is the response of RemoteSignerKeysPath.
+type RemoteKeysResponse struct {
+	Keys []RemoteKey `json:"keys"`
+}
+
+// RemoteSignRequest is the request of RemoteSignerSignPath.
+type RemoteSignRequest struct {
+	Name      string `json:"name"`
+	SignBytes []byte `json:"sign_bytes"`
+	SignMode  string `json:"sign_mode"`
+}
+
+// RemoteSignResponse is the response of RemoteSignerSignPath.
+type RemoteSignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// remoteBackend signs with the keys of a remote signer. The remote signer
+// serves a JSON over HTTP protocol: a GET request to RemoteSignerKeysPath
+// returns a RemoteKeysResponse and a POST request of a RemoteSignRequest to
+// RemoteSignerSignPath returns a RemoteSignResponse. Every request carries the
+// auth token of the remote signer as a bearer token. NewRemoteSignerHandler
+// serves it.
+type remoteBackend struct {
+	url       string
+	authToken string
+	client    *http.Client
+
+	mtx sync.Mutex
+	// keys caches the keys of the latest List. Key only lists the keys again
+	// if the key isn't cached.
+	keys []*KeyInfo
+}
+
+// NewRemoteBackend returns a SignerBackend signing with the keys of the remote
+// signer at url, authenticating with authToken. The http client can be
+// configured with TLS to protect the token and the signed bytes in transit. A
+// nil client uses a client with DefaultRemoteSignerTimeout as timeout.
+func NewRemoteBackend(url, authToken string, client *http.Client) SignerBackend {
+	if client == nil {
+		client = &http.Client{Timeout: DefaultRemoteSignerTimeout}
+	}
+	return &remoteBackend{url: strings.TrimSuffix(url, "/"), authToken: authToken, client: client}
+}
+
+// do sends a request to the remote signer, bounded by
+// DefaultRemoteSignerTimeout even if the http client has no timeout, and
+// decodes its response into v.
+func (b *remoteBackend) do(method, path string, body io.Reader, v any) error {
+	ctx, cancel := context.WithTimeout(context.Background(), DefaultRemoteSignerTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, method, b.url+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+b.authToken)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := b.client.Do(req)
+	if err != nil {
+		return err
+	}
+	return decodeRemoteResponse(resp, v)
+}
+
+func (b *remoteBackend) List() ([]*KeyInfo, error) {
+	var keysResp RemoteKeysResponse
+	if err := b.do(http.MethodGet, RemoteSignerKeysPath, nil, &keysResp); err != nil {
+		return nil, fmt.Errorf("listing remote keys: %w", err)
+	}
+	keys := make([]*KeyInfo, len(keysResp.Keys))
+	for i, key := range keysResp.Keys {
+		if len(key.PubKey) != secp256k1.PubKeySize {
+			return nil, fmt.Errorf("remote key %s is not a compressed secp256k1 public key", key.Name)
+		}
+		keys[i] = &KeyInfo{Name: key.Name, PubKey: &secp256k1.PubKey{Key: key.PubKey}}
+	}
+	b.mtx.Lock()
+	b.keys = keys
+	b.mtx.Unlock()
+	return keys, nil
+}
+
+func (b *remoteBackend) Key(name string) (*KeyInfo, error) {
+	if key := b.cachedKey(name); key != nil {
+		return key, nil
+	}
+	if _, err := b.List(); err != nil {
+		return nil, err
+	}
+	if key := b.cachedKey(name); key != nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("remote key %s not found", name)
+}
+
+// cachedKey returns the key with the provided name from the latest List, if
+// any.
+func (b *remoteBackend) cachedKey(name string) *KeyInfo {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	for _, key := range b.keys {
+		if key.Name == name {
+			return key
+		}
+	}
+	return nil
+}
+
+// Sign signs with the remote key and verifies the returned signature against
+// the public key of the remote key so that a misbehaving remote signer can't
+// make the Signer broadcast invalid transactions.
+func (b *remoteBackend) Sign(name string, bytesToSign []byte, signMode signing.SignMode) ([]byte, error) {
+	key, err := b.Key(name)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(RemoteSignRequest{Name: name, SignBytes: bytesToSign, SignMode: signMode.String()})
+	if err != nil {
+		return nil, err
+	}
+	var signResp RemoteSignResponse
+	if err := b.do(http.MethodPost, RemoteSignerSignPath, bytes.NewReader(body), &signResp); err != nil {
+		return nil, fmt.Errorf("signing with remote key %s: %w", name, err)
+	}
+	if !key.PubKey.VerifySignature(bytesToSign, signResp.Signature) {
+		return nil, fmt.Errorf("remote signer returned an invalid signature for key %s", name)
+	}
+	return signResp.Signature, nil
+}
+
+func decodeRemoteResponse(resp *http.Response, v any) error {
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("remote signer returned %s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// NewRemoteSignerHandler returns an http.Handler serving the remote signer
+// protocol with the keys of backend. It is meant to run in a separate process
+// holding the keys. Requests that don't carry authToken as bearer token are
+// rejected, as the handler otherwise signs anything for anyone able to reach
+// it; the token must not be empty and should be sent over TLS. Sign requests
+// larger than MaxRemoteSignRequestSize are rejected.
+func NewRemoteSignerHandler(backend SignerBackend, authToken string) (http.Handler, error) {
+	if authToken == "" {
+		return nil, errors.New("remote signer auth token must not be empty")
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(RemoteSignerKeysPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		keys, err := backend.List()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp := RemoteKeysResponse{Keys: make([]RemoteKey, 0, len(keys))}
+		for _, key := range keys {
+			if _, ok := key.PubKey.(*secp256k1.PubKey); !ok {
+				continue
+			}
+			resp.Keys = append(resp.Keys, RemoteKey{Name: key.Name, PubKey: key.PubKey.Bytes()})
+		}
+		writeRemoteResponse(w, resp)
+	})
+	mux.HandleFunc(RemoteSignerSignPath, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		var req RemoteSignRequest
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, int64(MaxRemoteSignRequestSize))).Decode(&req); err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		signMode, ok := signing.SignMode_value[req.SignMode]
+		if !ok {
+			http.Error(w, fmt.Sprintf("unknown sign mode %s", req.SignMode), http.StatusBadRequest)
+			return
+		}
+		signature, err := backend.Sign(req.Name, req.SignBytes, signing.SignMode(signMode))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeRemoteResponse(w, RemoteSignResponse{Signature: signature})
+	})
+	expected := []byte("Bearer " + authToken)
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), expected) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}), nil
+}
+
+func writeRemoteResponse(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// errNoKeyring is returned when an operation requires the signer to use a
+// keyring backend.
+var errNoKeyring = errors.New("signer backend is not a keyring")
@@ -0,0 +1,118 @@
+package user_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v4/app"
+	"github.com/celestiaorg/celestia-app/v4/app/encoding"
+	"github.com/celestiaorg/celestia-app/v4/pkg/user"
+	"github.com/celestiaorg/celestia-app/v4/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testfactory"
+)
+
+func TestSignerBackends(t *testing.T) {
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, testfactory.TestAccName)
+	keyringBackend := user.NewKeyringBackend(kr)
+
+	const authToken = "secret"
+	_, err := user.NewRemoteSignerHandler(keyringBackend, "")
+	require.Error(t, err)
+	handler, err := user.NewRemoteSignerHandler(keyringBackend, authToken)
+	require.NoError(t, err)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	// requests without the auth token are rejected
+	_, err = user.NewRemoteBackend(server.URL, "wrong", nil).List()
+	require.Error(t, err)
+
+	keys, err := keyringBackend.List()
+	require.NoError(t, err)
+	var calls int
+	callbackBackend := user.NewCallbackBackend(keys, func(name string, bytesToSign []byte, signMode signing.SignMode) ([]byte, error) {
+		calls++
+		return keyringBackend.Sign(name, bytesToSign, signMode)
+	})
+
+	newSigner := func(backend user.SignerBackend) *user.Signer {
+		signer, err := user.NewSignerWithBackend(backend, enc.TxConfig, testfactory.ChainID, user.NewAccount(testfactory.TestAccName, 1, 0))
+		require.NoError(t, err)
+		return signer
+	}
+	// secp256k1 signatures are deterministic so every backend produces the
+	// same transaction.
+	expected := blobfactory.GenerateRawSendTx(newSigner(keyringBackend), 100)
+
+	for name, backend := range map[string]user.SignerBackend{
+		"remote":   user.NewRemoteBackend(server.URL, authToken, nil),
+		"callback": callbackBackend,
+	} {
+		t.Run(name, func(t *testing.T) {
+			key, err := backend.Key(testfactory.TestAccName)
+			require.NoError(t, err)
+			assert.Equal(t, testfactory.GetAddress(kr, testfactory.TestAccName), key.Address())
+
+			_, err = backend.Key("unknown")
+			assert.Error(t, err)
+			_, err = backend.Sign("unknown", []byte("data"), signing.SignMode_SIGN_MODE_DIRECT)
+			assert.Error(t, err)
+
+			signer := newSigner(backend)
+			assert.Nil(t, signer.Keyring())
+			assert.Equal(t, expected, blobfactory.GenerateRawSendTx(signer, 100))
+		})
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	enc := encoding.MakeTestConfig(app.ModuleEncodingRegisters...)
+	kr := testfactory.TestKeyring(enc.Codec, testfactory.TestAccName)
+	keyringBackend := user.NewKeyringBackend(kr)
+	keys, err := keyringBackend.List()
+	require.NoError(t, err)
+
+	const authToken = "secret"
+	newServer := func(backend user.SignerBackend) *httptest.Server {
+		handler, err := user.NewRemoteSignerHandler(backend, authToken)
+		require.NoError(t, err)
+		server := httptest.NewServer(handler)
+		t.Cleanup(server.Close)
+		return server
+	}
+
+	t.Run("oversized sign request", func(t *testing.T) {
+		server := newServer(keyringBackend)
+		body, err := json.Marshal(user.RemoteSignRequest{
+			Name:      testfactory.TestAccName,
+			SignBytes: make([]byte, user.MaxRemoteSignRequestSize),
+			SignMode:  signing.SignMode_SIGN_MODE_DIRECT.String(),
+		})
+		require.NoError(t, err)
+		req, err := http.NewRequest(http.MethodPost, server.URL+user.RemoteSignerSignPath, bytes.NewReader(body))
+		require.NoError(t, err)
+		req.Header.Set("Authorization", "Bearer "+authToken)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		// the remote signer signs with the right key but over other bytes
+		server := newServer(user.NewCallbackBackend(keys, func(name string, _ []byte, signMode signing.SignMode) ([]byte, error) {
+			return keyringBackend.Sign(name, []byte("other"), signMode)
+		}))
+		_, err := user.NewRemoteBackend(server.URL, authToken, nil).Sign(testfactory.TestAccName, []byte("data"), signing.SignMode_SIGN_MODE_DIRECT)
+		assert.ErrorContains(t, err, "invalid signature")
+	})
+}
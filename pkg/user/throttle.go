@@ -0,0 +1,385 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cometbft/cometbft/rpc/core"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/gasestimation"
+)
+
+// ThrottleConfig configures how the TxClient slows down submissions when the
+// node is congested.
+type ThrottleConfig struct {
+	// MaxInFlightTxs is the max number of transactions of an account that were
+	// broadcast and not yet confirmed, evicted or rejected. Zero disables the
+	// limit.
+	MaxInFlightTxs int
+	// MaxInFlightBytes is the max size of the blobs of the PayForBlobs
+	// transactions of an account that are in flight. A transaction larger
+	// than the limit is submitted once the account has no transaction in
+	// flight. Zero disables the limit.
+	MaxInFlightBytes int
+	// MempoolFullnessThreshold is the size of the mempool transactions,
+	// relative to the max square size in bytes, from which submissions wait
+	// for the mempool to drain. It is the mempool fullness reported by the gas
+	// price feed of the gas estimation service. Zero disables the check.
+	MempoolFullnessThreshold float64
+	// PollInterval is how often a waiting submission checks the in-flight
+	// transactions and the mempool fullness.
+	PollInterval time.Duration
+	// MinBackoff and MaxBackoff bound the delay applied to submissions after
+	// a broadcast was rejected because the mempool was full. The delay
+	// doubles on every rejection and halves on every accepted broadcast.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// MaxMempoolFullRetries is the number of times a broadcast rejected
+	// because the mempool was full is retried before the rejection is
+	// returned.
+	MaxMempoolFullRetries int
+}
+
+// DefaultThrottleConfig returns a config that limits every account to 10
+// transactions in flight, waits while the mempool holds more than two blocks
+// worth of transactions, and backs off for up to a minute, ten times at most,
+// when the mempool is full.
+func DefaultThrottleConfig() ThrottleConfig {
+	return ThrottleConfig{
+		MaxInFlightTxs:           10,
+		MempoolFullnessThreshold: 2,
+		PollInterval:             time.Second,
+		MinBackoff:               time.Second,
+		MaxBackoff:               time.Minute,
+		MaxMempoolFullRetries:    10,
+	}
+}
+
+// throttleFeedIdleTimeout is how long the gas price feed stream of the
+// throttle stays open after the last submission checked the mempool fullness.
+const throttleFeedIdleTimeout = time.Minute
+
+// WithThrottle enables throttling submissions according to the provided
+// config. Submissions that would exceed the in-flight limits of their account,
+// or that are made while the mempool is congested, wait until the limits are
+// met or the context is cancelled. Broadcasts rejected because the mempool is
+// full are retried after an adaptive backoff. Transactions stay in flight
+// from their broadcast until they are confirmed with ConfirmTx or the node
+// reports that they are no longer pending, so that clients that only
+// broadcast don't need to confirm their transactions.
+func WithThrottle(cfg ThrottleConfig) Option {
+	return func(c *TxClient) {
+		c.throttle = &throttle{
+			cfg:       cfg,
+			reserved:  make(map[string]inFlight),
+			broadcast: make(map[string]throttledTx),
+		}
+	}
+}
+
+// throttle is the state of the throttling of the submissions of a TxClient.
+// It is guarded by the lock of the client.
+type throttle struct {
+	cfg ThrottleConfig
+	// reserved are the in-flight transactions of submissions that passed the
+	// throttle and are being broadcast, indexed by account.
+	reserved map[string]inFlight
+	// broadcast are the in-flight transactions that were broadcast, indexed
+	// by hash.
+	broadcast map[string]throttledTx
+	backoff   time.Duration
+	// fullness is the latest mempool fullness reported by the gas price feed.
+	// A single feed stream is kept open while submissions check the fullness
+	// and closed once it wasn't used for throttleFeedIdleTimeout.
+	fullness    float64
+	feedRunning bool
+	feedStarted time.Time
+	feedUsed    time.Time
+	// feedReady is closed once the stream received its first update or
+	// failed.
+	feedReady chan struct{}
+}
+
+// throttledTx is a broadcast transaction counted by the throttle.
+type throttledTx struct {
+	account   string
+	size      int
+	timestamp time.Time
+}
+
+// inFlight counts the transactions of an account that are in flight.
+type inFlight struct {
+	txs   int
+	bytes int
+}
+
+// throttled calls broadcast once the submission of size blob bytes by account
+// is allowed by the throttle, retrying it while the mempool is full.
+func (client *TxClient) throttled(ctx context.Context, account string, size int, broadcast func() (*sdktypes.TxResponse, error)) (*sdktypes.TxResponse, error) {
+	if client.throttle == nil {
+		return broadcast()
+	}
+	for retries := 0; ; retries++ {
+		if err := client.waitForThrottle(ctx, account, size); err != nil {
+			return nil, err
+		}
+		resp, err := broadcast()
+
+		client.mtx.Lock()
+		t := client.throttle
+		reserved := t.reserved[account]
+		reserved.txs--
+		reserved.bytes -= size
+		t.reserved[account] = reserved
+		if resp != nil {
+			t.broadcast[resp.TxHash] = throttledTx{account: account, size: size, timestamp: time.Now()}
+		}
+		if !isMempoolFull(err) || retries >= t.cfg.MaxMempoolFullRetries {
+			t.backoff /= 2
+			if t.backoff < t.cfg.MinBackoff {
+				t.backoff = 0
+			}
+			client.mtx.Unlock()
+			return resp, err
+		}
+		t.backoff = min(max(2*t.backoff, t.cfg.MinBackoff), t.cfg.MaxBackoff)
+		client.mtx.Unlock()
+	}
+}
+
+// waitForThrottle waits for the backoff, for the mempool to drain below the
+// fullness threshold and for the account to have room for the submission, at
+// which point the submission is reserved.
+func (client *TxClient) waitForThrottle(ctx context.Context, account string, size int) error {
+	client.mtx.Lock()
+	backoff := client.throttle.backoff
+	client.mtx.Unlock()
+	if err := sleep(ctx, backoff); err != nil {
+		return err
+	}
+
+	for {
+		fullness := client.mempoolFullness(ctx)
+
+		client.mtx.Lock()
+		t := client.throttle
+		limited := false
+		if t.cfg.MempoolFullnessThreshold == 0 || fullness < t.cfg.MempoolFullnessThreshold {
+			current := client.inFlight(account)
+			if t.allows(current, size) {
+				reserved := t.reserved[account]
+				reserved.txs++
+				reserved.bytes += size
+				t.reserved[account] = reserved
+				client.mtx.Unlock()
+				return nil
+			}
+			limited = true
+		}
+		pollInterval := t.cfg.PollInterval
+		client.mtx.Unlock()
+
+		// the transactions that left the mempool since the last check no
+		// longer count even if they were not confirmed with ConfirmTx.
+		if limited && client.releaseFinishedTxs(ctx, account) {
+			continue
+		}
+		if err := sleep(ctx, pollInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// releaseFinishedTxs queries the status of the broadcast transactions of
+// account that are in flight and stops counting the ones that are no longer
+// pending. It returns true if any transaction was released. A failed query
+// releases nothing and is retried at the next poll.
+func (client *TxClient) releaseFinishedTxs(ctx context.Context, account string) bool {
+	client.mtx.Lock()
+	txHashes := make([]string, 0)
+	for txHash, tx := range client.throttle.broadcast {
+		if tx.account == account {
+			txHashes = append(txHashes, txHash)
+		}
+	}
+	client.mtx.Unlock()
+	if len(txHashes) == 0 {
+		return false
+	}
+
+	statuses, err := client.queryTxStatuses(ctx, txHashes)
+	if err != nil {
+		return false
+	}
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	released := false
+	for _, txHash := range txHashes {
+		if status, ok := statuses[txHash]; ok && status.Status != core.TxStatusPending {
+			delete(client.throttle.broadcast, txHash)
+			released = true
+		}
+	}
+	return released
+}
+
+// pruneThrottledTxs stops counting the transactions broadcast more than
+// txTrackerPruningInterval ago, like the tx tracker stops tracking them. The
+// caller must hold the lock of the client.
+func (client *TxClient) pruneThrottledTxs() {
+	if client.throttle == nil {
+		return
+	}
+	for txHash, tx := range client.throttle.broadcast {
+		if time.Since(tx.timestamp) >= txTrackerPruningInterval {
+			delete(client.throttle.broadcast, txHash)
+		}
+	}
+}
+
+// releaseTx stops counting the transaction as in flight. The caller must hold
+// the lock of the client.
+func (client *TxClient) releaseTx(txHash string) {
+	if client.throttle != nil {
+		delete(client.throttle.broadcast, txHash)
+	}
+}
+
+// replaceThrottledTx counts the replacement of a transaction in its place.
+// The caller must hold the lock of the client.
+func (client *TxClient) replaceThrottledTx(txHash, replacementHash string) {
+	if client.throttle == nil {
+		return
+	}
+	if tx, ok := client.throttle.broadcast[txHash]; ok {
+		delete(client.throttle.broadcast, txHash)
+		client.throttle.broadcast[replacementHash] = tx
+	}
+}
+
+// allows returns true if a submission of size blob bytes can be added to the
+// in-flight transactions of an account.
+func (t *throttle) allows(current inFlight, size int) bool {
+	if t.cfg.MaxInFlightTxs > 0 && current.txs >= t.cfg.MaxInFlightTxs {
+		return false
+	}
+	if t.cfg.MaxInFlightBytes > 0 && current.txs > 0 && current.bytes+size > t.cfg.MaxInFlightBytes {
+		return false
+	}
+	return true
+}
+
+// inFlight returns the transactions of account that are in flight, including
+// the ones being broadcast. The caller must hold the lock of the client.
+func (client *TxClient) inFlight(account string) inFlight {
+	current := client.throttle.reserved[account]
+	for _, tx := range client.throttle.broadcast {
+		if tx.account == account {
+			current.txs++
+			current.bytes += tx.size
+		}
+	}
+	return current
+}
+
+// mempoolFullness returns the latest mempool fullness reported by the gas
+// price feed, opening the feed stream if it isn't open. A stream that failed
+// is opened again at most once per poll interval. It returns zero if the node
+// doesn't serve the feed so that submissions are not blocked.
+func (client *TxClient) mempoolFullness(ctx context.Context) float64 {
+	client.mtx.Lock()
+	t := client.throttle
+	if t.cfg.MempoolFullnessThreshold == 0 {
+		client.mtx.Unlock()
+		return 0
+	}
+	t.feedUsed = time.Now()
+	if !t.feedRunning && time.Since(t.feedStarted) >= t.cfg.PollInterval {
+		t.feedRunning = true
+		t.feedStarted = time.Now()
+		t.feedReady = make(chan struct{})
+		go client.runFullnessFeed(t.feedReady)
+	}
+	ready, pollInterval := t.feedReady, t.cfg.PollInterval
+	client.mtx.Unlock()
+
+	// the first update of a new stream is sent right away
+	timer := time.NewTimer(pollInterval)
+	defer timer.Stop()
+	select {
+	case <-ready:
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	return t.fullness
+}
+
+// runFullnessFeed records the mempool fullness of every update of a gas price
+// feed stream until the stream fails or the throttle stops using it. ready is
+// closed once the first update is received or the stream failed.
+func (client *TxClient) runFullnessFeed(ready chan struct{}) {
+	var readyOnce sync.Once
+	markReady := func() { readyOnce.Do(func() { close(ready) }) }
+	defer markReady()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.gasEstimationClient.GasPriceFeed(ctx, &gasestimation.GasPriceFeedRequest{})
+	for err == nil {
+		var update *gasestimation.GasPriceFeedResponse
+		update, err = stream.Recv()
+
+		client.mtx.Lock()
+		t := client.throttle
+		if err == nil {
+			t.fullness = update.MempoolFullness
+		}
+		idle := time.Since(t.feedUsed) >= throttleFeedIdleTimeout
+		client.mtx.Unlock()
+		markReady()
+		if idle {
+			break
+		}
+	}
+
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	client.throttle.feedRunning = false
+	if err != nil {
+		client.throttle.fullness = 0
+	}
+}
+
+// isMempoolFull returns true if the broadcast was rejected because the
+// mempool of the node is full.
+func isMempoolFull(err error) bool {
+	var broadcastErr *BroadcastTxError
+	if !errors.As(err, &broadcastErr) {
+		return false
+	}
+	return broadcastErr.Code == sdkerrors.ErrMempoolIsFull.ABCICode() ||
+		strings.Contains(broadcastErr.ErrorLog, "mempool is full")
+}
+
+// sleep waits for d or until the context is cancelled.
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
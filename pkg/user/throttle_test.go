@@ -0,0 +1,50 @@
+package user_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/user"
+	"github.com/celestiaorg/celestia-app/v4/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v4/test/util/random"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testnode"
+)
+
+func TestThrottle(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode.")
+	}
+	cfg := user.DefaultThrottleConfig()
+	cfg.MaxInFlightTxs = 1
+	cfg.PollInterval = 50 * time.Millisecond
+	_, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration, user.WithThrottle(cfg))
+	subCtx, cancel := context.WithTimeout(ctx.GoContext(), time.Minute)
+	defer cancel()
+
+	first, err := txClient.BroadcastPayForBlob(subCtx, blobfactory.ManyRandBlobs(random.New(), 1e3))
+	require.NoError(t, err)
+
+	// the account already has a transaction in flight
+	waitCtx, cancelWait := context.WithTimeout(subCtx, time.Second)
+	defer cancelWait()
+	_, err = txClient.BroadcastPayForBlob(waitCtx, blobfactory.ManyRandBlobs(random.New(), 1e3))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	// other accounts are not throttled
+	other := "b"
+	if txClient.DefaultAccountName() == other {
+		other = "c"
+	}
+	_, err = txClient.SubmitPayForBlobWithAccount(subCtx, other, blobfactory.ManyRandBlobs(random.New(), 1e3))
+	require.NoError(t, err)
+
+	// the first transaction stops counting once it is committed even though
+	// it wasn't confirmed with ConfirmTx
+	_, err = txClient.SubmitPayForBlob(subCtx, blobfactory.ManyRandBlobs(random.New(), 1e3))
+	require.NoError(t, err)
+	_, err = txClient.ConfirmTx(subCtx, first.TxHash)
+	require.NoError(t, err)
+}
@@ -22,8 +22,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/celestiaorg/go-square/v2/share"
 
@@ -39,18 +42,13 @@ import (
 const (
 	DefaultPollTime          = 3 * time.Second
 	txTrackerPruningInterval = 10 * time.Minute
+	// txTrackerPruningFrequency is the minimum time between two prunings of
+	// the tx tracker.
+	txTrackerPruningFrequency = time.Minute
 )
 
 type Option func(client *TxClient)
 
-// txInfo is a struct that holds the sequence and the signer of a transaction
-// in the local tx pool.
-type txInfo struct {
-	sequence  uint64
-	signer    string
-	timestamp time.Time
-}
-
 // TxResponse is a response from the chain after
 // a transaction has been submitted.
 type TxResponse struct {
@@ -84,6 +82,30 @@ func (e *ExecutionError) Error() string {
 	return fmt.Sprintf("tx execution failed with code %d: %s", e.Code, e.ErrorLog)
 }
 
+// TxTrackingError is returned along with the response of a transaction that
+// was broadcast but couldn't be added to the tx tracker. The transaction can
+// still be confirmed but its sequence can't be recovered if it is evicted or
+// after a restart.
+type TxTrackingError struct {
+	TxHash string
+	Err    error
+}
+
+func (e *TxTrackingError) Error() string {
+	return fmt.Sprintf("tracking tx %s: %v", e.TxHash, e.Err)
+}
+
+func (e *TxTrackingError) Unwrap() error {
+	return e.Err
+}
+
+// isTxTrackingError returns true if err means that the transaction was
+// broadcast but not tracked.
+func isTxTrackingError(err error) bool {
+	var trackingErr *TxTrackingError
+	return errors.As(err, &trackingErr)
+}
+
 // WithDefaultGasPrice sets the gas price.
 func WithDefaultGasPrice(price float64) Option {
 	return func(c *TxClient) {
@@ -99,27 +121,23 @@ func WithPollTime(time time.Duration) Option {
 
 func WithDefaultAddress(address sdktypes.AccAddress) Option {
 	return func(c *TxClient) {
-		record, err := c.signer.keys.KeyByAddress(address)
+		key, err := keyByAddress(c.signer.backend, address)
 		if err != nil {
 			panic(err)
 		}
-		c.defaultAccount = record.Name
+		c.defaultAccount = key.Name
 		c.defaultAddress = address
 	}
 }
 
 func WithDefaultAccount(name string) Option {
 	return func(c *TxClient) {
-		rec, err := c.signer.keys.Key(name)
-		if err != nil {
-			panic(err)
-		}
-		addr, err := rec.GetAddress()
+		key, err := c.signer.backend.Key(name)
 		if err != nil {
 			panic(err)
 		}
 		c.defaultAccount = name
-		c.defaultAddress = addr
+		c.defaultAddress = key.Address()
 	}
 }
 
@@ -132,26 +150,75 @@ func WithEstimatorService(conn *grpc.ClientConn) Option {
 	}
 }
 
+// WithTxTracker sets the backend used to track the submitted transactions
+// until they are confirmed. The default tracker keeps them in memory. A
+// persistent tracker allows the client to recover the sequences of its signers
+// after a restart, see ReconcileTxTracker.
+func WithTxTracker(tracker TxTracker) Option {
+	return func(c *TxClient) {
+		c.txTracker = tracker
+	}
+}
+
+// WithSignMode sets the sign mode of the transactions signed by the client,
+// see Signer.SetSignMode. Signing in SIGN_MODE_TEXTUAL requires a TxConfig
+// created with encoding.EnableTextualSignMode, for example with the coin
+// metadata query function txconfig.NewGRPCCoinMetadataQueryFn(conn).
+func WithSignMode(mode signing.SignMode) Option {
+	return func(c *TxClient) {
+		if err := c.signer.SetSignMode(mode); err != nil {
+			panic(err)
+		}
+	}
+}
+
 // TxClient is an abstraction for building, signing, and broadcasting Celestia transactions
 // It supports multiple accounts. If none is specified, it will
 // try to use the default account.
 // TxClient is thread-safe.
 type TxClient struct {
-	mtx      sync.Mutex
-	cdc      codec.Codec
-	signer   *Signer
-	registry codectypes.InterfaceRegistry
-	grpc     *grpc.ClientConn
+	// submitMtx serializes the operations signing and broadcasting
+	// transactions or changing the sequence of the accounts, so that the
+	// transactions are broadcast in sequence order. mtx guards the state of
+	// the client and is released while the backend signs so that a slow
+	// backend, such as a remote signer, doesn't block the other operations.
+	// submitMtx is always acquired before mtx.
+	submitMtx sync.Mutex
+	mtx       sync.Mutex
+	cdc       codec.Codec
+	signer    *Signer
+	registry  codectypes.InterfaceRegistry
+	grpc      *grpc.ClientConn
 	// how often to poll the network for confirmation of a transaction
 	pollTime time.Duration
 	// defaultGasPrice is the price used if no price is provided
 	defaultGasPrice float64
 	defaultAccount  string
 	defaultAddress  sdktypes.AccAddress
-	// txTracker maps the tx hash to the Sequence and signer of the transaction
-	// that was submitted to the chain
-	txTracker           map[string]txInfo
+	// txTracker keeps the Sequence and signer of the transactions that were
+	// submitted to the chain until they are confirmed
+	txTracker TxTracker
+	// lastPruned is the last time the tx tracker was pruned.
+	lastPruned          time.Time
 	gasEstimationClient gasestimation.GasEstimatorClient
+	// retryPolicy is nil unless transactions should be resubmitted.
+	retryPolicy *RetryPolicy
+	// feeBumping is true if the fee of pending transactions can be bumped.
+	feeBumping bool
+	// submissions maps the tx hash to what is needed to resubmit the
+	// transaction and replacements the hash of a resubmitted or fee bumped
+	// transaction to the hash of the transaction that replaced it.
+	submissions  map[string]*submission
+	replacements map[string]replacement
+	// accountCallbacks are called when an account is loaded after the
+	// client is created.
+	accountCallbacks []AccountCallback
+	// throttle is nil unless submissions should be throttled.
+	throttle *throttle
+	// blobParams caches the params of the blob module and blobBaseFee the
+	// blob base fee.
+	blobParams  queryCache[types.Params]
+	blobBaseFee queryCache[float64]
 }
 
 // NewTxClient returns a new signer using the provided keyring
@@ -162,31 +229,30 @@ func NewTxClient(
 	registry codectypes.InterfaceRegistry,
 	options ...Option,
 ) (*TxClient, error) {
-	records, err := signer.keys.List()
+	keys, err := signer.backend.List()
 	if err != nil {
 		return nil, fmt.Errorf("retrieving keys: %w", err)
 	}
 
-	if len(records) == 0 {
+	if len(keys) == 0 {
 		return nil, errors.New("signer must have at least one key")
 	}
 
-	addr, err := records[0].GetAddress()
-	if err != nil {
-		return nil, err
-	}
-
 	txClient := &TxClient{
 		signer:              signer,
 		registry:            registry,
 		grpc:                conn,
 		pollTime:            DefaultPollTime,
 		defaultGasPrice:     appconsts.DefaultMinGasPrice,
-		defaultAccount:      records[0].Name,
-		defaultAddress:      addr,
-		txTracker:           make(map[string]txInfo),
+		defaultAccount:      keys[0].Name,
+		defaultAddress:      keys[0].Address(),
+		txTracker:           NewMemTxTracker(),
 		cdc:                 cdc,
 		gasEstimationClient: gasestimation.NewGasEstimatorClient(conn),
+		submissions:         make(map[string]*submission),
+		replacements:        make(map[string]replacement),
+		blobParams:          queryCache[types.Params]{interval: DefaultBlobParamsRefreshInterval, query: QueryBlobParams},
+		blobBaseFee:         queryCache[float64]{interval: appconsts.TimeoutCommit, query: QueryBlobBaseFee},
 	}
 
 	for _, opt := range options {
@@ -204,6 +270,19 @@ func SetupTxClient(
 	conn *grpc.ClientConn,
 	encCfg encoding.Config,
 	options ...Option,
+) (*TxClient, error) {
+	return SetupTxClientWithBackend(ctx, NewKeyringBackend(keys), conn, encCfg, options...)
+}
+
+// SetupTxClientWithBackend is SetupTxClient for the accounts of any
+// SignerBackend, for example a remote signer holding the keys in a separate
+// process.
+func SetupTxClientWithBackend(
+	ctx context.Context,
+	backend SignerBackend,
+	conn *grpc.ClientConn,
+	encCfg encoding.Config,
+	options ...Option,
 ) (*TxClient, error) {
 	resp, err := tmservice.NewServiceClient(conn).GetLatestBlock(
 		ctx,
@@ -215,24 +294,21 @@ func SetupTxClient(
 
 	chainID := resp.SdkBlock.Header.ChainID
 
-	records, err := keys.List()
+	keys, err := backend.List()
 	if err != nil {
 		return nil, err
 	}
 
-	accounts := make([]*Account, 0, len(records))
-	for _, record := range records {
-		addr, err := record.GetAddress()
+	accounts := make([]*Account, 0, len(keys))
+	for _, key := range keys {
+		accNum, seqNum, err := QueryAccount(ctx, conn, encCfg.InterfaceRegistry, key.Address())
 		if err != nil {
-			return nil, err
-		}
-		accNum, seqNum, err := QueryAccount(ctx, conn, encCfg.InterfaceRegistry, addr)
-		if err != nil {
-			// skip over the accounts that don't exist in state
+			// skip over the accounts that don't exist in state, they are loaded
+			// once funded, see LoadNewAccounts
 			continue
 		}
 
-		accounts = append(accounts, NewAccount(record.Name, accNum, seqNum))
+		accounts = append(accounts, NewAccount(key.Name, accNum, seqNum))
 	}
 
 	// query the min gas price from the chain
@@ -242,19 +318,26 @@ func SetupTxClient(
 	}
 	options = append([]Option{WithDefaultGasPrice(minPrice)}, options...)
 
-	signer, err := NewSigner(keys, encCfg.TxConfig, chainID, accounts...)
+	signer, err := NewSignerWithBackend(backend, encCfg.TxConfig, chainID, accounts...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create signer: %w", err)
 	}
 
-	return NewTxClient(encCfg.Codec, signer, conn, encCfg.InterfaceRegistry, options...)
+	txClient, err := NewTxClient(encCfg.Codec, signer, conn, encCfg.InterfaceRegistry, options...)
+	if err != nil {
+		return nil, err
+	}
+	if err := txClient.ReconcileTxTracker(ctx); err != nil {
+		return nil, fmt.Errorf("reconciling tx tracker: %w", err)
+	}
+	return txClient, nil
 }
 
 // SubmitPayForBlob forms a transaction from the provided blobs, signs it, and submits it to the chain.
 // TxOptions may be provided to set the fee and gas limit.
 func (client *TxClient) SubmitPayForBlob(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
 	resp, err := client.BroadcastPayForBlob(ctx, blobs, opts...)
-	if err != nil {
+	if err != nil && !isTxTrackingError(err) {
 		return nil, err
 	}
 
@@ -265,7 +348,7 @@ func (client *TxClient) SubmitPayForBlob(ctx context.Context, blobs []*share.Blo
 // TxOptions may be provided to set the fee and gas limit.
 func (client *TxClient) SubmitPayForBlobWithAccount(ctx context.Context, account string, blobs []*share.Blob, opts ...TxOption) (*TxResponse, error) {
 	resp, err := client.BroadcastPayForBlobWithAccount(ctx, account, blobs, opts...)
-	if err != nil {
+	if err != nil && !isTxTrackingError(err) {
 		return nil, err
 	}
 
@@ -274,42 +357,74 @@ func (client *TxClient) SubmitPayForBlobWithAccount(ctx context.Context, account
 
 // BroadcastPayForBlob signs and broadcasts a transaction to pay for blobs.
 // It does not confirm that the transaction has been committed on chain.
-// If no gas or gas price is set, it will estimate the gas and use
-// the max effective gas price: max(localMinGasPrice, networkMinGasPrice).
+// If no gas or gas price is set, it will estimate the gas from the blob params
+// and use the default min gas price, raised to the blob base fee if the
+// dynamic blob base fee is enabled.
 func (client *TxClient) BroadcastPayForBlob(ctx context.Context, blobs []*share.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
 	return client.BroadcastPayForBlobWithAccount(ctx, client.defaultAccount, blobs, opts...)
 }
 
 func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, account string, blobs []*share.Blob, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	size := 0
+	for _, blob := range blobs {
+		size += len(blob.Data())
+	}
+	return client.throttled(ctx, account, size, func() (*sdktypes.TxResponse, error) {
+		return client.broadcastPayForBlob(ctx, account, blobs, opts)
+	})
+}
+
+func (client *TxClient) broadcastPayForBlob(ctx context.Context, account string, blobs []*share.Blob, opts []TxOption) (*sdktypes.TxResponse, error) {
+	// the gas charged for the blobs depends on the blob params
+	blobParams, err := client.blobParams.get(ctx, client.grpc)
+	if err != nil {
+		return nil, err
+	}
+	blobBaseFee, err := client.blobBaseFee.get(ctx, client.grpc)
+	if err != nil {
+		return nil, err
+	}
+
+	client.submitMtx.Lock()
+	defer client.submitMtx.Unlock()
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
 	if err := client.checkAccountLoaded(ctx, account); err != nil {
 		return nil, err
 	}
 
-	blobSizes := make([]uint32, len(blobs))
-	for i, blob := range blobs {
-		blobSizes[i] = uint32(len(blob.Data()))
-	}
-
-	gasLimit := uint64(float64(types.DefaultEstimateGas(blobSizes)))
-	fee := uint64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
+	gasLimit := types.EstimateGasWithParams(blobParams, blobs, appconsts.DefaultTxSizeCostPerByte)
+	// the PFB must pay at least the blob base fee
+	fee := uint64(math.Ceil(max(appconsts.DefaultMinGasPrice, blobBaseFee) * float64(gasLimit)))
 	// prepend calculated params, so it can be overwritten in case the user has specified it.
 	opts = append([]TxOption{SetGasLimit(gasLimit), SetFee(fee)}, opts...)
 
-	txBytes, _, err := client.signer.CreatePayForBlobs(account, blobs, opts...)
+	gasLimit, fee, err = client.gasLimitAndFee(opts)
 	if err != nil {
 		return nil, err
 	}
 
-	return client.broadcastTx(ctx, txBytes, account)
+	resp, err := client.submit(ctx, &submission{
+		account:  account,
+		blobs:    blobs,
+		opts:     opts,
+		gasLimit: gasLimit,
+		fee:      fee,
+	})
+	if isGasRejection(err) {
+		// the blob params or the blob base fee may have changed since they
+		// were cached
+		client.blobParams.invalidate()
+		client.blobBaseFee.invalidate()
+	}
+	return resp, err
 }
 
 // SubmitTx forms a transaction from the provided messages, signs it, and submits it to the chain. TxOptions
 // may be provided to set the fee and gas limit.
 func (client *TxClient) SubmitTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*TxResponse, error) {
 	resp, err := client.BroadcastTx(ctx, msgs, opts...)
-	if err != nil {
+	if err != nil && !isTxTrackingError(err) {
 		return nil, err
 	}
 
@@ -317,17 +432,26 @@ func (client *TxClient) SubmitTx(ctx context.Context, msgs []sdktypes.Msg, opts
 }
 
 func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, opts ...TxOption) (*sdktypes.TxResponse, error) {
+	account, err := client.getAccountNameFromMsgs(msgs)
+	if err != nil {
+		return nil, err
+	}
+	return client.throttled(ctx, account, 0, func() (*sdktypes.TxResponse, error) {
+		return client.broadcastMsgs(ctx, account, msgs, opts)
+	})
+}
+
+func (client *TxClient) broadcastMsgs(ctx context.Context, account string, msgs []sdktypes.Msg, opts []TxOption) (*sdktypes.TxResponse, error) {
+	client.submitMtx.Lock()
+	defer client.submitMtx.Unlock()
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
 
 	// prune transactions that are older than 10 minutes
 	// pruning has to be done in broadcast, since users
 	// might not always call ConfirmTx().
-	client.pruneTxTracker()
-
-	account, err := client.getAccountNameFromMsgs(msgs)
-	if err != nil {
-		return nil, err
+	if err := client.pruneTxTracker(); err != nil {
+		return nil, fmt.Errorf("pruning tx tracker: %w", err)
 	}
 
 	if err := client.checkAccountLoaded(ctx, account); err != nil {
@@ -365,21 +489,93 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 		txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewInt(fee))))
 	}
 
-	account, _, err = client.signer.signTransaction(txBuilder)
+	fee := txBuilder.GetTx().GetFee().AmountOf(appconsts.BondDenom)
+	if !fee.IsUint64() {
+		return nil, fmt.Errorf("fee %s overflows uint64", fee)
+	}
+
+	return client.submit(ctx, &submission{
+		account:  account,
+		msgs:     msgs,
+		opts:     opts,
+		gasLimit: gasLimit,
+		fee:      fee.Uint64(),
+	})
+}
+
+// BumpFee replaces a pending transaction with the same transaction signed
+// with the same sequence and a fee paying newGasPrice for its gas limit. The
+// new fee must be higher than the fee of the pending transaction. Nodes accept
+// the replacement if its priority is strictly higher than the priority of the
+// pending transaction. ConfirmTx follows the replacement when asked to confirm
+// the pending transaction and reports whichever of the two is committed. It
+// requires the client to be created with WithFeeBumping or WithRetryPolicy.
+func (client *TxClient) BumpFee(ctx context.Context, txHash string, newGasPrice float64) (*sdktypes.TxResponse, error) {
+	client.submitMtx.Lock()
+	defer client.submitMtx.Unlock()
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	if !client.keepsSubmissions() {
+		return nil, errors.New("bumping fees requires the client to be created with WithFeeBumping or WithRetryPolicy")
+	}
+
+	txHash = client.latestReplacement(txHash)
+	trackedTx, exists, err := client.txTracker.Get(txHash)
 	if err != nil {
 		return nil, err
 	}
+	sub, ok := client.submissions[txHash]
+	if !exists || !ok {
+		return nil, fmt.Errorf("tx %s is not pending", txHash)
+	}
+	fee := uint64(math.Ceil(newGasPrice * float64(sub.gasLimit)))
+	if fee <= sub.fee {
+		return nil, fmt.Errorf("fee %d of gas price %f must be higher than the fee %d of tx %s", fee, newGasPrice, sub.fee, txHash)
+	}
 
-	txBytes, err := client.signer.EncodeTx(txBuilder.GetTx())
+	previousFee := sub.fee
+	sub.fee = fee
+	next := client.signer.accounts[trackedTx.Signer].Sequence()
+	resp, err := client.replaceTx(ctx, trackedTx, sub)
+	if err := client.signer.SetSequence(trackedTx.Signer, next); err != nil {
+		return nil, fmt.Errorf("setting sequence: %w", err)
+	}
+	if resp == nil {
+		sub.fee = previousFee
+		return nil, err
+	}
+	return resp, err
+}
+
+func (client *TxClient) broadcastTx(ctx context.Context, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
+	resp, err := client.broadcast(ctx, txBytes)
 	if err != nil {
 		return nil, err
 	}
 
-	return client.broadcastTx(ctx, txBytes, account)
+	// save the sequence and signer of the transaction in the local txTracker
+	// before the sequence is incremented
+	trackErr := client.trackTx(resp.TxHash, signer, client.signer.accounts[signer].Sequence())
+
+	// after the transaction has been submitted, we can increment the
+	// sequence of the signer
+	if err := client.signer.IncrementSequence(signer); err != nil {
+		return nil, fmt.Errorf("increment sequencing: %w", err)
+	}
+	// the transaction was broadcast so the response is returned even if it
+	// couldn't be tracked.
+	return resp, trackErr
 }
 
-func (client *TxClient) broadcastTx(ctx context.Context, txBytes []byte, signer string) (*sdktypes.TxResponse, error) {
-	txClient := sdktx.NewServiceClient(client.grpc)
+// broadcast broadcasts the transaction and returns an error if it is rejected.
+func (client *TxClient) broadcast(ctx context.Context, txBytes []byte) (*sdktypes.TxResponse, error) {
+	return broadcastRawTx(ctx, client.grpc, txBytes)
+}
+
+// broadcastRawTx broadcasts the encoded transaction to the node at conn and
+// returns an error if it is rejected.
+func broadcastRawTx(ctx context.Context, conn *grpc.ClientConn, txBytes []byte) (*sdktypes.TxResponse, error) {
+	txClient := sdktx.NewServiceClient(conn)
 	resp, err := txClient.BroadcastTx(
 		ctx,
 		&sdktx.BroadcastTxRequest{
@@ -398,30 +594,117 @@ func (client *TxClient) broadcastTx(ctx context.Context, txBytes []byte, signer
 		}
 		return nil, broadcastTxErr
 	}
+	return resp.TxResponse, nil
+}
 
-	// save the sequence and signer of the transaction in the local txTracker
-	// before the sequence is incremented
-	client.txTracker[resp.TxResponse.TxHash] = txInfo{
-		sequence:  client.signer.accounts[signer].Sequence(),
-		signer:    signer,
-		timestamp: time.Now(),
+// trackTx saves the sequence and signer of a broadcast transaction in the
+// local txTracker.
+func (client *TxClient) trackTx(txHash, signer string, sequence uint64) error {
+	err := client.txTracker.Add(TrackedTx{
+		TxHash:    txHash,
+		Sequence:  sequence,
+		Signer:    signer,
+		Timestamp: time.Now(),
+	})
+	if err != nil {
+		return &TxTrackingError{TxHash: txHash, Err: err}
 	}
+	return nil
+}
 
-	// after the transaction has been submitted, we can increment the
-	// sequence of the signer
-	if err := client.signer.IncrementSequence(signer); err != nil {
-		return nil, fmt.Errorf("increment sequencing: %w", err)
+// pruneTxTracker removes transactions from the local tx tracker that are older
+// than 10 minutes. It does nothing if the tracker was pruned less than
+// txTrackerPruningFrequency ago.
+func (client *TxClient) pruneTxTracker() error {
+	now := time.Now()
+	if now.Sub(client.lastPruned) < txTrackerPruningFrequency {
+		return nil
 	}
-	return resp.TxResponse, nil
+	if err := client.txTracker.Prune(now.Add(-txTrackerPruningInterval)); err != nil {
+		return err
+	}
+	client.lastPruned = now
+	client.pruneRetries()
+	client.pruneThrottledTxs()
+	return nil
 }
 
-// pruneTxTracker removes transactions from the local tx tracker that are older than 10 minutes
-func (client *TxClient) pruneTxTracker() {
-	for hash, txInfo := range client.txTracker {
-		if time.Since(txInfo.timestamp) >= txTrackerPruningInterval {
-			delete(client.txTracker, hash)
+// ReconcileTxTracker resolves the transactions left in the tx tracker, for
+// example by a previous run of the client using a persistent tracker. It
+// queries their status and stops tracking the ones that are no longer pending.
+// The sequence of every signer with pending transactions is set after its last
+// pending transaction so that new transactions don't reuse it, unless one of
+// its transactions was evicted, in which case the sequence is rolled back to
+// the evicted transaction so that it can be resubmitted. SetupTxClient calls
+// it before returning the client; clients created with NewTxClient should call
+// it before submitting transactions.
+func (client *TxClient) ReconcileTxTracker(ctx context.Context) error {
+	client.submitMtx.Lock()
+	defer client.submitMtx.Unlock()
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+
+	trackedTxs, err := client.txTracker.List()
+	if err != nil {
+		return err
+	}
+
+	txHashes := make([]string, len(trackedTxs))
+	for i, trackedTx := range trackedTxs {
+		txHashes[i] = trackedTx.TxHash
+	}
+	statuses, err := client.queryTxStatuses(ctx, txHashes)
+	if err != nil {
+		return err
+	}
+
+	// nextSequences is the sequence following the last pending transaction of
+	// every signer and evictedSequences the lowest sequence of the evicted
+	// transactions of every signer.
+	nextSequences := make(map[string]uint64)
+	evictedSequences := make(map[string]uint64)
+	for _, trackedTx := range trackedTxs {
+		switch statuses[trackedTx.TxHash].GetStatus() {
+		case core.TxStatusPending:
+			nextSequences[trackedTx.Signer] = max(nextSequences[trackedTx.Signer], trackedTx.Sequence+1)
+			continue
+		case core.TxStatusEvicted:
+			if evicted, ok := evictedSequences[trackedTx.Signer]; !ok || trackedTx.Sequence < evicted {
+				evictedSequences[trackedTx.Signer] = trackedTx.Sequence
+			}
+		}
+		if err := client.txTracker.Delete(trackedTx.TxHash); err != nil {
+			return err
+		}
+	}
+
+	for signer, next := range nextSequences {
+		if err := client.checkAccountLoaded(ctx, signer); err != nil {
+			return err
+		}
+		if next > client.signer.accounts[signer].Sequence() {
+			if err := client.signer.SetSequence(signer, next); err != nil {
+				return fmt.Errorf("setting sequence: %w", err)
+			}
+		}
+	}
+	for signer, evicted := range evictedSequences {
+		if err := client.checkAccountLoaded(ctx, signer); err != nil {
+			return err
+		}
+		// The account sequence has moved past the evicted transaction if
+		// another transaction with the same sequence was committed since.
+		_, committed, err := QueryAccount(ctx, client.grpc, client.registry, client.signer.accounts[signer].Address())
+		if err != nil {
+			return fmt.Errorf("querying account %s: %w", signer, err)
+		}
+		if evicted >= committed && evicted < client.signer.accounts[signer].Sequence() {
+			if err := client.signer.SetSequence(signer, evicted); err != nil {
+				return fmt.Errorf("setting sequence: %w", err)
+			}
 		}
 	}
+	return nil
 }
 
 // ConfirmTx periodically pings the provided node for the commitment of a transaction by its
@@ -434,6 +717,8 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 	defer pollTicker.Stop()
 
 	for {
+		// follow the transaction if it was resubmitted
+		txHash = client.resolveReplacement(txHash)
 		resp, err := txClient.TxStatus(ctx, &tx.TxStatusRequest{TxId: txHash})
 		if err != nil {
 			return nil, err
@@ -449,25 +734,29 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 				continue
 			}
 		case core.TxStatusCommitted:
-			txResponse := &TxResponse{
-				Height: resp.Height,
-				TxHash: txHash,
-				Code:   resp.ExecutionCode,
+			return client.committedTx(txHash, resp)
+		case core.TxStatusEvicted:
+			// a transaction it replaced may have been committed instead
+			if committedHash, committed, ok := client.committedReplacedTx(ctx, txHash); ok {
+				client.deleteFromTxTracker(txHash)
+				return client.committedTx(committedHash, committed)
 			}
-			if resp.ExecutionCode != abci.CodeTypeOK {
-				executionErr := &ExecutionError{
-					TxHash:   txHash,
-					Code:     resp.ExecutionCode,
-					ErrorLog: resp.Error,
+			if client.retryPolicy != nil {
+				if _, err := client.resubmit(ctx, txHash); err == nil {
+					continue
 				}
-				client.deleteFromTxTracker(txHash)
-				return nil, executionErr
 			}
-			client.deleteFromTxTracker(txHash)
-			return txResponse, nil
-		case core.TxStatusEvicted:
 			return nil, client.handleEvictions(txHash)
 		default:
+			if committedHash, committed, ok := client.committedReplacedTx(ctx, txHash); ok {
+				client.deleteFromTxTracker(txHash)
+				return client.committedTx(committedHash, committed)
+			}
+			if client.retryPolicy != nil {
+				if _, err := client.resubmit(ctx, txHash); err == nil {
+					continue
+				}
+			}
 			client.deleteFromTxTracker(txHash)
 			if ctx.Err() != nil {
 				return nil, ctx.Err()
@@ -477,31 +766,62 @@ func (client *TxClient) ConfirmTx(ctx context.Context, txHash string) (*TxRespon
 	}
 }
 
+// committedTx stops tracking the committed transaction and returns its
+// response, or an ExecutionError if its execution failed.
+func (client *TxClient) committedTx(txHash string, resp *tx.TxStatusResponse) (*TxResponse, error) {
+	client.deleteFromTxTracker(txHash)
+	if resp.ExecutionCode != abci.CodeTypeOK {
+		return nil, &ExecutionError{
+			TxHash:   txHash,
+			Code:     resp.ExecutionCode,
+			ErrorLog: resp.Error,
+		}
+	}
+	return &TxResponse{
+		Height: resp.Height,
+		TxHash: txHash,
+		Code:   resp.ExecutionCode,
+	}, nil
+}
+
 // handleEvictions handles the scenario where a transaction is evicted from the mempool.
 // It removes the evicted transaction from the local tx tracker without incrementing
 // the signer's sequence.
 func (client *TxClient) handleEvictions(txHash string) error {
+	client.submitMtx.Lock()
+	defer client.submitMtx.Unlock()
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
 	// Get transaction from the local tx tracker
-	txInfo, exists := client.txTracker[txHash]
+	txInfo, exists, err := client.txTracker.Get(txHash)
+	if err != nil {
+		return fmt.Errorf("getting tx %s from tx tracker: %w", txHash, err)
+	}
 	if !exists {
 		return fmt.Errorf("tx: %s not found in tx client txTracker; likely failed during broadcast", txHash)
 	}
 	// The sequence should be rolled back to the sequence of the transaction that was evicted to be
 	// ready for resubmission. All transactions with a later nonce will be kicked by the nodes tx pool.
-	if err := client.signer.SetSequence(txInfo.signer, txInfo.sequence); err != nil {
+	if err := client.signer.SetSequence(txInfo.Signer, txInfo.Sequence); err != nil {
 		return fmt.Errorf("setting sequence: %w", err)
 	}
-	delete(client.txTracker, txHash)
+	if err := client.txTracker.Delete(txHash); err != nil {
+		return fmt.Errorf("deleting tx %s from tx tracker: %w", txHash, err)
+	}
+	delete(client.submissions, txHash)
+	client.releaseTx(txHash)
 	return fmt.Errorf("tx was evicted from the mempool")
 }
 
 // deleteFromTxTracker safely deletes a transaction from the local tx tracker.
+// A transaction that fails to be deleted is removed by the next pruning or
+// reconciliation of the tracker.
 func (client *TxClient) deleteFromTxTracker(txHash string) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
-	delete(client.txTracker, txHash)
+	_ = client.txTracker.Delete(txHash)
+	delete(client.submissions, txHash)
+	client.releaseTx(txHash)
 }
 
 // EstimateGas simulates the transaction, calculating the amount of gas that was
@@ -539,7 +859,7 @@ func (client *TxClient) EstimateGasPriceAndUsage(
 	// add at least 1utia as fee to builder as it affects gas calculation.
 	txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewInt(1))))
 
-	_, _, err = client.signer.signTransaction(txBuilder)
+	_, _, err = client.signer.signTransactionWith(txBuilder, client.signUnlocked)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -574,11 +894,19 @@ func (client *TxClient) EstimateGasPrice(ctx context.Context, priority gasestima
 	return resp.EstimatedGasPrice, nil
 }
 
+// signUnlocked signs with the backend of the signer while mtx is released. The
+// caller must hold mtx, and submitMtx if the signed transaction is broadcast.
+func (client *TxClient) signUnlocked(name string, bytesToSign []byte, signMode signing.SignMode) ([]byte, error) {
+	client.mtx.Unlock()
+	defer client.mtx.Lock()
+	return client.signer.backend.Sign(name, bytesToSign, signMode)
+}
+
 func (client *TxClient) estimateGas(ctx context.Context, txBuilder client.TxBuilder) (uint64, error) {
 	// add at least 1utia as fee to builder as it affects gas calculation.
 	txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdkmath.NewInt(1))))
 
-	_, _, err := client.signer.signTransaction(txBuilder)
+	_, _, err := client.signer.signTransactionWith(txBuilder, client.signUnlocked)
 	if err != nil {
 		return 0, err
 	}
@@ -624,20 +952,16 @@ func (client *TxClient) checkAccountLoaded(ctx context.Context, account string)
 	if _, exists := client.signer.accounts[account]; exists {
 		return nil
 	}
-	record, err := client.signer.keys.Key(account)
+	key, err := client.signer.backend.Key(account)
 	if err != nil {
-		return fmt.Errorf("trying to find account %s on keyring: %w", account, err)
-	}
-	addr, err := record.GetAddress()
-	if err != nil {
-		return fmt.Errorf("retrieving address from keyring: %w", err)
+		return fmt.Errorf("trying to find account %s on signer backend: %w", account, err)
 	}
 	// FIXME: have a less trusting way of getting the account number and sequence
-	accNum, sequence, err := QueryAccount(ctx, client.grpc, client.registry, addr)
+	accNum, sequence, err := QueryAccount(ctx, client.grpc, client.registry, key.Address())
 	if err != nil {
 		return fmt.Errorf("querying account %s: %w", account, err)
 	}
-	return client.signer.AddAccount(NewAccount(account, accNum, sequence))
+	return client.loadAccount(NewAccount(account, accNum, sequence))
 }
 
 func (client *TxClient) getAccountNameFromMsgs(msgs []sdktypes.Msg) (string, error) {
@@ -657,19 +981,32 @@ func (client *TxClient) getAccountNameFromMsgs(msgs []sdktypes.Msg) (string, err
 			return "", errors.New("not supported: got two different signers across multiple messages")
 		}
 	}
-	record, err := client.signer.keys.KeyByAddress(addr)
+	key, err := keyByAddress(client.signer.backend, addr)
 	if err != nil {
 		return "", err
 	}
-	return record.Name, nil
+	return key.Name, nil
 }
 
 // GetTxFromTxTracker gets transaction info from the tx client's local tx tracker by its hash
 func (client *TxClient) GetTxFromTxTracker(hash string) (sequence uint64, signer string, exists bool) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
-	txInfo, exists := client.txTracker[hash]
-	return txInfo.sequence, txInfo.signer, exists
+	txInfo, exists, err := client.txTracker.Get(hash)
+	if err != nil {
+		return 0, "", false
+	}
+	return txInfo.Sequence, txInfo.Signer, exists
+}
+
+// isTracked returns true if the transaction with the provided hash, or the
+// transaction that replaced it, was broadcast by the client and hasn't been
+// confirmed yet.
+func (client *TxClient) isTracked(txHash string) bool {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	_, exists, err := client.txTracker.Get(client.latestReplacement(txHash))
+	return err == nil && exists
 }
 
 // Signer exposes the tx clients underlying signer
@@ -683,6 +1020,13 @@ func (client *TxClient) SetDefaultGasPrice(price float64) {
 	client.defaultGasPrice = price
 }
 
+// gasPrice returns the default gas price of the client.
+func (client *TxClient) gasPrice() float64 {
+	client.mtx.Lock()
+	defer client.mtx.Unlock()
+	return client.defaultGasPrice
+}
+
 // QueryMinimumGasPrice queries both the nodes local and network wide
 // minimum gas prices, returning the maximum of the two.
 func QueryMinimumGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
@@ -712,6 +1056,32 @@ func QueryMinimumGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float
 	return max(localMinPrice, networkMinPrice), nil
 }
 
+// QueryBlobParams queries the params of the blob module.
+func QueryBlobParams(ctx context.Context, grpcConn *grpc.ClientConn) (types.Params, error) {
+	resp, err := types.NewQueryClient(grpcConn).Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return types.Params{}, fmt.Errorf("querying blob params: %w", err)
+	}
+	return resp.Params, nil
+}
+
+// QueryBlobBaseFee queries the min gas price of the transactions paying for
+// blobs set by the dynamic blob base fee. It returns zero if the dynamic blob
+// base fee is disabled or not supported by the network.
+func QueryBlobBaseFee(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
+	resp, err := minfeetypes.NewQueryClient(grpcConn).BlobBaseFee(ctx, &minfeetypes.QueryBlobBaseFeeRequest{})
+	if status.Code(err) == codes.Unimplemented {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("querying blob base fee: %w", err)
+	}
+	if !resp.Enabled {
+		return 0, nil
+	}
+	return resp.BlobBaseFee.Float64()
+}
+
 func QueryNetworkMinGasPrice(ctx context.Context, grpcConn *grpc.ClientConn) (float64, error) {
 	paramsClient := paramtypes.NewQueryClient(grpcConn)
 	// NOTE: that we don't prove that this is the correct value
@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/math/unsafe"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/rpc/core"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
@@ -24,6 +25,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v4/app"
 	"github.com/celestiaorg/celestia-app/v4/app/encoding"
 	"github.com/celestiaorg/celestia-app/v4/app/grpc/gasestimation"
+	"github.com/celestiaorg/celestia-app/v4/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v4/app/params"
 	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v4/pkg/user"
@@ -235,6 +237,45 @@ func (suite *TxClientTestSuite) TestConfirmTx() {
 	})
 }
 
+func TestBumpFee(t *testing.T) {
+	// blocks are produced slowly enough for the original transaction to be
+	// replaced before a block is proposed.
+	_, txClient, ctx := setupTxClientWithTimeoutCommit(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration, 2*time.Second, user.WithFeeBumping())
+	goCtx, cancel := context.WithTimeout(ctx.GoContext(), 30*time.Second)
+	defer cancel()
+
+	_, err := txClient.BumpFee(goCtx, "unknown", 1)
+	require.Error(t, err)
+
+	require.NoError(t, ctx.WaitForNextBlock())
+	addr := txClient.DefaultAddress()
+	msg := bank.NewMsgSend(addr, testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	resp, err := txClient.BroadcastTx(goCtx, []sdk.Msg{msg}, user.SetGasLimit(1e6), user.SetFee(1e4))
+	require.NoError(t, err)
+	seqAfterBroadcast := txClient.Signer().Account(txClient.DefaultAccountName()).Sequence()
+
+	// the fee must be raised
+	_, err = txClient.BumpFee(goCtx, resp.TxHash, 0.01)
+	require.Error(t, err)
+
+	bumpResp, err := txClient.BumpFee(goCtx, resp.TxHash, 0.02)
+	require.NoError(t, err)
+	require.NotEqual(t, resp.TxHash, bumpResp.TxHash)
+	require.Equal(t, seqAfterBroadcast, txClient.Signer().Account(txClient.DefaultAccountName()).Sequence())
+
+	// confirming the original transaction follows the replacement
+	confirmTxResp, err := txClient.ConfirmTx(goCtx, resp.TxHash)
+	require.NoError(t, err)
+	require.Equal(t, bumpResp.TxHash, confirmTxResp.TxHash)
+
+	// the original transaction is dropped from the proposal and evicted
+	// from the mempool when it is rechecked.
+	require.NoError(t, ctx.WaitForNextBlock())
+	status, err := tx.NewTxClient(ctx.GRPCClient).TxStatus(goCtx, &tx.TxStatusRequest{TxId: resp.TxHash})
+	require.NoError(t, err)
+	require.Contains(t, []string{core.TxStatusEvicted, core.TxStatusRejected}, status.Status)
+}
+
 func TestEvictions(t *testing.T) {
 	_, txClient, ctx := setupTxClient(t, 1*time.Nanosecond)
 
@@ -262,6 +303,20 @@ func TestEvictions(t *testing.T) {
 	require.Equal(t, seqBeforeEviction, seqAfterEviction)
 }
 
+func TestRetryPolicyNonceMismatch(t *testing.T) {
+	_, txClient, ctx := setupTxClient(t, testnode.DefaultTendermintConfig().Mempool.TTLDuration, user.WithRetryPolicy(user.DefaultRetryPolicy()))
+
+	account := txClient.DefaultAccountName()
+	seq := txClient.Signer().Account(account).Sequence()
+	require.NoError(t, txClient.Signer().SetSequence(account, seq+5))
+
+	msg := bank.NewMsgSend(txClient.DefaultAddress(), testnode.RandomAddress().(sdk.AccAddress), sdk.NewCoins(sdk.NewInt64Coin(params.BondDenom, 10)))
+	resp, err := txClient.SubmitTx(ctx.GoContext(), []sdk.Msg{msg}, blobfactory.DefaultTxOpts()...)
+	require.NoError(t, err)
+	require.Equal(t, abci.CodeTypeOK, resp.Code)
+	require.Equal(t, seq+1, txClient.Signer().Account(account).Sequence())
+}
+
 // TestWithEstimatorService ensures that if the WithEstimatorService
 // option is provided to the tx client, the separate gas estimator service is
 // used to estimate gas price and usage instead of the default connection.
@@ -372,6 +427,15 @@ func setupTxClient(
 	t *testing.T,
 	ttlDuration time.Duration,
 	opts ...user.Option,
+) (encoding.Config, *user.TxClient, testnode.Context) {
+	return setupTxClientWithTimeoutCommit(t, ttlDuration, 100*time.Millisecond, opts...)
+}
+
+func setupTxClientWithTimeoutCommit(
+	t *testing.T,
+	ttlDuration time.Duration,
+	timeoutCommit time.Duration,
+	opts ...user.Option,
 ) (encoding.Config, *user.TxClient, testnode.Context) {
 	defaultTmConfig := testnode.DefaultTendermintConfig()
 	defaultTmConfig.Mempool.TTLDuration = ttlDuration
@@ -381,7 +445,7 @@ func setupTxClient(
 		WithTendermintConfig(defaultTmConfig).
 		WithFundedAccounts("a", "b", "c").
 		WithChainID(chainID).
-		WithTimeoutCommit(100 * time.Millisecond).
+		WithTimeoutCommit(timeoutCommit).
 		WithAppCreator(testnode.CustomAppCreator(baseapp.SetMinGasPrices("0utia"), baseapp.SetChainID(chainID)))
 
 	ctx, _, _ := testnode.NewNetwork(t, testnodeConfig)
@@ -0,0 +1,185 @@
+package user
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+)
+
+// TrackedTx is a transaction submitted by the TxClient that hasn't been
+// confirmed yet.
+type TrackedTx struct {
+	TxHash string `json:"tx_hash"`
+	// Signer is the name of the account that signed the transaction.
+	Signer string `json:"signer"`
+	// Sequence is the sequence of the signer used by the transaction.
+	Sequence  uint64    `json:"sequence"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// TxTracker stores the transactions submitted by the TxClient until they are
+// confirmed. It allows the TxClient to roll back the sequence of a signer when
+// one of its transactions is evicted and, if the tracker is persistent, to
+// recover the sequences of its signers after a restart.
+type TxTracker interface {
+	// Add tracks a submitted transaction.
+	Add(tx TrackedTx) error
+	// Get returns the tracked transaction with the provided hash.
+	Get(txHash string) (tx TrackedTx, exists bool, err error)
+	// Delete stops tracking the transaction with the provided hash.
+	Delete(txHash string) error
+	// Prune stops tracking the transactions submitted before the provided time.
+	Prune(before time.Time) error
+	// List returns all the tracked transactions.
+	List() ([]TrackedTx, error)
+}
+
+var (
+	_ TxTracker = &memTxTracker{}
+	_ TxTracker = &dbTxTracker{}
+)
+
+// memTxTracker is an in-memory TxTracker. It is the default tracker of the
+// TxClient.
+type memTxTracker struct {
+	mtx sync.Mutex
+	txs map[string]TrackedTx
+}
+
+// NewMemTxTracker returns a TxTracker that keeps the transactions in memory.
+func NewMemTxTracker() TxTracker {
+	return &memTxTracker{txs: make(map[string]TrackedTx)}
+}
+
+func (t *memTxTracker) Add(tx TrackedTx) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	t.txs[tx.TxHash] = tx
+	return nil
+}
+
+func (t *memTxTracker) Get(txHash string) (TrackedTx, bool, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	tx, exists := t.txs[txHash]
+	return tx, exists, nil
+}
+
+func (t *memTxTracker) Delete(txHash string) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	delete(t.txs, txHash)
+	return nil
+}
+
+func (t *memTxTracker) Prune(before time.Time) error {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	for hash, tx := range t.txs {
+		if !tx.Timestamp.After(before) {
+			delete(t.txs, hash)
+		}
+	}
+	return nil
+}
+
+func (t *memTxTracker) List() ([]TrackedTx, error) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+	txs := make([]TrackedTx, 0, len(t.txs))
+	for _, tx := range t.txs {
+		txs = append(txs, tx)
+	}
+	return txs, nil
+}
+
+// dbTxTracker is a TxTracker persisted in a key-value store. Writes are
+// flushed to storage before returning so that the tracked transactions survive
+// a crash.
+type dbTxTracker struct {
+	db dbm.DB
+}
+
+// NewDBTxTracker returns a TxTracker that persists the transactions in db,
+// keyed by their hash. The db must not be used for anything else. Closing the
+// db is left to the caller.
+func NewDBTxTracker(db dbm.DB) TxTracker {
+	return &dbTxTracker{db: db}
+}
+
+// NewFileTxTracker returns a TxTracker persisted in a goleveldb database in
+// dir along with a function to close it.
+func NewFileTxTracker(dir string) (TxTracker, func() error, error) {
+	db, err := dbm.NewGoLevelDB("tx_tracker", dir, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening tx tracker db: %w", err)
+	}
+	return NewDBTxTracker(db), db.Close, nil
+}
+
+func (t *dbTxTracker) Add(tx TrackedTx) error {
+	bz, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+	return t.db.SetSync([]byte(tx.TxHash), bz)
+}
+
+func (t *dbTxTracker) Get(txHash string) (TrackedTx, bool, error) {
+	bz, err := t.db.Get([]byte(txHash))
+	if err != nil || bz == nil {
+		return TrackedTx{}, false, err
+	}
+	var tx TrackedTx
+	if err := json.Unmarshal(bz, &tx); err != nil {
+		return TrackedTx{}, false, fmt.Errorf("decoding tracked tx %s: %w", txHash, err)
+	}
+	return tx, true, nil
+}
+
+func (t *dbTxTracker) Delete(txHash string) error {
+	return t.db.DeleteSync([]byte(txHash))
+}
+
+func (t *dbTxTracker) Prune(before time.Time) error {
+	txs, err := t.List()
+	if err != nil {
+		return err
+	}
+	batch := t.db.NewBatch()
+	defer batch.Close()
+	pruned := 0
+	for _, tx := range txs {
+		if !tx.Timestamp.After(before) {
+			if err := batch.Delete([]byte(tx.TxHash)); err != nil {
+				return err
+			}
+			pruned++
+		}
+	}
+	if pruned == 0 {
+		return nil
+	}
+	return batch.WriteSync()
+}
+
+func (t *dbTxTracker) List() ([]TrackedTx, error) {
+	iter, err := t.db.Iterator(nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	txs := make([]TrackedTx, 0)
+	for ; iter.Valid(); iter.Next() {
+		var tx TrackedTx
+		if err := json.Unmarshal(iter.Value(), &tx); err != nil {
+			return nil, fmt.Errorf("decoding tracked tx %s: %w", iter.Key(), err)
+		}
+		txs = append(txs, tx)
+	}
+	return txs, iter.Error()
+}
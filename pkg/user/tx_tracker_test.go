@@ -0,0 +1,78 @@
+package user
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxTrackers(t *testing.T) {
+	trackers := map[string]func(t *testing.T) TxTracker{
+		"memory": func(*testing.T) TxTracker { return NewMemTxTracker() },
+		"db":     func(*testing.T) TxTracker { return NewDBTxTracker(dbm.NewMemDB()) },
+		"file": func(t *testing.T) TxTracker {
+			tracker, closeFn, err := NewFileTxTracker(t.TempDir())
+			require.NoError(t, err)
+			t.Cleanup(func() { require.NoError(t, closeFn()) })
+			return tracker
+		},
+	}
+	for name, newTracker := range trackers {
+		t.Run(name, func(t *testing.T) {
+			tracker := newTracker(t)
+			now := time.Now().UTC()
+			old := TrackedTx{TxHash: "AA", Signer: "alice", Sequence: 1, Timestamp: now.Add(-time.Hour)}
+			recent := TrackedTx{TxHash: "BB", Signer: "bob", Sequence: 7, Timestamp: now}
+			require.NoError(t, tracker.Add(old))
+			require.NoError(t, tracker.Add(recent))
+
+			got, exists, err := tracker.Get("BB")
+			require.NoError(t, err)
+			require.True(t, exists)
+			assert.Equal(t, recent.Sequence, got.Sequence)
+			assert.Equal(t, recent.Signer, got.Signer)
+			assert.True(t, recent.Timestamp.Equal(got.Timestamp))
+
+			_, exists, err = tracker.Get("CC")
+			require.NoError(t, err)
+			assert.False(t, exists)
+
+			txs, err := tracker.List()
+			require.NoError(t, err)
+			sort.Slice(txs, func(i, j int) bool { return txs[i].TxHash < txs[j].TxHash })
+			require.Len(t, txs, 2)
+			assert.Equal(t, "AA", txs[0].TxHash)
+
+			require.NoError(t, tracker.Prune(now.Add(-time.Minute)))
+			txs, err = tracker.List()
+			require.NoError(t, err)
+			require.Len(t, txs, 1)
+			assert.Equal(t, "BB", txs[0].TxHash)
+
+			require.NoError(t, tracker.Delete("BB"))
+			txs, err = tracker.List()
+			require.NoError(t, err)
+			assert.Empty(t, txs)
+		})
+	}
+}
+
+func TestFileTxTrackerSurvivesRestart(t *testing.T) {
+	dir := t.TempDir()
+	tracker, closeFn, err := NewFileTxTracker(dir)
+	require.NoError(t, err)
+	require.NoError(t, tracker.Add(TrackedTx{TxHash: "AA", Signer: "alice", Sequence: 3, Timestamp: time.Now()}))
+	require.NoError(t, closeFn())
+
+	tracker, closeFn, err = NewFileTxTracker(dir)
+	require.NoError(t, err)
+	defer func() { require.NoError(t, closeFn()) }()
+	tx, exists, err := tracker.Get("AA")
+	require.NoError(t, err)
+	require.True(t, exists)
+	assert.Equal(t, uint64(3), tx.Sequence)
+}
@@ -79,7 +79,7 @@ func (s *BlobSequence) Init(_ context.Context, _ grpc.ClientConn, allocateAccoun
 	s.account = allocateAccounts(1, funds)[0]
 }
 
-func (s *BlobSequence) Next(_ context.Context, _ grpc.ClientConn, rand *rand.Rand) (Operation, error) {
+func (s *BlobSequence) Next(ctx context.Context, querier grpc.ClientConn, rand *rand.Rand) (Operation, error) {
 	numBlobs := s.blobsPerPFB.Rand(rand)
 	sizes := make([]int, numBlobs)
 	namespaces := make([]share.Namespace, numBlobs)
@@ -113,10 +113,14 @@ func (s *BlobSequence) Next(_ context.Context, _ grpc.ClientConn, rand *rand.Ran
 	if err != nil {
 		return Operation{}, err
 	}
+	resp, err := blob.NewQueryClient(querier).Params(ctx, &blob.QueryParamsRequest{})
+	if err != nil {
+		return Operation{}, fmt.Errorf("querying blob params: %w", err)
+	}
 	return Operation{
 		Msgs:     []types.Msg{msg},
 		Blobs:    blobs,
-		GasLimit: estimateGas(sizes, s.useFeegrant),
+		GasLimit: estimateGas(resp.Params, blobs, s.useFeegrant),
 	}, nil
 }
 
@@ -137,18 +141,14 @@ func (r Range) Rand(rand *rand.Rand) int {
 	return rand.Intn(r.Max-r.Min) + r.Min
 }
 
-// estimateGas estimates the gas required to pay for a set of blobs in a PFB.
-func estimateGas(blobSizes []int, useFeegrant bool) uint64 {
-	size := make([]uint32, len(blobSizes))
-	for i, s := range blobSizes {
-		size[i] = uint32(s)
-	}
-
+// estimateGas estimates the gas required to pay for a set of blobs in a PFB
+// under the blob params.
+func estimateGas(params blob.Params, blobs []*share.Blob, useFeegrant bool) uint64 {
 	// account for the extra gas required to pay for the fee granter
 	extra := uint64(0)
 	if useFeegrant {
 		extra = 12000
 	}
 
-	return blob.DefaultEstimateGas(size) + extra
+	return blob.EstimateGasWithParams(params, blobs, appconsts.DefaultTxSizeCostPerByte) + extra
 }
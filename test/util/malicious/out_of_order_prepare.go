@@ -37,6 +37,7 @@ func (a *App) OutOfOrderPrepareProposal(req *abci.RequestPrepareProposal) (*abci
 		a.AccountKeeper,
 		a.BankKeeper,
 		a.BlobKeeper,
+		a.NsRegistryKeeper,
 		a.FeeGrantKeeper,
 		a.GetTxConfig().SignModeHandler(),
 		ante.DefaultSigVerificationGasConsumer,
@@ -46,11 +47,12 @@ func (a *App) OutOfOrderPrepareProposal(req *abci.RequestPrepareProposal) (*abci
 		a.GovParamFilters(),
 	)
 
-	txs := app.FilterTxs(a.Logger(), sdkCtx, handler, a.GetTxConfig(), req.Txs)
+	maxSquareSize := a.MaxEffectiveSquareSize(sdkCtx)
+	txs := app.FilterTxs(a.Logger(), sdkCtx, handler, a.GetTxConfig(), req.Txs, maxSquareSize)
 
 	// build the square from the set of valid and prioritised transactions.
 	// The txs returned are the ones used in the square and block
-	dataSquare, txs, err := Build(txs, appconsts.LatestVersion, a.MaxEffectiveSquareSize(sdkCtx), OutOfOrderExport)
+	dataSquare, txs, err := Build(txs, appconsts.LatestVersion, maxSquareSize, OutOfOrderExport)
 	if err != nil {
 		panic(err)
 	}
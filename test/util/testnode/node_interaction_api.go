@@ -251,7 +251,11 @@ func (c *Context) PostData(account, broadcastMode string, ns share.Namespace, bl
 		return nil, err
 	}
 
-	gas := types.DefaultEstimateGas([]uint32{uint32(len(blobData))})
+	params, err := types.NewQueryClient(c.Context).Params(c.GoContext(), &types.QueryParamsRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("querying blob params: %w", err)
+	}
+	gas := types.EstimateGasWithParams(params.Params, []*share.Blob{b}, appconsts.DefaultTxSizeCostPerByte)
 	opts := blobfactory.FeeTxOpts(gas)
 
 	blobTx, _, err := signer.CreatePayForBlobs(account, []*share.Blob{b}, opts...)
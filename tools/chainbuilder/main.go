@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -216,6 +217,7 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 		return fmt.Errorf("last application height is %d, but the block store height is %d", infoResp.LastBlockHeight, lastHeight)
 	}
 
+	var blobParams blobtypes.Params
 	if lastHeight == 0 {
 		if gen == nil {
 			return fmt.Errorf("non empty directory but no blocks found")
@@ -226,6 +228,11 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 			return fmt.Errorf("failed to export genesis document: %w", err)
 		}
 
+		blobParams, err = genesisBlobParams(encCfg, genDoc.AppState)
+		if err != nil {
+			return fmt.Errorf("failed to read blob params from genesis: %w", err)
+		}
+
 		state, err := stateStore.LoadFromDBOrGenesisDoc(genDoc)
 		if err != nil {
 			return fmt.Errorf("failed to load state from database or genesis document: %w", err)
@@ -264,6 +271,7 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 		currentTime = currentTime.Add(cfg.BlockInterval)
 	} else {
 		fmt.Println("Starting from height", lastHeight)
+		blobParams = simApp.BlobKeeper.GetParams(simApp.NewUncachedContext(false, tmproto.Header{}))
 	}
 	state, err := stateStore.Load()
 	if err != nil {
@@ -304,7 +312,7 @@ func Run(ctx context.Context, cfg BuilderConfig, dir string) error {
 	defer cancel()
 
 	go func() {
-		errCh <- generateSquareRoutine(ctx, signer, cfg, dataCh)
+		errCh <- generateSquareRoutine(ctx, signer, cfg, blobParams, dataCh)
 	}()
 
 	go func() {
@@ -458,6 +466,7 @@ func generateSquareRoutine(
 	ctx context.Context,
 	signer *user.Signer,
 	cfg BuilderConfig,
+	blobParams blobtypes.Params,
 	dataCh chan<- *tmproto.Data,
 ) error {
 	for i := 0; i < cfg.NumBlocks; i++ {
@@ -474,7 +483,7 @@ func generateSquareRoutine(
 			return err
 		}
 
-		blobGas := blobtypes.DefaultEstimateGas([]uint32{uint32(cfg.BlockSize)})
+		blobGas := blobtypes.EstimateGasWithParams(blobParams, []*share.Blob{blob}, appconsts.DefaultTxSizeCostPerByte)
 		fee := float64(blobGas) * appconsts.DefaultMinGasPrice * 2
 		tx, _, err := signer.CreatePayForBlobs(account.Name(), []*share.Blob{blob}, user.SetGasLimit(blobGas), user.SetFee(uint64(fee)))
 		if err != nil {
@@ -522,6 +531,19 @@ type persistData struct {
 	seenCommit *types.Commit
 }
 
+// genesisBlobParams returns the blob params of the genesis app state.
+func genesisBlobParams(encCfg encoding.Config, appState []byte) (blobtypes.Params, error) {
+	var genesisState map[string]json.RawMessage
+	if err := json.Unmarshal(appState, &genesisState); err != nil {
+		return blobtypes.Params{}, err
+	}
+	var blobGenesis blobtypes.GenesisState
+	if err := encCfg.Codec.UnmarshalJSON(genesisState[blobtypes.ModuleName], &blobGenesis); err != nil {
+		return blobtypes.Params{}, err
+	}
+	return blobGenesis.Params, nil
+}
+
 func persistDataRoutine(
 	ctx context.Context,
 	stateStore sm.Store,
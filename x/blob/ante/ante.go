@@ -2,6 +2,7 @@ package ante
 
 import (
 	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/authz"
@@ -34,9 +35,10 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 		return next(ctx, tx, simulate)
 	}
 
-	gasPerByte := appconsts.DefaultGasPerBlobByte
+	// read the gas schedule without consuming gas from the tx gas meter
+	params := d.k.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
 	txGas := ctx.GasMeter().GasRemaining()
-	err := d.validatePFBHasEnoughGas(tx.GetMsgs(), gasPerByte, txGas)
+	err := d.validatePFBHasEnoughGas(tx.GetMsgs(), params, txGas)
 	if err != nil {
 		return ctx, err
 	}
@@ -47,7 +49,7 @@ func (d MinGasPFBDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 // validatePFBHasEnoughGas iterates through all the msgs and nested msgs to find
 // a MsgPayForBlobs. If found, it validates that the txGas is enough to pay for
 // the blobs.
-func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, gasPerByte uint32, txGas uint64) error {
+func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, params types.Params, txGas uint64) error {
 	for _, m := range msgs {
 		if execMsg, ok := m.(*authz.MsgExec); ok {
 			// Recursively look for PFBs in nested authz messages.
@@ -55,13 +57,13 @@ func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, gasPerByte u
 			if err != nil {
 				return err
 			}
-			err = d.validatePFBHasEnoughGas(nestedMsgs, gasPerByte, txGas)
+			err = d.validatePFBHasEnoughGas(nestedMsgs, params, txGas)
 			if err != nil {
 				return err
 			}
 		}
 		if pfb, ok := m.(*types.MsgPayForBlobs); ok {
-			err := validateEnoughGas(pfb, gasPerByte, txGas)
+			err := validateEnoughGas(pfb, params, txGas)
 			if err != nil {
 				return err
 			}
@@ -72,8 +74,8 @@ func (d MinGasPFBDecorator) validatePFBHasEnoughGas(msgs []sdk.Msg, gasPerByte u
 
 // validateEnoughGas returns an error if the gas needed to pay for the blobs is
 // greater than the txGas.
-func validateEnoughGas(msg *types.MsgPayForBlobs, gasPerByte uint32, txGas uint64) error {
-	gasToConsume := msg.Gas(gasPerByte)
+func validateEnoughGas(msg *types.MsgPayForBlobs, params types.Params, txGas uint64) error {
+	gasToConsume := params.PFBGas(msg, appconsts.DefaultGasPerBlobByte)
 	if gasToConsume > txGas {
 		return errors.Wrapf(sdkerrors.ErrInsufficientFee, "not enough gas to pay for blobs (minimum: %d, got: %d)", gasToConsume, txGas)
 	}
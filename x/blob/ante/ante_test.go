@@ -186,3 +186,38 @@ func (mockBlobKeeper) GetParams(sdk.Context) blob.Params {
 		GovMaxSquareSize: testGovMaxSquareSize,
 	}
 }
+
+func TestMaxBlobSizeDecorator(t *testing.T) {
+	anteHandler := ante.NewMaxBlobSizeDecorator(paramsBlobKeeper{blob.Params{MaxBlobSize: 1000}})
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	ctx := sdk.NewContext(nil, tmproto.Header{Height: 1}, false, nil)
+
+	testCases := []struct {
+		name    string
+		msg     sdk.Msg
+		wantErr bool
+	}{
+		{"blobs within max size", &blob.MsgPayForBlobs{BlobSizes: []uint32{1, 1000}}, false},
+		{"blob exceeding max size", &blob.MsgPayForBlobs{BlobSizes: []uint32{1, 1001}}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msg))
+			_, err := anteHandler.AnteHandle(ctx, txBuilder.GetTx(), false, mockNext)
+			if tc.wantErr {
+				require.ErrorIs(t, err, blob.ErrBlobsTooLarge)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+type paramsBlobKeeper struct {
+	params blob.Params
+}
+
+func (k paramsBlobKeeper) GetParams(sdk.Context) blob.Params {
+	return k.params
+}
@@ -0,0 +1,59 @@
+package ante
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+// MaxBlobSizeDecorator helps to prevent a PFB from being included in a block
+// but failing in DeliverTx because one of its blobs exceeds the MaxBlobSize
+// param.
+type MaxBlobSizeDecorator struct {
+	k BlobKeeper
+}
+
+func NewMaxBlobSizeDecorator(k BlobKeeper) MaxBlobSizeDecorator {
+	return MaxBlobSizeDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. It
+// returns an error if tx contains a MsgPayForBlobs with a blob larger than the
+// max blob size.
+func (d MaxBlobSizeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// Skip the check during genesis initialization
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	params := d.k.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	if err := d.validateMsgs(tx.GetMsgs(), params); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+func (d MaxBlobSizeDecorator) validateMsgs(msgs []sdk.Msg, params blobtypes.Params) error {
+	for _, m := range msgs {
+		if execMsg, ok := m.(*authz.MsgExec); ok {
+			// Recursively look for PFBs in nested authz messages.
+			nestedMsgs, err := execMsg.GetMessages()
+			if err != nil {
+				return err
+			}
+			if err := d.validateMsgs(nestedMsgs, params); err != nil {
+				return err
+			}
+		}
+
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			if err := params.ValidateBlobSizes(pfb.BlobSizes); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -61,3 +61,21 @@ func TestPayForBlobGas(t *testing.T) {
 		})
 	}
 }
+
+func TestPayForBlobGasSchedule(t *testing.T) {
+	k, stateStore, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	params := types.DefaultParams()
+	params.PfbBaseGas = 1000
+	params.GasPerBlob = 100
+	params.MaxBlobSize = 1024
+	k.SetParams(ctx, params)
+
+	ctx = sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	_, err := k.PayForBlobs(ctx, &types.MsgPayForBlobs{BlobSizes: []uint32{1, 1024}})
+	require.NoError(t, err)
+	// 1 + 3 shares * 512 bytes per share * 8 gas per byte + 2 blobs * 100 + 1000
+	require.Equal(t, uint64(4*share.ShareSize*appconsts.DefaultGasPerBlobByte+2*100+1000), ctx.GasMeter().GasConsumed())
+
+	_, err = k.PayForBlobs(ctx, &types.MsgPayForBlobs{BlobSizes: []uint32{1025}})
+	require.ErrorIs(t, err, types.ErrBlobsTooLarge)
+}
@@ -52,7 +52,13 @@ func (k Keeper) GetAuthority() string {
 // PayForBlobs consumes gas based on the blob sizes in the MsgPayForBlobs.
 func (k Keeper) PayForBlobs(goCtx context.Context, msg *types.MsgPayForBlobs) (*types.MsgPayForBlobsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	gasToConsume := types.GasToConsume(msg.BlobSizes, appconsts.DefaultGasPerBlobByte)
+	// reading the gas schedule is free so that the gas of a PFB only depends
+	// on the schedule.
+	params := k.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	if err := params.ValidateBlobSizes(msg.BlobSizes); err != nil {
+		return nil, err
+	}
+	gasToConsume := params.PFBGas(msg, appconsts.DefaultGasPerBlobByte)
 
 	ctx.GasMeter().ConsumeGas(gasToConsume, payForBlobGasDescriptor)
 
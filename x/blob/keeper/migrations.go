@@ -29,3 +29,22 @@ func (m *Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.SetParams(ctx, params)
 	return nil
 }
+
+// MigrateGasSchedule handles the migration of the blob module parameters to the
+// gas schedule with a base cost per PFB, an overhead per blob, a max blob size
+// and namespace gas multipliers. The new parameters are set to their defaults
+// which keep the gas charged per PFB unchanged.
+func (m *Migrator) MigrateGasSchedule(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.PfbBaseGas = blobtypes.DefaultPFBBaseGas
+	params.GasPerBlob = blobtypes.DefaultGasPerBlob
+	params.MaxBlobSize = blobtypes.DefaultMaxBlobSize
+	params.NamespaceGasMultipliers = nil
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
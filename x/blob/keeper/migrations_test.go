@@ -31,3 +31,18 @@ func TestMigrateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMigrateGasSchedule(t *testing.T) {
+	k, _, ctx := CreateKeeper(t, appconsts.LatestVersion)
+	migrator := keeper.NewMigrator(*k)
+	require.NoError(t, migrator.MigrateParams(ctx))
+
+	params := k.GetParams(ctx)
+	params.GovMaxSquareSize = 128
+	k.SetParams(ctx, params)
+
+	require.NoError(t, migrator.MigrateGasSchedule(ctx))
+	want := blobtypes.DefaultParams()
+	want.GovMaxSquareSize = 128
+	require.Equal(t, want, k.GetParams(ctx))
+}
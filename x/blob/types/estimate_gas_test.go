@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	sdkmath "cosmossdk.io/math"
 	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/stretchr/testify/require"
 
@@ -122,3 +123,24 @@ func randBlobSize(seed int64, numBlobs, maxBlobSize int) []int {
 	}
 	return res
 }
+
+func TestEstimateGasWithParams(t *testing.T) {
+	rnd := random.New()
+	blobs := blobfactory.ManyRandBlobs(rnd, 100, 2000)
+	blobSizes := []uint32{100, 2000}
+
+	// the default params charge the blobs like DefaultEstimateGas
+	gas := blobtypes.EstimateGasWithParams(blobtypes.DefaultParams(), blobs, appconsts.DefaultTxSizeCostPerByte)
+	require.Equal(t, blobtypes.DefaultEstimateGas(blobSizes), gas)
+
+	params := blobtypes.DefaultParams()
+	params.PfbBaseGas = 1000
+	params.GasPerBlob = 500
+	params.NamespaceGasMultipliers = []blobtypes.NamespaceGasMultiplier{
+		{Namespace: blobs[1].Namespace().Bytes(), Multiplier: sdkmath.LegacyNewDec(2)},
+	}
+	first := 500 + blobtypes.GasToConsume(blobSizes[:1], appconsts.DefaultGasPerBlobByte)
+	second := 2 * (500 + blobtypes.GasToConsume(blobSizes[1:], appconsts.DefaultGasPerBlobByte))
+	want := blobtypes.DefaultEstimateGas(blobSizes) - blobtypes.GasToConsume(blobSizes, appconsts.DefaultGasPerBlobByte) + 1000 + first + second
+	require.Equal(t, want, blobtypes.EstimateGasWithParams(params, blobs, appconsts.DefaultTxSizeCostPerByte))
+}
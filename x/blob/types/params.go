@@ -1,12 +1,16 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
 
 	"github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
 
 	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
 )
@@ -18,8 +22,18 @@ var (
 	DefaultGasPerBlobByte   uint32 = appconsts.DefaultGasPerBlobByte
 	KeyGovMaxSquareSize            = []byte("GovMaxSquareSize")
 	DefaultGovMaxSquareSize uint64 = appconsts.DefaultGovMaxSquareSize
+	DefaultPFBBaseGas       uint64 = 0
+	DefaultGasPerBlob       uint64 = 0
+	DefaultMaxBlobSize      uint32 = 0
 )
 
+// MaxFixedGas bounds PfbBaseGas and GasPerBlob.
+const MaxFixedGas uint64 = 100_000_000
+
+// MaxNamespaceGasMultiplier is the max multiplier of the gas consumed for the
+// blobs of a namespace.
+var MaxNamespaceGasMultiplier = sdkmath.LegacyNewDec(1000)
+
 // ParamKeyTable returns the param key table for the blob module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
@@ -35,10 +49,15 @@ func NewParams(gasPerBlobByte uint32, govMaxSquareSize uint64) Params {
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultGasPerBlobByte, appconsts.DefaultGovMaxSquareSize)
+	params := NewParams(DefaultGasPerBlobByte, appconsts.DefaultGovMaxSquareSize)
+	params.PfbBaseGas = DefaultPFBBaseGas
+	params.GasPerBlob = DefaultGasPerBlob
+	params.MaxBlobSize = DefaultMaxBlobSize
+	return params
 }
 
-// ParamSetPairs gets the list of param key-value pairs
+// ParamSetPairs gets the list of param key-value pairs. The params added after
+// the migration away from the legacy subspace aren't part of it.
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyGasPerBlobByte, &p.GasPerBlobByte, validateGasPerBlobByte),
@@ -52,7 +71,59 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
-	return validateGovMaxSquareSize(p.GovMaxSquareSize)
+	err = validateGovMaxSquareSize(p.GovMaxSquareSize)
+	if err != nil {
+		return err
+	}
+	if p.PfbBaseGas > MaxFixedGas {
+		return fmt.Errorf("pfb base gas %d exceeds max %d", p.PfbBaseGas, MaxFixedGas)
+	}
+	if p.GasPerBlob > MaxFixedGas {
+		return fmt.Errorf("gas per blob %d exceeds max %d", p.GasPerBlob, MaxFixedGas)
+	}
+	return validateNamespaceGasMultipliers(p.NamespaceGasMultipliers)
+}
+
+// PFBGas works out the gas charged to pay for the blobs of msg: the base cost
+// of a PFB plus, for every blob, the blob overhead and the gas of its shares,
+// scaled by the multiplier of its namespace.
+func (p Params) PFBGas(msg *MsgPayForBlobs, gasPerByte uint32) uint64 {
+	gas := p.PfbBaseGas
+	for i, size := range msg.BlobSizes {
+		blobGas := p.GasPerBlob + GasToConsume([]uint32{size}, gasPerByte)
+		if i < len(msg.Namespaces) {
+			if multiplier, ok := p.NamespaceGasMultiplier(msg.Namespaces[i]); ok {
+				blobGas = multiplier.MulInt(sdkmath.NewIntFromUint64(blobGas)).Ceil().TruncateInt().Uint64()
+			}
+		}
+		gas += blobGas
+	}
+	return gas
+}
+
+// ValidateBlobSizes returns an error if one of the blob sizes exceeds
+// MaxBlobSize.
+func (p Params) ValidateBlobSizes(blobSizes []uint32) error {
+	if p.MaxBlobSize == 0 {
+		return nil
+	}
+	for _, size := range blobSizes {
+		if size > p.MaxBlobSize {
+			return errors.Wrapf(ErrBlobsTooLarge, "blob size %d exceeds max blob size %d", size, p.MaxBlobSize)
+		}
+	}
+	return nil
+}
+
+// NamespaceGasMultiplier returns the gas multiplier of the namespace if it
+// has one.
+func (p Params) NamespaceGasMultiplier(namespace []byte) (sdkmath.LegacyDec, bool) {
+	for _, m := range p.NamespaceGasMultipliers {
+		if bytes.Equal(m.Namespace, namespace) {
+			return m.Multiplier, true
+		}
+	}
+	return sdkmath.LegacyDec{}, false
 }
 
 // String implements the Stringer interface.
@@ -95,3 +166,29 @@ func validateGovMaxSquareSize(v interface{}) error {
 
 	return nil
 }
+
+// validateNamespaceGasMultipliers validates the NamespaceGasMultipliers param
+func validateNamespaceGasMultipliers(multipliers []NamespaceGasMultiplier) error {
+	seen := make(map[string]bool, len(multipliers))
+	for _, m := range multipliers {
+		namespace, err := share.NewNamespaceFromBytes(m.Namespace)
+		if err != nil {
+			return fmt.Errorf("invalid namespace %X: %w", m.Namespace, err)
+		}
+		if err := ValidateBlobNamespace(namespace); err != nil {
+			return fmt.Errorf("invalid namespace %X: %w", m.Namespace, err)
+		}
+		if seen[string(m.Namespace)] {
+			return fmt.Errorf("duplicate gas multiplier for namespace %X", m.Namespace)
+		}
+		seen[string(m.Namespace)] = true
+
+		if m.Multiplier.IsNil() || !m.Multiplier.IsPositive() {
+			return fmt.Errorf("gas multiplier of namespace %X must be positive", m.Namespace)
+		}
+		if m.Multiplier.GT(MaxNamespaceGasMultiplier) {
+			return fmt.Errorf("gas multiplier %s of namespace %X exceeds max %s", m.Multiplier, m.Namespace, MaxNamespaceGasMultiplier)
+		}
+	}
+	return nil
+}
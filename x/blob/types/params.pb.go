@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -27,6 +29,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	GasPerBlobByte   uint32 `protobuf:"varint,1,opt,name=gas_per_blob_byte,json=gasPerBlobByte,proto3" json:"gas_per_blob_byte,omitempty" yaml:"gas_per_blob_byte"`
 	GovMaxSquareSize uint64 `protobuf:"varint,2,opt,name=gov_max_square_size,json=govMaxSquareSize,proto3" json:"gov_max_square_size,omitempty" yaml:"gov_max_square_size"`
+	// pfb_base_gas is the gas consumed by every MsgPayForBlobs regardless of the
+	// number and size of its blobs.
+	PfbBaseGas uint64 `protobuf:"varint,3,opt,name=pfb_base_gas,json=pfbBaseGas,proto3" json:"pfb_base_gas,omitempty" yaml:"pfb_base_gas"`
+	// gas_per_blob is the gas consumed for every blob of a MsgPayForBlobs in
+	// addition to the gas consumed for its bytes.
+	GasPerBlob uint64 `protobuf:"varint,4,opt,name=gas_per_blob,json=gasPerBlob,proto3" json:"gas_per_blob,omitempty" yaml:"gas_per_blob"`
+	// max_blob_size is the max size in bytes of a single blob. Zero means that
+	// blobs are only bounded by the max square size.
+	MaxBlobSize uint32 `protobuf:"varint,5,opt,name=max_blob_size,json=maxBlobSize,proto3" json:"max_blob_size,omitempty" yaml:"max_blob_size"`
+	// namespace_gas_multipliers scale the gas consumed for the blobs published
+	// to specific namespaces.
+	NamespaceGasMultipliers []NamespaceGasMultiplier `protobuf:"bytes,6,rep,name=namespace_gas_multipliers,json=namespaceGasMultipliers,proto3" json:"namespace_gas_multipliers" yaml:"namespace_gas_multipliers"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -75,31 +89,120 @@ func (m *Params) GetGovMaxSquareSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetPfbBaseGas() uint64 {
+	if m != nil {
+		return m.PfbBaseGas
+	}
+	return 0
+}
+
+func (m *Params) GetGasPerBlob() uint64 {
+	if m != nil {
+		return m.GasPerBlob
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlobSize() uint32 {
+	if m != nil {
+		return m.MaxBlobSize
+	}
+	return 0
+}
+
+func (m *Params) GetNamespaceGasMultipliers() []NamespaceGasMultiplier {
+	if m != nil {
+		return m.NamespaceGasMultipliers
+	}
+	return nil
+}
+
+// NamespaceGasMultiplier scales the gas consumed for the blobs of a namespace.
+type NamespaceGasMultiplier struct {
+	Namespace  []byte                      `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *NamespaceGasMultiplier) Reset()         { *m = NamespaceGasMultiplier{} }
+func (m *NamespaceGasMultiplier) String() string { return proto.CompactTextString(m) }
+func (*NamespaceGasMultiplier) ProtoMessage()    {}
+func (*NamespaceGasMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2145b82d3e5371c6, []int{1}
+}
+func (m *NamespaceGasMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespaceGasMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NamespaceGasMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NamespaceGasMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceGasMultiplier.Merge(m, src)
+}
+func (m *NamespaceGasMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespaceGasMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceGasMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceGasMultiplier proto.InternalMessageInfo
+
+func (m *NamespaceGasMultiplier) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.blob.v1.Params")
+	proto.RegisterType((*NamespaceGasMultiplier)(nil), "celestia.blob.v1.NamespaceGasMultiplier")
 }
 
 func init() { proto.RegisterFile("celestia/blob/v1/params.proto", fileDescriptor_2145b82d3e5371c6) }
 
 var fileDescriptor_2145b82d3e5371c6 = []byte{
-	// 270 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0x4f, 0xca, 0xc9, 0x4f, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c,
-	0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0x49, 0xeb, 0x81, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d,
-	0xd2, 0x32, 0x46, 0x2e, 0xb6, 0x00, 0xb0, 0x46, 0x21, 0x77, 0x2e, 0xc1, 0xf4, 0xc4, 0xe2, 0xf8,
-	0x82, 0xd4, 0xa2, 0x78, 0x90, 0x9e, 0xf8, 0xa4, 0xca, 0x92, 0x54, 0x09, 0x46, 0x05, 0x46, 0x0d,
-	0x5e, 0x27, 0x99, 0x4f, 0xf7, 0xe4, 0x25, 0x2a, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0x94, 0x28,
-	0x05, 0xf1, 0xa5, 0x27, 0x16, 0x07, 0xa4, 0x16, 0x39, 0xe5, 0xe4, 0x27, 0x39, 0x55, 0x96, 0xa4,
-	0x0a, 0xf9, 0x72, 0x09, 0xa7, 0xe7, 0x97, 0xc5, 0xe7, 0x26, 0x56, 0xc4, 0x17, 0x17, 0x96, 0x26,
-	0x16, 0xa5, 0xc6, 0x17, 0x67, 0x56, 0xa5, 0x4a, 0x30, 0x29, 0x30, 0x6a, 0xb0, 0x38, 0xc9, 0x7d,
-	0xba, 0x27, 0x2f, 0x05, 0x35, 0x0a, 0x53, 0x91, 0x52, 0x90, 0x40, 0x7a, 0x7e, 0x99, 0x6f, 0x62,
-	0x45, 0x30, 0x58, 0x2c, 0x38, 0xb3, 0x2a, 0xd5, 0x8a, 0x65, 0xc6, 0x02, 0x79, 0x06, 0x27, 0xaf,
-	0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39,
-	0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf, 0x2c, 0xc9, 0x28,
-	0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xf9, 0x3a, 0xbf, 0x28, 0x1d, 0xce, 0xd6, 0x4d, 0x2c,
-	0x28, 0xd0, 0xaf, 0x80, 0x04, 0x53, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0xef, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8e, 0x2d, 0x7d, 0x23, 0x44, 0x01, 0x00, 0x00,
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xbb, 0x6e, 0xd4, 0x40,
+	0x14, 0xb5, 0xd9, 0x65, 0xa5, 0x4c, 0x12, 0x14, 0x9c, 0x88, 0x38, 0x4b, 0xb0, 0x57, 0xae, 0xdc,
+	0xc4, 0x66, 0xa1, 0x22, 0xa2, 0xb2, 0x22, 0xad, 0x84, 0x58, 0x14, 0x9c, 0x8e, 0x66, 0x74, 0x6d,
+	0x26, 0x13, 0x0b, 0x3b, 0x33, 0x78, 0xbc, 0xab, 0x75, 0xfe, 0x80, 0x06, 0x51, 0x52, 0xf2, 0x11,
+	0x7c, 0x44, 0xca, 0x88, 0x0a, 0x51, 0x58, 0x68, 0xb7, 0xa4, 0xf3, 0x17, 0x20, 0xcf, 0x64, 0x1f,
+	0x24, 0xa1, 0x1b, 0xdf, 0x73, 0xcf, 0xb9, 0xe7, 0x3e, 0x8c, 0x9e, 0xc4, 0x24, 0x25, 0xa2, 0x48,
+	0xc0, 0x8f, 0x52, 0x16, 0xf9, 0xe3, 0xbe, 0xcf, 0x21, 0x87, 0x4c, 0x78, 0x3c, 0x67, 0x05, 0x33,
+	0xb6, 0xe6, 0xb0, 0xd7, 0xc0, 0xde, 0xb8, 0xdf, 0xdd, 0xa1, 0x8c, 0x32, 0x09, 0xfa, 0xcd, 0x4b,
+	0xe5, 0x75, 0xf7, 0x62, 0x26, 0x32, 0x26, 0xb0, 0x02, 0xd4, 0x87, 0x82, 0x9c, 0x3f, 0x2d, 0xd4,
+	0x39, 0x96, 0x9a, 0xc6, 0x00, 0x3d, 0xa4, 0x20, 0x30, 0x27, 0x39, 0x6e, 0xe4, 0x70, 0x54, 0x16,
+	0xc4, 0xd4, 0x7b, 0xba, 0xbb, 0x19, 0xec, 0xd7, 0x95, 0x6d, 0x96, 0x90, 0xa5, 0x87, 0xce, 0xad,
+	0x14, 0x27, 0x7c, 0x40, 0x41, 0x1c, 0x93, 0x3c, 0x48, 0x59, 0x14, 0x94, 0x05, 0x31, 0x86, 0x68,
+	0x9b, 0xb2, 0x31, 0xce, 0x60, 0x82, 0xc5, 0xc7, 0x11, 0xe4, 0x04, 0x8b, 0xe4, 0x82, 0x98, 0xf7,
+	0x7a, 0xba, 0xdb, 0x0e, 0xac, 0xba, 0xb2, 0xbb, 0xd7, 0x52, 0xb7, 0x93, 0x9c, 0x70, 0x8b, 0xb2,
+	0xf1, 0x10, 0x26, 0x27, 0x32, 0x76, 0x92, 0x5c, 0x10, 0xe3, 0x05, 0xda, 0xe0, 0xa7, 0x11, 0x8e,
+	0x40, 0x10, 0x4c, 0x41, 0x98, 0x2d, 0xa9, 0xb3, 0x5b, 0x57, 0xf6, 0xb6, 0xd2, 0x59, 0x45, 0x9d,
+	0x10, 0xf1, 0xd3, 0x28, 0x00, 0x41, 0x06, 0x20, 0x1a, 0xea, 0xaa, 0x5f, 0xb3, 0x7d, 0x93, 0xba,
+	0x8a, 0x3a, 0x21, 0x5a, 0x36, 0x62, 0xbc, 0x44, 0x9b, 0x8d, 0x37, 0xd9, 0xa6, 0xb4, 0x7f, 0x5f,
+	0x4e, 0xc2, 0xac, 0x2b, 0x7b, 0x47, 0x71, 0xff, 0x81, 0x9d, 0x70, 0x3d, 0x83, 0x49, 0xc3, 0x94,
+	0x9e, 0x3f, 0xeb, 0x68, 0xef, 0x1c, 0x32, 0x22, 0x38, 0xc4, 0xd2, 0x17, 0xce, 0x46, 0x69, 0x91,
+	0xf0, 0x34, 0x21, 0xb9, 0x30, 0x3b, 0xbd, 0x96, 0xbb, 0xfe, 0xcc, 0xf5, 0x6e, 0xae, 0xcf, 0x7b,
+	0x33, 0xa7, 0x0c, 0x40, 0x0c, 0x17, 0x84, 0xc0, 0xbd, 0xac, 0x6c, 0xad, 0xae, 0xec, 0x9e, 0x2a,
+	0xfc, 0x5f, 0x61, 0x27, 0xdc, 0x3d, 0xbf, 0x53, 0x41, 0x1c, 0xb6, 0xbf, 0x7e, 0xb3, 0x35, 0xe7,
+	0x93, 0x8e, 0x1e, 0xdd, 0x5d, 0xc3, 0xd8, 0x47, 0x6b, 0x0b, 0xae, 0xdc, 0xfa, 0x46, 0xb8, 0x0c,
+	0x18, 0x6f, 0x11, 0x5a, 0xd6, 0x91, 0x9b, 0x5c, 0x0b, 0xfa, 0x8d, 0xab, 0x5f, 0x95, 0xfd, 0x58,
+	0x1d, 0x94, 0x78, 0xff, 0xc1, 0x4b, 0x98, 0x9f, 0x41, 0x71, 0xe6, 0xbd, 0x26, 0x14, 0xe2, 0xf2,
+	0x88, 0xc4, 0x3f, 0xbe, 0x1f, 0xa0, 0xeb, 0x7b, 0x3b, 0x22, 0x71, 0xb8, 0x22, 0x12, 0xbc, 0xba,
+	0x9c, 0x5a, 0xfa, 0xd5, 0xd4, 0xd2, 0x7f, 0x4f, 0x2d, 0xfd, 0xcb, 0xcc, 0xd2, 0xae, 0x66, 0x96,
+	0xf6, 0x73, 0x66, 0x69, 0xef, 0x9e, 0xd2, 0xa4, 0x38, 0x1b, 0x45, 0x5e, 0xcc, 0x32, 0x7f, 0x3e,
+	0x22, 0x96, 0xd3, 0xc5, 0xfb, 0x00, 0x38, 0xf7, 0x27, 0xea, 0x97, 0x28, 0x4a, 0x4e, 0x44, 0xd4,
+	0x91, 0xc7, 0xfc, 0xfc, 0xef, 0x00, 0x58, 0x3b, 0x1d, 0xba, 0x30, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -122,6 +225,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.NamespaceGasMultipliers) > 0 {
+		for iNdEx := len(m.NamespaceGasMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NamespaceGasMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxBlobSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBlobSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasPerBlob != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GasPerBlob))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PfbBaseGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PfbBaseGas))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.GovMaxSquareSize != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GovMaxSquareSize))
 		i--
@@ -135,6 +267,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespaceGasMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespaceGasMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespaceGasMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -158,6 +330,36 @@ func (m *Params) Size() (n int) {
 	if m.GovMaxSquareSize != 0 {
 		n += 1 + sovParams(uint64(m.GovMaxSquareSize))
 	}
+	if m.PfbBaseGas != 0 {
+		n += 1 + sovParams(uint64(m.PfbBaseGas))
+	}
+	if m.GasPerBlob != 0 {
+		n += 1 + sovParams(uint64(m.GasPerBlob))
+	}
+	if m.MaxBlobSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBlobSize))
+	}
+	if len(m.NamespaceGasMultipliers) > 0 {
+		for _, e := range m.NamespaceGasMultipliers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NamespaceGasMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -234,6 +436,215 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PfbBaseGas", wireType)
+			}
+			m.PfbBaseGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PfbBaseGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerBlob", wireType)
+			}
+			m.GasPerBlob = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerBlob |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlobSize", wireType)
+			}
+			m.MaxBlobSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlobSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceGasMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NamespaceGasMultipliers = append(m.NamespaceGasMultipliers, NamespaceGasMultiplier{})
+			if err := m.NamespaceGasMultipliers[len(m.NamespaceGasMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NamespaceGasMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NamespaceGasMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NamespaceGasMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
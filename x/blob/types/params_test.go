@@ -1,10 +1,15 @@
 package types
 
 import (
+	"bytes"
+	"math"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/celestiaorg/go-square/v2/share"
+
 	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
 )
 
@@ -43,3 +48,101 @@ func Test_validateGovMaxSquareSize(t *testing.T) {
 		}
 	}
 }
+
+func TestParamsValidate(t *testing.T) {
+	namespace := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	withMultipliers := func(multipliers ...NamespaceGasMultiplier) Params {
+		params := DefaultParams()
+		params.NamespaceGasMultipliers = multipliers
+		return params
+	}
+	tests := []struct {
+		name      string
+		params    Params
+		expectErr bool
+	}{
+		{
+			name:   "default",
+			params: DefaultParams(),
+		},
+		{
+			name:   "valid multiplier",
+			params: withMultipliers(NamespaceGasMultiplier{Namespace: namespace.Bytes(), Multiplier: sdkmath.LegacyNewDecWithPrec(15, 1)}),
+		},
+		{
+			name:      "base gas too high",
+			params:    Params{GasPerBlobByte: DefaultGasPerBlobByte, GovMaxSquareSize: DefaultGovMaxSquareSize, PfbBaseGas: MaxFixedGas + 1},
+			expectErr: true,
+		},
+		{
+			name:      "gas per blob too high",
+			params:    Params{GasPerBlobByte: DefaultGasPerBlobByte, GovMaxSquareSize: DefaultGovMaxSquareSize, GasPerBlob: MaxFixedGas + 1},
+			expectErr: true,
+		},
+		{
+			name:      "reserved namespace",
+			params:    withMultipliers(NamespaceGasMultiplier{Namespace: share.TxNamespace.Bytes(), Multiplier: sdkmath.LegacyOneDec()}),
+			expectErr: true,
+		},
+		{
+			name: "duplicate namespace",
+			params: withMultipliers(
+				NamespaceGasMultiplier{Namespace: namespace.Bytes(), Multiplier: sdkmath.LegacyOneDec()},
+				NamespaceGasMultiplier{Namespace: namespace.Bytes(), Multiplier: sdkmath.LegacyNewDec(2)},
+			),
+			expectErr: true,
+		},
+		{
+			name:      "zero multiplier",
+			params:    withMultipliers(NamespaceGasMultiplier{Namespace: namespace.Bytes(), Multiplier: sdkmath.LegacyZeroDec()}),
+			expectErr: true,
+		},
+		{
+			name:      "multiplier too high",
+			params:    withMultipliers(NamespaceGasMultiplier{Namespace: namespace.Bytes(), Multiplier: MaxNamespaceGasMultiplier.Add(sdkmath.LegacyOneDec())}),
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.params.Validate()
+			if tt.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPFBGas(t *testing.T) {
+	ns1 := share.MustNewV0Namespace(bytes.Repeat([]byte{1}, share.NamespaceVersionZeroIDSize))
+	ns2 := share.MustNewV0Namespace(bytes.Repeat([]byte{2}, share.NamespaceVersionZeroIDSize))
+	gasPerByte := appconsts.DefaultGasPerBlobByte
+	shareGas := uint64(share.ShareSize * gasPerByte)
+	msg := &MsgPayForBlobs{
+		Namespaces: [][]byte{ns1.Bytes(), ns2.Bytes()},
+		BlobSizes:  []uint32{1, 1024}, // 1 and 3 shares
+	}
+
+	// the default schedule is linear in the number of shares
+	params := DefaultParams()
+	assert.Equal(t, 4*shareGas, params.PFBGas(msg, gasPerByte))
+	assert.Equal(t, GasToConsume(msg.BlobSizes, gasPerByte), params.PFBGas(msg, gasPerByte))
+
+	params.PfbBaseGas = 1000
+	params.GasPerBlob = 100
+	assert.Equal(t, 1000+2*100+4*shareGas, params.PFBGas(msg, gasPerByte))
+
+	params.NamespaceGasMultipliers = []NamespaceGasMultiplier{{Namespace: ns2.Bytes(), Multiplier: sdkmath.LegacyNewDecWithPrec(25, 1)}}
+	assert.Equal(t, 1000+(100+shareGas)+(100+3*shareGas)*5/2, params.PFBGas(msg, gasPerByte))
+}
+
+func TestValidateBlobSizes(t *testing.T) {
+	params := DefaultParams()
+	assert.NoError(t, params.ValidateBlobSizes([]uint32{math.MaxUint32}))
+
+	params.MaxBlobSize = 1000
+	assert.NoError(t, params.ValidateBlobSizes([]uint32{1, 1000}))
+	assert.ErrorIs(t, params.ValidateBlobSizes([]uint32{1, 1001}), ErrBlobsTooLarge)
+}
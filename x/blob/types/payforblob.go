@@ -161,11 +161,29 @@ func EstimateGas(blobSizes []uint32, gasPerByte uint32, txSizeCost uint64) uint6
 	return GasToConsume(blobSizes, gasPerByte) + (txSizeCost * BytesPerBlobInfo * uint64(len(blobSizes))) + PFBGasFixedCost
 }
 
-// DefaultEstimateGas runs EstimateGas with the system defaults.
+// DefaultEstimateGas runs EstimateGas with the system defaults. It doesn't
+// account for the blob params beyond the gas per blob byte, see
+// EstimateGasWithParams.
 func DefaultEstimateGas(blobSizes []uint32) uint64 {
 	return EstimateGas(blobSizes, appconsts.DefaultGasPerBlobByte, appconsts.DefaultTxSizeCostPerByte)
 }
 
+// EstimateGasWithParams estimates the total gas required to pay for blobs in a
+// PFB like EstimateGas but charges the blobs the way params does: with the
+// base cost of a PFB, the overhead of every blob and the gas multipliers of
+// their namespaces.
+func EstimateGasWithParams(params Params, blobs []*share.Blob, txSizeCost uint64) uint64 {
+	msg := &MsgPayForBlobs{
+		Namespaces: make([][]byte, len(blobs)),
+		BlobSizes:  make([]uint32, len(blobs)),
+	}
+	for i, blob := range blobs {
+		msg.Namespaces[i] = blob.Namespace().Bytes()
+		msg.BlobSizes[i] = uint32(len(blob.Data()))
+	}
+	return params.PFBGas(msg, appconsts.DefaultGasPerBlobByte) + (txSizeCost * BytesPerBlobInfo * uint64(len(blobs))) + PFBGasFixedCost
+}
+
 // ValidateBlobNamespace returns an error if the provided namespace is an
 // invalid user-specifiable blob namespace (e.g. reserved, parity shares, or
 // tail padding).
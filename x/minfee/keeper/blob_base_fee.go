@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/celestia-app/v4/x/minfee/types"
+)
+
+// GetBlobBaseFee returns the blob base fee of the transactions of the current
+// block. It defaults to the min blob base fee until the first adjustment.
+func (k Keeper) GetBlobBaseFee(ctx sdk.Context) math.LegacyDec {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.BlobBaseFeeKey))
+	if len(bz) == 0 {
+		return k.GetParams(ctx).MinBlobBaseFee
+	}
+	return mustUnmarshalDec(bz)
+}
+
+// SetBlobBaseFee sets the blob base fee.
+func (k Keeper) SetBlobBaseFee(ctx sdk.Context, baseFee math.LegacyDec) {
+	k.setDec(ctx, types.BlobBaseFeeKey, baseFee)
+}
+
+// GetSquareUtilization returns the utilization of the square of the last
+// block recorded with SetSquareUtilization.
+func (k Keeper) GetSquareUtilization(ctx sdk.Context) math.LegacyDec {
+	bz := ctx.KVStore(k.storeKey).Get([]byte(types.SquareUtilizationKey))
+	if len(bz) == 0 {
+		return math.LegacyZeroDec()
+	}
+	return mustUnmarshalDec(bz)
+}
+
+// SetSquareUtilization records the fraction of the max square used by the
+// block being finalized. It is a no-op if the dynamic blob base fee is
+// disabled.
+func (k Keeper) SetSquareUtilization(ctx sdk.Context, utilization math.LegacyDec) {
+	if !k.GetParams(ctx).DynamicBlobBaseFee {
+		return
+	}
+	k.setDec(ctx, types.SquareUtilizationKey, utilization)
+}
+
+// UpdateBlobBaseFee adjusts the blob base fee for the next block based on the
+// square utilization of the block being finalized. If the dynamic blob base
+// fee is disabled, the state is cleared so that the fee starts again from its
+// lower bound once it is enabled.
+func (k Keeper) UpdateBlobBaseFee(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.DynamicBlobBaseFee {
+		store := ctx.KVStore(k.storeKey)
+		for _, key := range []string{types.BlobBaseFeeKey, types.SquareUtilizationKey} {
+			if store.Has([]byte(key)) {
+				store.Delete([]byte(key))
+			}
+		}
+		return
+	}
+	k.SetBlobBaseFee(ctx, params.NextBlobBaseFee(k.GetBlobBaseFee(ctx), k.GetSquareUtilization(ctx)))
+}
+
+// setDec stores d under key unless it is already stored there so that the
+// values that don't change from one block to the next aren't written again.
+func (k Keeper) setDec(ctx sdk.Context, key string, d math.LegacyDec) {
+	store := ctx.KVStore(k.storeKey)
+	bz := mustMarshalDec(d)
+	if bytes.Equal(store.Get([]byte(key)), bz) {
+		return
+	}
+	store.Set([]byte(key), bz)
+}
+
+func mustMarshalDec(d math.LegacyDec) []byte {
+	bz, err := d.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func mustUnmarshalDec(bz []byte) math.LegacyDec {
+	var d math.LegacyDec
+	if err := d.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return d
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v4/app"
+	testutil "github.com/celestiaorg/celestia-app/v4/test/util"
+	"github.com/celestiaorg/celestia-app/v4/x/minfee/types"
+)
+
+func TestNextBlobBaseFee(t *testing.T) {
+	params := types.DefaultParams()
+	params.DynamicBlobBaseFee = true
+	params.MinBlobBaseFee = sdkmath.LegacyMustNewDecFromStr("0.1")
+	params.MaxBlobBaseFee = sdkmath.LegacyNewDec(10)
+	baseFee := sdkmath.LegacyOneDec()
+
+	tests := []struct {
+		name        string
+		baseFee     sdkmath.LegacyDec
+		utilization sdkmath.LegacyDec
+		want        sdkmath.LegacyDec
+	}{
+		{"target utilization", baseFee, sdkmath.LegacyMustNewDecFromStr("0.5"), baseFee},
+		{"full square", baseFee, sdkmath.LegacyOneDec(), sdkmath.LegacyMustNewDecFromStr("1.125")},
+		{"empty square", baseFee, sdkmath.LegacyZeroDec(), sdkmath.LegacyMustNewDecFromStr("0.875")},
+		{"half way to a full square", baseFee, sdkmath.LegacyMustNewDecFromStr("0.75"), sdkmath.LegacyMustNewDecFromStr("1.0625")},
+		{"upper bound", sdkmath.LegacyNewDec(10), sdkmath.LegacyOneDec(), sdkmath.LegacyNewDec(10)},
+		{"lower bound", sdkmath.LegacyMustNewDecFromStr("0.1"), sdkmath.LegacyZeroDec(), sdkmath.LegacyMustNewDecFromStr("0.1")},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, params.NextBlobBaseFee(tc.baseFee, tc.utilization))
+		})
+	}
+}
+
+func TestUpdateBlobBaseFee(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	k := testApp.MinFeeKeeper
+	ctx := testApp.NewContext(false)
+
+	// the utilization is not recorded if the dynamic blob base fee is disabled
+	k.SetSquareUtilization(ctx, sdkmath.LegacyOneDec())
+	k.UpdateBlobBaseFee(ctx)
+	resp, err := k.BlobBaseFee(ctx, &types.QueryBlobBaseFeeRequest{})
+	require.NoError(t, err)
+	require.False(t, resp.Enabled)
+	require.Equal(t, types.DefaultMinBlobBaseFee, resp.BlobBaseFee)
+	require.True(t, resp.SquareUtilization.IsZero())
+
+	params := k.GetParams(ctx)
+	params.DynamicBlobBaseFee = true
+	k.SetParams(ctx, params)
+
+	// the fee rises after a full square and starts from its lower bound
+	k.SetSquareUtilization(ctx, sdkmath.LegacyOneDec())
+	k.UpdateBlobBaseFee(ctx)
+	resp, err = k.BlobBaseFee(ctx, &types.QueryBlobBaseFeeRequest{})
+	require.NoError(t, err)
+	require.True(t, resp.Enabled)
+	require.Equal(t, params.NextBlobBaseFee(params.MinBlobBaseFee, sdkmath.LegacyOneDec()), resp.BlobBaseFee)
+	require.True(t, resp.BlobBaseFee.GT(params.MinBlobBaseFee))
+	require.Equal(t, sdkmath.LegacyOneDec(), resp.SquareUtilization)
+
+	// disabling the dynamic blob base fee resets it
+	params.DynamicBlobBaseFee = false
+	k.SetParams(ctx, params)
+	k.UpdateBlobBaseFee(ctx)
+	require.Equal(t, params.MinBlobBaseFee, k.GetBlobBaseFee(ctx))
+}
+
+func TestValidateBlobBaseFeeParams(t *testing.T) {
+	valid := types.DefaultParams()
+	valid.DynamicBlobBaseFee = true
+	require.NoError(t, valid.Validate())
+
+	// the blob base fee params are not validated if it is disabled
+	require.NoError(t, types.Params{NetworkMinGasPrice: types.DefaultNetworkMinGasPrice}.Validate())
+	require.Error(t, types.Params{NetworkMinGasPrice: types.DefaultNetworkMinGasPrice, DynamicBlobBaseFee: true}.Validate())
+
+	for name, modify := range map[string]func(*types.Params){
+		"zero min":            func(p *types.Params) { p.MinBlobBaseFee = sdkmath.LegacyZeroDec() },
+		"max lower than min":  func(p *types.Params) { p.MaxBlobBaseFee = p.MinBlobBaseFee.QuoInt64(2) },
+		"zero change rate":    func(p *types.Params) { p.BlobBaseFeeMaxChangeRate = sdkmath.LegacyZeroDec() },
+		"change rate above 1": func(p *types.Params) { p.BlobBaseFeeMaxChangeRate = sdkmath.LegacyMustNewDecFromStr("1.01") },
+		"zero target":         func(p *types.Params) { p.TargetSquareUtilization = sdkmath.LegacyZeroDec() },
+		"full square target":  func(p *types.Params) { p.TargetSquareUtilization = sdkmath.LegacyOneDec() },
+	} {
+		t.Run(name, func(t *testing.T) {
+			params := valid
+			modify(&params)
+			require.Error(t, params.Validate())
+		})
+	}
+}
+
+func TestBlobBaseFeeGenesis(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	k := testApp.MinFeeKeeper
+	ctx := testApp.NewContext(false)
+
+	params := k.GetParams(ctx)
+	params.DynamicBlobBaseFee = true
+	k.SetParams(ctx, params)
+	k.SetSquareUtilization(ctx, sdkmath.LegacyOneDec())
+	k.UpdateBlobBaseFee(ctx)
+
+	genesis := k.ExportGenesis(ctx)
+	require.NoError(t, types.ValidateGenesis(genesis))
+	require.Equal(t, k.GetBlobBaseFee(ctx), genesis.BlobBaseFee)
+	require.Equal(t, sdkmath.LegacyOneDec(), genesis.SquareUtilization)
+
+	// the blob base fee state survives an export and an import
+	importApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	importCtx := importApp.NewContext(false)
+	require.NoError(t, importApp.MinFeeKeeper.InitGenesis(importCtx, *genesis))
+	require.Equal(t, genesis.BlobBaseFee, importApp.MinFeeKeeper.GetBlobBaseFee(importCtx))
+	require.Equal(t, genesis.SquareUtilization, importApp.MinFeeKeeper.GetSquareUtilization(importCtx))
+}
@@ -18,6 +18,16 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	}
 
 	k.SetParams(sdkCtx, genState.Params)
+	// the blob base fee state is only kept while the dynamic blob base fee is
+	// enabled
+	if genState.Params.DynamicBlobBaseFee {
+		if !genState.BlobBaseFee.IsNil() && genState.BlobBaseFee.IsPositive() {
+			k.SetBlobBaseFee(sdkCtx, genState.BlobBaseFee)
+		}
+		if !genState.SquareUtilization.IsNil() && genState.SquareUtilization.IsPositive() {
+			k.SetSquareUtilization(sdkCtx, genState.SquareUtilization)
+		}
+	}
 	return nil
 }
 
@@ -27,5 +37,10 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	// TODO: genesis should hold params not this field.
 	genesis.NetworkMinGasPrice = k.GetParams(sdkCtx).NetworkMinGasPrice
+	genesis.Params = k.GetParams(sdkCtx)
+	if genesis.Params.DynamicBlobBaseFee {
+		genesis.BlobBaseFee = k.GetBlobBaseFee(sdkCtx)
+		genesis.SquareUtilization = k.GetSquareUtilization(sdkCtx)
+	}
 	return genesis
 }
@@ -26,3 +26,16 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 	ctx := sdk.UnwrapSDKContext(c)
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+// BlobBaseFee returns the blob base fee of the next block.
+func (k Keeper) BlobBaseFee(c context.Context, req *types.QueryBlobBaseFeeRequest) (*types.QueryBlobBaseFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryBlobBaseFeeResponse{
+		Enabled:           k.GetParams(ctx).DynamicBlobBaseFee,
+		BlobBaseFee:       k.GetBlobBaseFee(ctx),
+		SquareUtilization: k.GetSquareUtilization(ctx),
+	}, nil
+}
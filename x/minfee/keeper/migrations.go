@@ -27,3 +27,15 @@ func (m *Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.SetParams(ctx, minfeetypes.NewParams(params.NetworkMinGasPrice))
 	return nil
 }
+
+// MigrateBlobBaseFeeParams handles the migration of the minfee module
+// parameters to the parameters of the dynamic blob base fee. The dynamic blob
+// base fee is disabled and its parameters are set to their defaults.
+func (m *Migrator) MigrateBlobBaseFeeParams(ctx sdk.Context) error {
+	params := minfeetypes.NewParams(m.keeper.GetParams(ctx).NetworkMinGasPrice)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	m.keeper.SetParams(ctx, params)
+	return nil
+}
@@ -1,6 +1,7 @@
 package minfee
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -24,6 +25,7 @@ var (
 	_ module.HasServices         = AppModule{}
 	_ module.HasConsensusVersion = AppModule{}
 	_ appmodule.AppModule        = AppModule{}
+	_ appmodule.HasEndBlocker    = AppModule{}
 )
 
 // AppModule implements the AppModule interface for the minfee module.
@@ -76,6 +78,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, m.MigrateParams); err != nil {
 		panic(err)
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, m.MigrateBlobBaseFeeParams); err != nil {
+		panic(err)
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the minfee module.
@@ -111,5 +116,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, _ codec.JSONCodec) json.RawMe
 	return am.cdc.MustMarshalJSON(gs)
 }
 
+// EndBlock adjusts the blob base fee for the next block.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.minfeeKeeper.UpdateBlobBaseFee(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 2 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
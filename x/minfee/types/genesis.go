@@ -2,15 +2,17 @@ package types
 
 import (
 	"fmt"
+
+	"cosmossdk.io/math"
 )
 
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		NetworkMinGasPrice: DefaultNetworkMinGasPrice, // TODO: remove this field
-		Params: Params{
-			NetworkMinGasPrice: DefaultNetworkMinGasPrice,
-		},
+		Params:             DefaultParams(),
+		BlobBaseFee:        math.LegacyZeroDec(),
+		SquareUtilization:  math.LegacyZeroDec(),
 	}
 }
 
@@ -20,5 +22,12 @@ func ValidateGenesis(genesis *GenesisState) error {
 		return fmt.Errorf("network min gas price cannot be negative or zero: %g", genesis.NetworkMinGasPrice)
 	}
 
+	if !genesis.BlobBaseFee.IsNil() && genesis.BlobBaseFee.IsNegative() {
+		return fmt.Errorf("blob base fee cannot be negative: %s", genesis.BlobBaseFee)
+	}
+	if !genesis.SquareUtilization.IsNil() && (genesis.SquareUtilization.IsNegative() || genesis.SquareUtilization.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("square utilization must be between 0 and 1: %s", genesis.SquareUtilization)
+	}
+
 	return genesis.Params.Validate()
 }
@@ -29,6 +29,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	NetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_min_gas_price"`
 	Params             Params                      `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// blob_base_fee is the blob base fee of the transactions of the next block.
+	// It is only set if the dynamic blob base fee is enabled, zero means that
+	// it starts from the min blob base fee.
+	BlobBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=blob_base_fee,json=blobBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"blob_base_fee"`
+	// square_utilization is the utilization of the last square the blob base
+	// fee was adjusted for. It is only set if the dynamic blob base fee is
+	// enabled.
+	SquareUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=square_utilization,json=squareUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"square_utilization"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,26 +86,29 @@ func init() {
 func init() { proto.RegisterFile("celestia/minfee/v1/genesis.proto", fileDescriptor_40506204178306cf) }
 
 var fileDescriptor_40506204178306cf = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xe3, 0x5f, 0xbf, 0x2a, 0x11, 0x98, 0x22, 0x90, 0x4a, 0x91, 0xdc, 0x88, 0xa9, 0x4b,
-	0x6d, 0xa5, 0x2c, 0xcc, 0x51, 0xa5, 0x2e, 0x45, 0xaa, 0xca, 0xc6, 0x12, 0xb9, 0xee, 0xc5, 0xb5,
-	0x8a, 0xe3, 0x28, 0x36, 0x85, 0xbe, 0x05, 0x4f, 0xc2, 0xc4, 0x43, 0x74, 0xac, 0x98, 0x10, 0x43,
-	0x85, 0x92, 0x17, 0x41, 0x89, 0x13, 0x16, 0xd8, 0x8e, 0x7d, 0xcf, 0xf9, 0x8e, 0x7d, 0xfd, 0x90,
-	0xc3, 0x03, 0x18, 0x2b, 0x19, 0x55, 0x32, 0xbd, 0x07, 0xa0, 0x9b, 0x88, 0x0a, 0x48, 0xc1, 0x48,
-	0x43, 0xb2, 0x5c, 0x5b, 0x1d, 0x04, 0xad, 0x83, 0x38, 0x07, 0xd9, 0x44, 0xbd, 0xfe, 0x1f, 0xa9,
-	0x8c, 0xe5, 0x4c, 0x35, 0xa1, 0xde, 0xa9, 0xd0, 0x42, 0xd7, 0x92, 0x56, 0xaa, 0xb9, 0x3d, 0xe7,
-	0xda, 0x28, 0x6d, 0x12, 0x37, 0x70, 0x07, 0x37, 0xba, 0x7c, 0x45, 0xfe, 0xc9, 0xc4, 0xf5, 0xde,
-	0x5a, 0x66, 0x21, 0x58, 0xfa, 0x67, 0x29, 0xd8, 0x27, 0x9d, 0xaf, 0x13, 0x25, 0xd3, 0x44, 0xb0,
-	0x2a, 0x26, 0x39, 0x74, 0x51, 0x88, 0x06, 0x47, 0x71, 0xb4, 0x3b, 0xf4, 0xbd, 0xcf, 0x43, 0xff,
-	0xc2, 0x51, 0xcc, 0x72, 0x4d, 0xa4, 0xa6, 0x8a, 0xd9, 0x15, 0x99, 0x82, 0x60, 0x7c, 0x3b, 0x06,
-	0xfe, 0xfe, 0x36, 0xf4, 0x9b, 0x92, 0x31, 0xf0, 0x79, 0xd0, 0xf0, 0x6e, 0x64, 0x3a, 0x61, 0x66,
-	0x56, 0xc1, 0x82, 0x6b, 0xbf, 0xe3, 0xde, 0xdd, 0xfd, 0x17, 0xa2, 0xc1, 0xf1, 0xa8, 0x47, 0x7e,
-	0xff, 0x96, 0xcc, 0x6a, 0x47, 0xfc, 0xbf, 0xaa, 0x9c, 0x37, 0xfe, 0x78, 0xba, 0x2b, 0x30, 0xda,
-	0x17, 0x18, 0x7d, 0x15, 0x18, 0xbd, 0x94, 0xd8, 0xdb, 0x97, 0xd8, 0xfb, 0x28, 0xb1, 0x77, 0x37,
-	0x12, 0xd2, 0xae, 0x1e, 0x17, 0x84, 0x6b, 0x45, 0x5b, 0x9a, 0xce, 0xc5, 0x8f, 0x1e, 0xb2, 0x2c,
-	0xa3, 0xcf, 0xed, 0xe6, 0xec, 0x36, 0x03, 0xb3, 0xe8, 0xd4, 0x5b, 0xb8, 0xfa, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0xa7, 0x34, 0xb0, 0x67, 0x8f, 0x01, 0x00, 0x00,
+	// 352 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xb1, 0x6e, 0xea, 0x30,
+	0x14, 0x86, 0x13, 0x2e, 0x42, 0xba, 0xe1, 0xde, 0xe1, 0x5a, 0xb7, 0x52, 0x4a, 0xa5, 0x80, 0x3a,
+	0xb1, 0x60, 0x0b, 0xba, 0x74, 0x8e, 0x50, 0x59, 0xa8, 0x84, 0xa8, 0x58, 0xba, 0xa4, 0x8e, 0x39,
+	0x18, 0x0b, 0x12, 0xa7, 0xb1, 0xa1, 0xa5, 0x4f, 0xd1, 0x87, 0xe9, 0x43, 0x30, 0xa2, 0x4e, 0x55,
+	0x07, 0x54, 0xc1, 0xd0, 0xd7, 0xa8, 0x82, 0x43, 0x3b, 0xb4, 0x13, 0xdb, 0xb1, 0xcf, 0xff, 0x7f,
+	0xff, 0xb1, 0x8f, 0x53, 0x63, 0x30, 0x05, 0xa5, 0x05, 0x25, 0x91, 0x88, 0x47, 0x00, 0x64, 0xde,
+	0x24, 0x1c, 0x62, 0x50, 0x42, 0xe1, 0x24, 0x95, 0x5a, 0x22, 0xb4, 0x57, 0x60, 0xa3, 0xc0, 0xf3,
+	0x66, 0xa5, 0xfa, 0x83, 0x2b, 0xa1, 0x29, 0x8d, 0x72, 0x53, 0xe5, 0x3f, 0x97, 0x5c, 0xee, 0x4a,
+	0x92, 0x55, 0xf9, 0xed, 0x31, 0x93, 0x2a, 0x92, 0x2a, 0x30, 0x0d, 0x73, 0x30, 0xad, 0xd3, 0xf7,
+	0x82, 0xf3, 0xa7, 0x63, 0x72, 0xaf, 0x34, 0xd5, 0x80, 0x86, 0xce, 0x51, 0x0c, 0xfa, 0x4e, 0xa6,
+	0x93, 0x20, 0x12, 0x71, 0xc0, 0x69, 0x66, 0x13, 0x0c, 0x5c, 0xbb, 0x66, 0xd7, 0x7f, 0xfb, 0xcd,
+	0xe5, 0xba, 0x6a, 0xbd, 0xae, 0xab, 0x27, 0x86, 0xa2, 0x86, 0x13, 0x2c, 0x24, 0x89, 0xa8, 0x1e,
+	0xe3, 0x2e, 0x70, 0xca, 0x16, 0x6d, 0x60, 0xcf, 0x4f, 0x0d, 0x27, 0x0f, 0x69, 0x03, 0xeb, 0xa3,
+	0x9c, 0x77, 0x29, 0xe2, 0x0e, 0x55, 0xbd, 0x0c, 0x86, 0xce, 0x9d, 0x92, 0x99, 0xdb, 0x2d, 0xd4,
+	0xec, 0x7a, 0xb9, 0x55, 0xc1, 0xdf, 0x5f, 0x8b, 0x7b, 0x3b, 0x85, 0x5f, 0xcc, 0x22, 0xfb, 0xb9,
+	0x1e, 0x0d, 0x9c, 0xbf, 0xe1, 0x54, 0x86, 0x41, 0x48, 0x15, 0x04, 0x23, 0x00, 0xf7, 0xd7, 0xa1,
+	0x73, 0x95, 0x33, 0x8e, 0x4f, 0x15, 0x5c, 0x00, 0xa0, 0x1b, 0x07, 0xa9, 0xdb, 0x19, 0x4d, 0x21,
+	0x98, 0x69, 0x31, 0x15, 0x0f, 0x54, 0x0b, 0x19, 0xbb, 0xc5, 0x43, 0xd9, 0xff, 0x0c, 0x6c, 0xf0,
+	0xc5, 0xf2, 0xbb, 0xcb, 0x8d, 0x67, 0xaf, 0x36, 0x9e, 0xfd, 0xb6, 0xf1, 0xec, 0xc7, 0xad, 0x67,
+	0xad, 0xb6, 0x9e, 0xf5, 0xb2, 0xf5, 0xac, 0xeb, 0x16, 0x17, 0x7a, 0x3c, 0x0b, 0x31, 0x93, 0x11,
+	0xd9, 0x7f, 0x83, 0x4c, 0xf9, 0x67, 0xdd, 0xa0, 0x49, 0x42, 0xee, 0xf7, 0x2b, 0xd7, 0x8b, 0x04,
+	0x54, 0x58, 0xda, 0xad, 0xef, 0xec, 0x63, 0x00, 0xc6, 0xce, 0xf7, 0x01, 0x48, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -120,6 +131,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SquareUtilization.Size()
+		i -= size
+		if _, err := m.SquareUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BlobBaseFee.Size()
+		i -= size
+		if _, err := m.BlobBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -164,6 +195,10 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BlobBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SquareUtilization.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -269,6 +304,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlobBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SquareUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9,4 +9,11 @@ const (
 
 	// ParamsKey defines the key used for storing module parameters
 	ParamsKey = "params"
+
+	// BlobBaseFeeKey defines the key used for storing the blob base fee
+	BlobBaseFeeKey = "blob_base_fee"
+
+	// SquareUtilizationKey defines the key used for storing the utilization
+	// of the square of the block being finalized
+	SquareUtilizationKey = "square_utilization"
 )
@@ -10,6 +10,19 @@ import (
 
 var DefaultNetworkMinGasPrice math.LegacyDec
 
+var (
+	// DefaultMinBlobBaseFee is the default lower bound of the blob base fee.
+	DefaultMinBlobBaseFee = math.LegacyNewDecWithPrec(1, 6) // 0.000001 utia
+	// DefaultMaxBlobBaseFee is the default upper bound of the blob base fee.
+	DefaultMaxBlobBaseFee = math.LegacyNewDec(10) // 10 utia
+	// DefaultBlobBaseFeeMaxChangeRate is the default max relative change of
+	// the blob base fee from one block to the next.
+	DefaultBlobBaseFeeMaxChangeRate = math.LegacyNewDecWithPrec(125, 3) // 12.5%
+	// DefaultTargetSquareUtilization is the default fraction of the max square
+	// that keeps the blob base fee unchanged.
+	DefaultTargetSquareUtilization = math.LegacyNewDecWithPrec(5, 1) // 50%
+)
+
 func init() {
 	DefaultNetworkMinGasPriceDec, err := math.LegacyNewDecFromStr(fmt.Sprintf("%f", appconsts.DefaultNetworkMinGasPrice))
 	if err != nil {
@@ -18,21 +31,59 @@ func init() {
 	DefaultNetworkMinGasPrice = DefaultNetworkMinGasPriceDec
 }
 
-// Validate validates the set of params
+// Validate validates the set of params. The blob base fee params are only
+// validated if the dynamic blob base fee is enabled.
 func (p Params) Validate() error {
+	if !p.DynamicBlobBaseFee {
+		return nil
+	}
+	if p.MinBlobBaseFee.IsNil() || !p.MinBlobBaseFee.IsPositive() {
+		return fmt.Errorf("min blob base fee must be positive: %s", p.MinBlobBaseFee)
+	}
+	if p.MaxBlobBaseFee.IsNil() || p.MaxBlobBaseFee.LT(p.MinBlobBaseFee) {
+		return fmt.Errorf("max blob base fee %s cannot be lower than the min blob base fee %s", p.MaxBlobBaseFee, p.MinBlobBaseFee)
+	}
+	if p.BlobBaseFeeMaxChangeRate.IsNil() || !p.BlobBaseFeeMaxChangeRate.IsPositive() || p.BlobBaseFeeMaxChangeRate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("blob base fee max change rate must be in (0, 1]: %s", p.BlobBaseFeeMaxChangeRate)
+	}
+	if p.TargetSquareUtilization.IsNil() || !p.TargetSquareUtilization.IsPositive() || p.TargetSquareUtilization.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("target square utilization must be in (0, 1): %s", p.TargetSquareUtilization)
+	}
 	return nil
 }
 
+// NextBlobBaseFee returns the blob base fee following baseFee after a block
+// with the given square utilization. The fee moves towards the max change
+// rate as the utilization moves away from the target towards a full or an
+// empty square and is kept within the bounds.
+func (p Params) NextBlobBaseFee(baseFee, utilization math.LegacyDec) math.LegacyDec {
+	utilization = math.LegacyMaxDec(math.LegacyZeroDec(), math.LegacyMinDec(utilization, math.LegacyOneDec()))
+	target := p.TargetSquareUtilization
+
+	var deviation math.LegacyDec
+	if utilization.GT(target) {
+		deviation = utilization.Sub(target).Quo(math.LegacyOneDec().Sub(target))
+	} else {
+		deviation = utilization.Sub(target).Quo(target)
+	}
+	next := baseFee.Add(baseFee.Mul(p.BlobBaseFeeMaxChangeRate).Mul(deviation))
+	return math.LegacyMaxDec(p.MinBlobBaseFee, math.LegacyMinDec(next, p.MaxBlobBaseFee))
+}
+
 // DefaultParams returns the default parameters for the module.
 func DefaultParams() Params {
-	return Params{
-		NetworkMinGasPrice: DefaultNetworkMinGasPrice,
-	}
+	return NewParams(DefaultNetworkMinGasPrice)
 }
 
-// NewParams creates a new instance of Params with the provided NetworkMinGasPrice.
+// NewParams creates a new instance of Params with the provided
+// NetworkMinGasPrice and the dynamic blob base fee disabled.
 func NewParams(networkMinGasPrice math.LegacyDec) Params {
 	return Params{
-		NetworkMinGasPrice: networkMinGasPrice,
+		NetworkMinGasPrice:       networkMinGasPrice,
+		DynamicBlobBaseFee:       false,
+		MinBlobBaseFee:           DefaultMinBlobBaseFee,
+		MaxBlobBaseFee:           DefaultMaxBlobBaseFee,
+		BlobBaseFeeMaxChangeRate: DefaultBlobBaseFeeMaxChangeRate,
+		TargetSquareUtilization:  DefaultTargetSquareUtilization,
 	}
 }
@@ -28,6 +28,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	NetworkMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"network_min_gas_price"`
+	// dynamic_blob_base_fee enables the blob base fee, a min gas price for the
+	// transactions paying for blobs that is adjusted every block based on the
+	// utilization of the data square.
+	DynamicBlobBaseFee bool `protobuf:"varint,2,opt,name=dynamic_blob_base_fee,json=dynamicBlobBaseFee,proto3" json:"dynamic_blob_base_fee,omitempty"`
+	// min_blob_base_fee is the lower bound of the blob base fee.
+	MinBlobBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_blob_base_fee,json=minBlobBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_blob_base_fee"`
+	// max_blob_base_fee is the upper bound of the blob base fee.
+	MaxBlobBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=max_blob_base_fee,json=maxBlobBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_blob_base_fee"`
+	// blob_base_fee_max_change_rate is the max relative change of the blob base
+	// fee from one block to the next.
+	BlobBaseFeeMaxChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=blob_base_fee_max_change_rate,json=blobBaseFeeMaxChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"blob_base_fee_max_change_rate"`
+	// target_square_utilization is the fraction of the max square, as set by
+	// the GovMaxSquareSize param of the blob module, that keeps the blob base
+	// fee unchanged.
+	TargetSquareUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=target_square_utilization,json=targetSquareUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_square_utilization"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -63,6 +78,13 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetDynamicBlobBaseFee() bool {
+	if m != nil {
+		return m.DynamicBlobBaseFee
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "celestia.minfee.v1.Params")
 }
@@ -70,23 +92,32 @@ func init() {
 func init() { proto.RegisterFile("celestia/minfee/v1/params.proto", fileDescriptor_821eedeb4e2f93bf) }
 
 var fileDescriptor_821eedeb4e2f93bf = []byte{
-	// 253 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0x29, 0xd0,
-	0x83, 0x28, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83, 0x58,
-	0x10, 0x95, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22,
-	0xa5, 0x94, 0xc7, 0xc5, 0x16, 0x00, 0x36, 0x54, 0x28, 0x85, 0x4b, 0x34, 0x2f, 0xb5, 0xa4, 0x3c,
-	0xbf, 0x28, 0x3b, 0x3e, 0x37, 0x33, 0x2f, 0x3e, 0x3d, 0x11, 0xa4, 0x3e, 0x33, 0x39, 0x55, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xf0, 0xc4, 0x3d, 0x79, 0x86, 0x5b, 0xf7, 0xe4, 0xa5, 0x21,
-	0xda, 0x8b, 0x53, 0xb2, 0xf5, 0x32, 0xf3, 0xf5, 0x73, 0x13, 0x4b, 0x32, 0xf4, 0x7c, 0x52, 0xd3,
-	0x13, 0x93, 0x2b, 0x5d, 0x52, 0x93, 0x2f, 0x6d, 0xd1, 0xe5, 0x82, 0x9a, 0xee, 0x92, 0x9a, 0x1c,
-	0x24, 0x04, 0x35, 0xcf, 0x37, 0x33, 0xcf, 0x3d, 0xb1, 0x38, 0x00, 0x64, 0x98, 0x93, 0xcf, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19, 0xa5, 0x67, 0x96, 0x64, 0x94, 0x26,
-	0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xc3, 0x7c, 0x96, 0x5f, 0x94, 0x0e, 0x67, 0xeb, 0x26, 0x16, 0x14,
-	0xe8, 0x57, 0xc0, 0x02, 0xa3, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x09, 0x63, 0x40,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x58, 0x21, 0xaa, 0xfb, 0x2c, 0x01, 0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x6d, 0x28, 0x11, 0xec, 0x01, 0x89, 0x15, 0x15, 0x6e, 0x11, 0x4e, 0xc5, 0xa9, 0x97,
+	0xda, 0x32, 0xbc, 0x81, 0xa9, 0xe0, 0xd2, 0x4a, 0x55, 0x10, 0x17, 0x84, 0xb4, 0x1a, 0x6f, 0xa6,
+	0x9b, 0x55, 0xbd, 0xbb, 0x66, 0x77, 0x53, 0x1c, 0x9e, 0x82, 0x87, 0xe1, 0x01, 0x38, 0xf6, 0x18,
+	0x71, 0x42, 0x1c, 0x22, 0x94, 0xbc, 0x08, 0xb2, 0x9d, 0x10, 0x92, 0xa3, 0x6f, 0x33, 0xfa, 0xff,
+	0xf9, 0xfe, 0x39, 0xfc, 0x64, 0xc8, 0xb1, 0x44, 0xe7, 0x25, 0xa4, 0x4a, 0xea, 0x6b, 0xc4, 0xf4,
+	0x36, 0x4b, 0x2b, 0xb0, 0xa0, 0x5c, 0x52, 0x59, 0xe3, 0x0d, 0xa5, 0x1b, 0x43, 0xd2, 0x19, 0x92,
+	0xdb, 0xec, 0xf8, 0xa9, 0x30, 0xc2, 0xb4, 0x72, 0xda, 0x4c, 0x9d, 0xf3, 0xf8, 0x88, 0x1b, 0xa7,
+	0x8c, 0x63, 0x9d, 0xd0, 0x2d, 0x9d, 0xf4, 0xf2, 0xc7, 0x01, 0x19, 0x5c, 0xb5, 0x54, 0x3a, 0x26,
+	0x87, 0x1a, 0xfd, 0x17, 0x63, 0x6f, 0x98, 0x92, 0x9a, 0x09, 0x68, 0x0e, 0x24, 0xc7, 0x28, 0x3c,
+	0x09, 0x4f, 0x1f, 0xe5, 0xd9, 0xdd, 0x62, 0x18, 0xfc, 0x5e, 0x0c, 0x9f, 0x77, 0xf7, 0x6e, 0x7c,
+	0x93, 0x48, 0x93, 0x2a, 0xf0, 0x93, 0xe4, 0x02, 0x05, 0xf0, 0xd9, 0x39, 0xf2, 0x9f, 0xdf, 0xcf,
+	0xc8, 0x1a, 0x7f, 0x8e, 0x7c, 0x44, 0xd7, 0xbc, 0x4b, 0xa9, 0xdf, 0x81, 0xbb, 0x6a, 0x60, 0x34,
+	0x23, 0x87, 0xe3, 0x99, 0x06, 0x25, 0x39, 0x2b, 0x4a, 0x53, 0xb0, 0x02, 0x1c, 0xb2, 0x6b, 0xc4,
+	0xe8, 0xde, 0x49, 0x78, 0xfa, 0x70, 0x44, 0xd7, 0x62, 0x5e, 0x9a, 0x22, 0x07, 0x87, 0x6f, 0x11,
+	0xe9, 0x27, 0xf2, 0xa4, 0x79, 0x68, 0xd7, 0x7e, 0xbf, 0xef, 0x53, 0x8f, 0x95, 0xd4, 0xfb, 0x74,
+	0xa8, 0xf7, 0xe8, 0x07, 0xfd, 0xe9, 0x50, 0xff, 0x4f, 0xb7, 0xe4, 0xc5, 0x0e, 0x99, 0x35, 0x59,
+	0x7c, 0x02, 0x5a, 0x20, 0xb3, 0xe0, 0x31, 0x7a, 0xd0, 0x37, 0x29, 0x2a, 0xb6, 0x31, 0x97, 0x50,
+	0xbf, 0x69, 0x99, 0x23, 0xf0, 0x48, 0x15, 0x39, 0xf2, 0x60, 0x05, 0x7a, 0xe6, 0x3e, 0x4f, 0xc1,
+	0x22, 0x9b, 0x7a, 0x59, 0xca, 0xaf, 0xe0, 0xa5, 0xd1, 0xd1, 0xa0, 0x6f, 0xde, 0xb3, 0x8e, 0xf9,
+	0xbe, 0x45, 0x7e, 0xd8, 0x12, 0xf3, 0x8b, 0xbb, 0x65, 0x1c, 0xce, 0x97, 0x71, 0xf8, 0x67, 0x19,
+	0x87, 0xdf, 0x56, 0x71, 0x30, 0x5f, 0xc5, 0xc1, 0xaf, 0x55, 0x1c, 0x7c, 0x7c, 0x25, 0xa4, 0x9f,
+	0x4c, 0x8b, 0x84, 0x1b, 0x95, 0x6e, 0xca, 0x6a, 0xac, 0xf8, 0x37, 0x9f, 0x41, 0x55, 0xa5, 0xf5,
+	0xa6, 0xdf, 0x7e, 0x56, 0xa1, 0x2b, 0x06, 0x6d, 0x2f, 0x5f, 0xff, 0x1d, 0x00, 0x5c, 0x9e, 0x8f,
+	0x3f, 0xff, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -109,6 +140,56 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TargetSquareUtilization.Size()
+		i -= size
+		if _, err := m.TargetSquareUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BlobBaseFeeMaxChangeRate.Size()
+		i -= size
+		if _, err := m.BlobBaseFeeMaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxBlobBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBlobBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinBlobBaseFee.Size()
+		i -= size
+		if _, err := m.MinBlobBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.DynamicBlobBaseFee {
+		i--
+		if m.DynamicBlobBaseFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.NetworkMinGasPrice.Size()
 		i -= size
@@ -141,6 +222,17 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.NetworkMinGasPrice.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.DynamicBlobBaseFee {
+		n += 2
+	}
+	l = m.MinBlobBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxBlobBaseFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BlobBaseFeeMaxChangeRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetSquareUtilization.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -213,6 +305,162 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicBlobBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DynamicBlobBaseFee = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlobBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBlobBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlobBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBlobBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFeeMaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlobBaseFeeMaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSquareUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetSquareUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
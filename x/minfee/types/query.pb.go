@@ -190,44 +190,141 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryBlobBaseFeeRequest is the request type for the Query/BlobBaseFee RPC
+// method.
+type QueryBlobBaseFeeRequest struct {
+}
+
+func (m *QueryBlobBaseFeeRequest) Reset()         { *m = QueryBlobBaseFeeRequest{} }
+func (m *QueryBlobBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBlobBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{4}
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBlobBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBlobBaseFeeResponse is the response type for the Query/BlobBaseFee RPC
+// method.
+type QueryBlobBaseFeeResponse struct {
+	// enabled is true if the blob base fee is enforced.
+	Enabled     bool                        `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	BlobBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=blob_base_fee,json=blobBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"blob_base_fee"`
+	// square_utilization is the utilization of the last square the blob base
+	// fee was adjusted for.
+	SquareUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=square_utilization,json=squareUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"square_utilization"`
+}
+
+func (m *QueryBlobBaseFeeResponse) Reset()         { *m = QueryBlobBaseFeeResponse{} }
+func (m *QueryBlobBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlobBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBlobBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{5}
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlobBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlobBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBlobBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlobBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlobBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlobBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBlobBaseFeeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryNetworkMinGasPrice)(nil), "celestia.minfee.v1.QueryNetworkMinGasPrice")
 	proto.RegisterType((*QueryNetworkMinGasPriceResponse)(nil), "celestia.minfee.v1.QueryNetworkMinGasPriceResponse")
 	proto.RegisterType((*QueryParamsRequest)(nil), "celestia.minfee.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "celestia.minfee.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryBlobBaseFeeRequest)(nil), "celestia.minfee.v1.QueryBlobBaseFeeRequest")
+	proto.RegisterType((*QueryBlobBaseFeeResponse)(nil), "celestia.minfee.v1.QueryBlobBaseFeeResponse")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/query.proto", fileDescriptor_4c41d9a8b7bf8984) }
 
 var fileDescriptor_4c41d9a8b7bf8984 = []byte{
-	// 421 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x8e, 0xd3, 0x30,
-	0x10, 0xc6, 0x93, 0x15, 0x54, 0xc2, 0x9c, 0xf0, 0x2e, 0x82, 0x06, 0x94, 0x40, 0x90, 0xf8, 0x23,
-	0xb4, 0xb6, 0xda, 0xbd, 0x70, 0xae, 0x56, 0xe2, 0xb2, 0xc0, 0x92, 0x23, 0x97, 0xca, 0xeb, 0x1d,
-	0xbc, 0x56, 0x1b, 0x3b, 0x8d, 0xdd, 0x42, 0xaf, 0x5c, 0xb8, 0x22, 0xf1, 0x04, 0x7d, 0x07, 0x1e,
-	0xa2, 0xc7, 0x0a, 0x2e, 0x88, 0x43, 0x85, 0x5a, 0x1e, 0x04, 0x25, 0x4e, 0x2a, 0x55, 0x69, 0x85,
-	0xb8, 0x4d, 0xf2, 0xcd, 0x37, 0xf3, 0xf3, 0xa7, 0x41, 0x21, 0x87, 0x21, 0x18, 0x2b, 0x19, 0x4d,
-	0xa5, 0x7a, 0x0f, 0x40, 0x27, 0x1d, 0x3a, 0x1a, 0x43, 0x3e, 0x25, 0x59, 0xae, 0xad, 0xc6, 0xb8,
-	0xd6, 0x89, 0xd3, 0xc9, 0xa4, 0x13, 0x44, 0x3b, 0x3c, 0x19, 0xcb, 0x59, 0x6a, 0x9c, 0x29, 0x38,
-	0x12, 0x5a, 0xe8, 0xb2, 0xa4, 0x45, 0x55, 0xfd, 0xbd, 0x2f, 0xb4, 0x16, 0x43, 0xa0, 0x2c, 0x93,
-	0x94, 0x29, 0xa5, 0x2d, 0xb3, 0x52, 0xab, 0xda, 0xd3, 0xe6, 0xda, 0xa4, 0xda, 0xf4, 0x9d, 0xcd,
-	0x7d, 0x38, 0x29, 0x6e, 0xa3, 0x3b, 0x6f, 0x0b, 0xa4, 0xd7, 0x60, 0x3f, 0xe8, 0x7c, 0xf0, 0x4a,
-	0xaa, 0x97, 0xcc, 0x9c, 0xe7, 0x92, 0x43, 0xfc, 0xd9, 0x47, 0xd1, 0x1e, 0x2d, 0x01, 0x93, 0x69,
-	0x65, 0x00, 0x5f, 0xa2, 0xdb, 0xca, 0xa9, 0xfd, 0x54, 0xaa, 0xbe, 0x60, 0xc5, 0x12, 0xc9, 0xe1,
-	0xae, 0xff, 0xc0, 0x7f, 0x7a, 0xa3, 0xd7, 0x99, 0x2f, 0x23, 0xef, 0xd7, 0x32, 0xba, 0xe7, 0x76,
-	0x9a, 0xcb, 0x01, 0x91, 0x9a, 0xa6, 0xcc, 0x5e, 0x91, 0x33, 0x10, 0x8c, 0x4f, 0x4f, 0x81, 0x7f,
-	0xff, 0x76, 0x8c, 0x2a, 0xa4, 0x53, 0xe0, 0x09, 0x56, 0x4d, 0x92, 0x23, 0x84, 0x4b, 0x90, 0xf3,
-	0x32, 0x88, 0x04, 0x46, 0x63, 0x30, 0x36, 0x7e, 0x83, 0x0e, 0xb7, 0xfe, 0x56, 0x48, 0x2f, 0x50,
-	0xcb, 0x05, 0x56, 0x32, 0xdc, 0xec, 0x06, 0xa4, 0x19, 0x33, 0x71, 0x9e, 0xde, 0xb5, 0x82, 0x2f,
-	0xa9, 0xfa, 0xbb, 0xb3, 0x03, 0x74, 0xbd, 0x9c, 0x88, 0x67, 0x3e, 0xc2, 0xcd, 0x57, 0xe3, 0xe7,
-	0xbb, 0x46, 0xed, 0x89, 0x28, 0x38, 0xf9, 0x8f, 0xe6, 0x1a, 0x3e, 0x7e, 0xf6, 0xe9, 0xc7, 0x9f,
-	0xaf, 0x07, 0x8f, 0xf0, 0x43, 0xba, 0xe3, 0x0e, 0xb6, 0x12, 0xc6, 0x16, 0xb5, 0xdc, 0x2b, 0xf0,
-	0xe3, 0xbd, 0x9b, 0xb6, 0x02, 0x0b, 0x9e, 0xfc, 0xb3, 0xaf, 0xa2, 0x68, 0x97, 0x14, 0x87, 0xf8,
-	0x56, 0xe3, 0x08, 0x7b, 0x67, 0xf3, 0x55, 0xe8, 0x2f, 0x56, 0xa1, 0xff, 0x7b, 0x15, 0xfa, 0x5f,
-	0xd6, 0xa1, 0xb7, 0x58, 0x87, 0xde, 0xcf, 0x75, 0xe8, 0xbd, 0xeb, 0x0a, 0x69, 0xaf, 0xc6, 0x17,
-	0x84, 0xeb, 0x74, 0x03, 0xaf, 0x73, 0xb1, 0xa9, 0x8f, 0x59, 0x96, 0xd1, 0x8f, 0xf5, 0x44, 0x3b,
-	0xcd, 0xc0, 0x5c, 0xb4, 0xca, 0x23, 0x3c, 0xf9, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x89, 0x37, 0xfc,
-	0x9c, 0x2a, 0x03, 0x00, 0x00,
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x1c, 0x8d, 0x5b, 0x08, 0x70, 0x11, 0x43, 0xaf, 0x45, 0x38, 0x06, 0x39, 0x10, 0x24, 0xfe, 0x08,
+	0x6a, 0x2b, 0xe9, 0xc2, 0x6c, 0x55, 0xb0, 0x14, 0x28, 0x96, 0xba, 0xb0, 0x98, 0xb3, 0xf3, 0xab,
+	0x7b, 0xaa, 0x7d, 0xe7, 0xf8, 0xce, 0x85, 0x30, 0xb2, 0xb0, 0x22, 0xf5, 0x13, 0xf0, 0x1d, 0x90,
+	0xf8, 0x0a, 0x1d, 0x2b, 0x58, 0x10, 0x43, 0x85, 0x12, 0x56, 0xbe, 0x03, 0x8a, 0xcf, 0x4e, 0x89,
+	0x9c, 0x40, 0x9b, 0xed, 0xee, 0xde, 0xef, 0xf7, 0x7b, 0xcf, 0xef, 0xde, 0x19, 0x99, 0x01, 0x44,
+	0x20, 0x24, 0x25, 0x76, 0x4c, 0xd9, 0x2e, 0x80, 0x7d, 0xd0, 0xb1, 0xfb, 0x19, 0xa4, 0x03, 0x2b,
+	0x49, 0xb9, 0xe4, 0x18, 0x97, 0xb8, 0xa5, 0x70, 0xeb, 0xa0, 0x63, 0xb4, 0x66, 0xf4, 0x24, 0x24,
+	0x25, 0xb1, 0x50, 0x4d, 0xc6, 0x5a, 0xc8, 0x43, 0x9e, 0x2f, 0xed, 0xf1, 0xaa, 0x38, 0xbd, 0x19,
+	0x72, 0x1e, 0x46, 0x60, 0x93, 0x84, 0xda, 0x84, 0x31, 0x2e, 0x89, 0xa4, 0x9c, 0x95, 0x3d, 0xcd,
+	0x80, 0x8b, 0x98, 0x0b, 0x4f, 0xb5, 0xa9, 0x8d, 0x82, 0xda, 0x4d, 0x74, 0xfd, 0xe5, 0x58, 0xd2,
+	0x73, 0x90, 0x6f, 0x78, 0xba, 0xff, 0x8c, 0xb2, 0xa7, 0x44, 0x6c, 0xa7, 0x34, 0x80, 0xf6, 0x07,
+	0x0d, 0xb5, 0xe6, 0x60, 0x2e, 0x88, 0x84, 0x33, 0x01, 0xb8, 0x87, 0xae, 0x31, 0x85, 0x7a, 0x31,
+	0x65, 0x5e, 0x48, 0xc6, 0x24, 0x34, 0x00, 0x5d, 0xbb, 0xa5, 0xdd, 0xbf, 0xe2, 0x74, 0x8e, 0x4e,
+	0x5a, 0xb5, 0x1f, 0x27, 0xad, 0x1b, 0x8a, 0x53, 0xf4, 0xf6, 0x2d, 0xca, 0xed, 0x98, 0xc8, 0x3d,
+	0x6b, 0x0b, 0x42, 0x12, 0x0c, 0x36, 0x21, 0xf8, 0xfa, 0x79, 0x1d, 0x15, 0x92, 0x36, 0x21, 0x70,
+	0x31, 0xab, 0x2a, 0x59, 0x43, 0x38, 0x17, 0xb2, 0x9d, 0x1b, 0xe1, 0x42, 0x3f, 0x03, 0x21, 0xdb,
+	0x2f, 0xd0, 0xea, 0xd4, 0x69, 0x21, 0xe9, 0x31, 0xaa, 0x2b, 0xc3, 0x72, 0x0d, 0x8d, 0xae, 0x61,
+	0x55, 0x6d, 0xb6, 0x54, 0x8f, 0x73, 0x61, 0xac, 0xcf, 0x2d, 0xea, 0x27, 0x5e, 0x38, 0x11, 0xf7,
+	0x1d, 0x22, 0xe0, 0x09, 0x40, 0xc9, 0xf5, 0x5b, 0x43, 0x7a, 0x15, 0x2b, 0x18, 0x75, 0x74, 0x09,
+	0x18, 0xf1, 0x23, 0xe8, 0xe5, 0x94, 0x97, 0xdd, 0x72, 0x8b, 0x77, 0xd0, 0x55, 0x3f, 0xe2, 0xbe,
+	0xe7, 0x13, 0x01, 0xde, 0x2e, 0x80, 0xbe, 0xb4, 0xa8, 0x2d, 0x0d, 0xff, 0x94, 0x18, 0xbf, 0x46,
+	0x58, 0xf4, 0x33, 0x92, 0x82, 0x97, 0x49, 0x1a, 0xd1, 0x77, 0xf9, 0x65, 0xeb, 0xcb, 0x8b, 0xce,
+	0x5e, 0x51, 0xc3, 0x76, 0x4e, 0x67, 0x75, 0xbf, 0x2c, 0xa3, 0x8b, 0xf9, 0xf7, 0xe2, 0x4f, 0x1a,
+	0xc2, 0xd5, 0x00, 0xe0, 0x87, 0xb3, 0x5c, 0x9d, 0x93, 0x16, 0x63, 0xe3, 0x1c, 0xc5, 0xa5, 0xab,
+	0xed, 0x07, 0xef, 0xbf, 0xfd, 0x3a, 0x5c, 0xba, 0x83, 0x6f, 0xdb, 0x33, 0x9e, 0xc4, 0x54, 0xd8,
+	0xb0, 0x44, 0x75, 0x75, 0xa1, 0xf8, 0xee, 0x5c, 0xa6, 0xa9, 0xec, 0x18, 0xf7, 0xfe, 0x5b, 0x57,
+	0xa8, 0x68, 0xe6, 0x2a, 0x56, 0xf1, 0x4a, 0xe5, 0x3d, 0xe2, 0x43, 0x0d, 0x35, 0xfe, 0x8a, 0xc3,
+	0x3f, 0x2c, 0xa9, 0x06, 0xca, 0x78, 0x74, 0xb6, 0xe2, 0xb3, 0x78, 0x31, 0x95, 0x30, 0x67, 0xeb,
+	0x68, 0x68, 0x6a, 0xc7, 0x43, 0x53, 0xfb, 0x39, 0x34, 0xb5, 0x8f, 0x23, 0xb3, 0x76, 0x3c, 0x32,
+	0x6b, 0xdf, 0x47, 0x66, 0xed, 0x55, 0x37, 0xa4, 0x72, 0x2f, 0xf3, 0xad, 0x80, 0xc7, 0x93, 0x31,
+	0x3c, 0x0d, 0x27, 0xeb, 0x75, 0x92, 0x24, 0xf6, 0xdb, 0x72, 0xb0, 0x1c, 0x24, 0x20, 0xfc, 0x7a,
+	0xfe, 0x97, 0xd8, 0xf8, 0x33, 0x00, 0x6e, 0x55, 0x54, 0x62, 0xcb, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -246,6 +343,8 @@ type QueryClient interface {
 	NetworkMinGasPrice(ctx context.Context, in *QueryNetworkMinGasPrice, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceResponse, error)
 	// Params queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// BlobBaseFee queries the blob base fee of the next block.
+	BlobBaseFee(ctx context.Context, in *QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlobBaseFeeResponse, error)
 }
 
 type queryClient struct {
@@ -274,12 +373,23 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) BlobBaseFee(ctx context.Context, in *QueryBlobBaseFeeRequest, opts ...grpc.CallOption) (*QueryBlobBaseFeeResponse, error) {
+	out := new(QueryBlobBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/BlobBaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// NetworkMinGasPrice queries the network wide minimum gas price.
 	NetworkMinGasPrice(context.Context, *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error)
 	// Params queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// BlobBaseFee queries the blob base fee of the next block.
+	BlobBaseFee(context.Context, *QueryBlobBaseFeeRequest) (*QueryBlobBaseFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -292,6 +402,9 @@ func (*UnimplementedQueryServer) NetworkMinGasPrice(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) BlobBaseFee(ctx context.Context, req *QueryBlobBaseFeeRequest) (*QueryBlobBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlobBaseFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -333,6 +446,24 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BlobBaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlobBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlobBaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.minfee.v1.Query/BlobBaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlobBaseFee(ctx, req.(*QueryBlobBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.minfee.v1.Query",
@@ -346,6 +477,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "BlobBaseFee",
+			Handler:    _Query_BlobBaseFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/minfee/v1/query.proto",
@@ -463,6 +598,82 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBlobBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlobBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlobBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlobBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SquareUtilization.Size()
+		i -= size
+		if _, err := m.SquareUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BlobBaseFee.Size()
+		i -= size
+		if _, err := m.BlobBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -514,6 +725,31 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBlobBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlobBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.BlobBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SquareUtilization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -787,6 +1023,194 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBlobBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlobBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlobBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BlobBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SquareUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SquareUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
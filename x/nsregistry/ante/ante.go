@@ -0,0 +1,65 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v4/x/nsregistry/types"
+)
+
+// NamespaceRegistry defines the registry methods used by the
+// NamespaceSignerDecorator.
+type NamespaceRegistry interface {
+	GetBlockStartRegistration(ctx sdk.Context, namespace share.Namespace) (types.Registration, bool)
+}
+
+// NamespaceSignerDecorator rejects PFBs paying for blobs in a registered
+// namespace if the signer is neither the owner of the namespace nor one of
+// its allowed signers. The registrations are read as of the beginning of the
+// block: PrepareProposal and ProcessProposal only run the ante handler, so
+// the registry messages of a block can't affect the blobs of the same block
+// without the proposal and the execution of the block disagreeing.
+type NamespaceSignerDecorator struct {
+	k NamespaceRegistry
+}
+
+func NewNamespaceSignerDecorator(k NamespaceRegistry) NamespaceSignerDecorator {
+	return NamespaceSignerDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature. PFBs
+// nested in a MsgExec are not inspected because they are rejected by the
+// MsgExecDecorator.
+func (d NamespaceSignerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, m := range tx.GetMsgs() {
+		pfb, ok := m.(*blobtypes.MsgPayForBlobs)
+		if !ok {
+			continue
+		}
+		if err := d.validatePFB(ctx, pfb); err != nil {
+			return ctx, err
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+func (d NamespaceSignerDecorator) validatePFB(ctx sdk.Context, pfb *blobtypes.MsgPayForBlobs) error {
+	signer, err := sdk.AccAddressFromBech32(pfb.Signer)
+	if err != nil {
+		return err
+	}
+	for _, bz := range pfb.Namespaces {
+		namespace, err := share.NewNamespaceFromBytes(bz)
+		if err != nil {
+			return errors.Wrap(blobtypes.ErrInvalidNamespace, err.Error())
+		}
+		registration, found := d.k.GetBlockStartRegistration(ctx, namespace)
+		if found && !registration.IsAllowed(signer.String()) {
+			return errors.Wrapf(types.ErrUnauthorizedBlobSigner, "%s is not allowed to pay for blobs in namespace %X owned by %s", pfb.Signer, bz, registration.Owner)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/app"
+	testutil "github.com/celestiaorg/celestia-app/v4/test/util"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testfactory"
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v4/x/nsregistry/ante"
+	"github.com/celestiaorg/celestia-app/v4/x/nsregistry/types"
+)
+
+func TestNamespaceSignerDecorator(t *testing.T) {
+	testApp, _, kr := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams(), "owner", "signer", "other")
+	ctx := testApp.NewContext(false)
+	k := testApp.NsRegistryKeeper
+	owner := testfactory.GetAddress(kr, "owner")
+	signer := testfactory.GetAddress(kr, "signer")
+	other := testfactory.GetAddress(kr, "other")
+
+	restricted := share.RandomBlobNamespace()
+	open := share.RandomBlobNamespace()
+	_, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, restricted, signer))
+	require.NoError(t, err)
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, open))
+	require.NoError(t, err)
+	// the registrations apply from the next block on
+	k.ClearBlockStartRegistrations(ctx)
+	unregistered := share.RandomBlobNamespace()
+
+	testCases := []struct {
+		name       string
+		signer     sdk.AccAddress
+		namespaces []share.Namespace
+		wantErr    error
+	}{
+		{"owner of a restricted namespace", owner, []share.Namespace{restricted}, nil},
+		{"allowed signer of a restricted namespace", signer, []share.Namespace{restricted}, nil},
+		{"other signer of a restricted namespace", other, []share.Namespace{restricted}, types.ErrUnauthorizedBlobSigner},
+		{"other signer of a namespace without allow-list", other, []share.Namespace{open}, nil},
+		{"other signer of an unregistered namespace", other, []share.Namespace{unregistered}, nil},
+		{"restricted namespace among others", other, []share.Namespace{unregistered, restricted}, types.ErrUnauthorizedBlobSigner},
+	}
+
+	decorator := ante.NewNamespaceSignerDecorator(k)
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := decorator.AnteHandle(ctx, newPFBTx(t, tc.signer, tc.namespaces...), false, nextAnteHandler)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNamespaceSignerDecoratorUsesBlockStartRegistrations(t *testing.T) {
+	testApp, _, kr := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams(), "owner", "other")
+	ctx := testApp.NewContext(false)
+	k := testApp.NsRegistryKeeper
+	owner := testfactory.GetAddress(kr, "owner")
+	other := testfactory.GetAddress(kr, "other")
+	namespace := share.RandomBlobNamespace()
+	decorator := ante.NewNamespaceSignerDecorator(k)
+
+	// restricting a namespace doesn't affect the blobs of the same block
+	_, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, namespace, owner))
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newPFBTx(t, other, namespace), false, nextAnteHandler)
+	require.NoError(t, err)
+
+	k.ClearBlockStartRegistrations(ctx)
+	_, err = decorator.AnteHandle(ctx, newPFBTx(t, other, namespace), false, nextAnteHandler)
+	require.ErrorIs(t, err, types.ErrUnauthorizedBlobSigner)
+
+	// neither does allowing a signer
+	_, err = k.UpdateAllowedSigners(ctx, types.NewMsgUpdateAllowedSigners(owner, namespace, other))
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newPFBTx(t, other, namespace), false, nextAnteHandler)
+	require.ErrorIs(t, err, types.ErrUnauthorizedBlobSigner)
+
+	k.ClearBlockStartRegistrations(ctx)
+	_, err = decorator.AnteHandle(ctx, newPFBTx(t, other, namespace), false, nextAnteHandler)
+	require.NoError(t, err)
+}
+
+func newPFBTx(t *testing.T, signer sdk.AccAddress, namespaces ...share.Namespace) sdk.Tx {
+	blobs := make([]*share.Blob, len(namespaces))
+	for i, ns := range namespaces {
+		blobs[i] = testfactory.GenerateBlobsWithNamespace(1, 100, ns)[0]
+	}
+	pfb, err := blobtypes.NewMsgPayForBlobs(signer.String(), 0, blobs...)
+	require.NoError(t, err)
+	return mockTx{msgs: []sdk.Msg{pfb}}
+}
+
+func nextAnteHandler(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
+
+type mockTx struct {
+	sdk.Tx
+	msgs []sdk.Msg
+}
+
+func (m mockTx) GetMsgs() []sdk.Msg {
+	return m.msgs
+}
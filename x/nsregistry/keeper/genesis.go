@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/celestia-app/v4/x/nsregistry/types"
+)
+
+// InitGenesis initializes the nsregistry module's state from a provided
+// genesis state. The deposits of the registrations are expected to be held by
+// the module account in the bank genesis state.
+func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := genState.Validate(); err != nil {
+		return fmt.Errorf("invalid nsregistry genesis state: %w", err)
+	}
+
+	k.SetParams(sdkCtx, genState.Params)
+	for _, registration := range genState.Registrations {
+		namespace, err := types.ParseNamespace(registration.Namespace)
+		if err != nil {
+			return err
+		}
+		k.SetRegistration(sdkCtx, namespace, registration)
+	}
+	return nil
+}
+
+// ExportGenesis returns the nsregistry module's exported genesis.
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &types.GenesisState{
+		Params:        k.GetParams(sdkCtx),
+		Registrations: k.GetAllRegistrations(sdkCtx),
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/celestiaorg/celestia-app/v4/x/nsregistry/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Registration returns the registration of a namespace.
+func (k Keeper) Registration(c context.Context, req *types.QueryRegistrationRequest) (*types.QueryRegistrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	namespace, err := types.ParseNamespace(req.Namespace)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	registration, found := k.GetRegistration(sdk.UnwrapSDKContext(c), namespace)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "namespace %X is not registered", req.Namespace)
+	}
+	return &types.QueryRegistrationResponse{Registration: registration}, nil
+}
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
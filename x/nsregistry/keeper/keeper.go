@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/x/nsregistry/types"
+)
+
+// Keeper handles the namespace registrations.
+type Keeper struct {
+	cdc        codec.Codec
+	storeKey   storetypes.StoreKey
+	bankKeeper types.BankKeeper
+	authority  string
+}
+
+func NewKeeper(
+	cdc codec.Codec,
+	storeKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	authority string,
+) *Keeper {
+	return &Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+		authority:  authority,
+	}
+}
+
+// GetAuthority returns the client submodule's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetRegistration returns the registration of the namespace, if any.
+func (k Keeper) GetRegistration(ctx sdk.Context, namespace share.Namespace) (types.Registration, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RegistrationKey(namespace))
+	if bz == nil {
+		return types.Registration{}, false
+	}
+	var registration types.Registration
+	k.cdc.MustUnmarshal(bz, &registration)
+	return registration, true
+}
+
+// SetRegistration stores the registration of a namespace.
+func (k Keeper) SetRegistration(ctx sdk.Context, namespace share.Namespace, registration types.Registration) {
+	ctx.KVStore(k.storeKey).Set(types.RegistrationKey(namespace), k.cdc.MustMarshal(&registration))
+}
+
+// DeleteRegistration deletes the registration of a namespace.
+func (k Keeper) DeleteRegistration(ctx sdk.Context, namespace share.Namespace) {
+	ctx.KVStore(k.storeKey).Delete(types.RegistrationKey(namespace))
+}
+
+// GetBlockStartRegistration returns the registration of the namespace as of
+// the beginning of the current block, if any. The changes made to the
+// registrations by the messages of a block apply to the blobs of the next
+// blocks so that the blobs of a block are checked against the same
+// registrations when the block is proposed and when it is executed.
+func (k Keeper) GetBlockStartRegistration(ctx sdk.Context, namespace share.Namespace) (types.Registration, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockStartRegistrationKey(namespace))
+	if bz == nil {
+		return k.GetRegistration(ctx, namespace)
+	}
+	// the namespace was registered during the block
+	if len(bz) == 0 {
+		return types.Registration{}, false
+	}
+	var registration types.Registration
+	k.cdc.MustUnmarshal(bz, &registration)
+	return registration, true
+}
+
+// snapshotRegistration records the registration of the namespace as of the
+// beginning of the block, if it wasn't already, before a message changes it.
+func (k Keeper) snapshotRegistration(ctx sdk.Context, namespace share.Namespace) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.BlockStartRegistrationKey(namespace)
+	if store.Has(key) {
+		return
+	}
+	bz := store.Get(types.RegistrationKey(namespace))
+	if bz == nil {
+		bz = []byte{}
+	}
+	store.Set(key, bz)
+}
+
+// ClearBlockStartRegistrations deletes the registrations recorded as of the
+// beginning of the block so that the changes of the block apply from the next
+// block on.
+func (k Keeper) ClearBlockStartRegistrations(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.BlockStartRegistrationPrefix)
+	keys := make([][]byte, 0)
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAllRegistrations returns the registrations of all the namespaces ordered
+// by namespace.
+func (k Keeper) GetAllRegistrations(ctx sdk.Context) []types.Registration {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.RegistrationPrefix)
+	defer it.Close()
+
+	registrations := make([]types.Registration, 0)
+	for ; it.Valid(); it.Next() {
+		var registration types.Registration
+		k.cdc.MustUnmarshal(it.Value(), &registration)
+		registrations = append(registrations, registration)
+	}
+	return registrations
+}
@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/x/nsregistry/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RegisterNamespace registers a namespace to the owner and escrows the
+// registration deposit. Governance registers without a deposit so that it can
+// reserve a namespace, such as one used by a rollup before the module existed,
+// and then transfer it to its rightful owner.
+func (k Keeper) RegisterNamespace(goCtx context.Context, msg *types.MsgRegisterNamespace) (*types.MsgRegisterNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	namespace, err := types.ParseNamespace(msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetRegistration(ctx, namespace); found {
+		return nil, errors.Wrapf(types.ErrNamespaceRegistered, "namespace %X", msg.Namespace)
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := k.GetParams(ctx).RegistrationDeposit
+	if owner.String() == k.GetAuthority() {
+		deposit = sdk.NewCoin(deposit.Denom, math.ZeroInt())
+	}
+	if deposit.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(deposit)); err != nil {
+			return nil, errors.Wrap(err, "escrowing registration deposit")
+		}
+	}
+
+	registration := types.Registration{
+		Namespace:      namespace.Bytes(),
+		Owner:          owner.String(),
+		Deposit:        deposit,
+		AllowedSigners: normalizeAddresses(msg.AllowedSigners),
+	}
+	k.snapshotRegistration(ctx, namespace)
+	k.SetRegistration(ctx, namespace, registration)
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewRegisterNamespaceEvent(registration)); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterNamespaceResponse{}, nil
+}
+
+// TransferNamespace transfers the ownership of a namespace, including the
+// right to the refund of its deposit.
+func (k Keeper) TransferNamespace(goCtx context.Context, msg *types.MsgTransferNamespace) (*types.MsgTransferNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	namespace, registration, err := k.ownedRegistration(ctx, msg.Namespace, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := registration.Owner
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+	registration.Owner = newOwner.String()
+	k.snapshotRegistration(ctx, namespace)
+	k.SetRegistration(ctx, namespace, registration)
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewTransferNamespaceEvent(registration.Namespace, owner, registration.Owner)); err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferNamespaceResponse{}, nil
+}
+
+// UpdateAllowedSigners replaces the accounts allowed to pay for blobs in a
+// namespace.
+func (k Keeper) UpdateAllowedSigners(goCtx context.Context, msg *types.MsgUpdateAllowedSigners) (*types.MsgUpdateAllowedSignersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	namespace, registration, err := k.ownedRegistration(ctx, msg.Namespace, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	registration.AllowedSigners = normalizeAddresses(msg.AllowedSigners)
+	k.snapshotRegistration(ctx, namespace)
+	k.SetRegistration(ctx, namespace, registration)
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewUpdateAllowedSignersEvent(registration.Namespace, registration.AllowedSigners)); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateAllowedSignersResponse{}, nil
+}
+
+// ReleaseNamespace deletes the registration of a namespace and refunds its
+// deposit to the owner.
+func (k Keeper) ReleaseNamespace(goCtx context.Context, msg *types.MsgReleaseNamespace) (*types.MsgReleaseNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	namespace, registration, err := k.ownedRegistration(ctx, msg.Namespace, msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if registration.Deposit.IsPositive() {
+		owner, err := sdk.AccAddressFromBech32(registration.Owner)
+		if err != nil {
+			return nil, err
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(registration.Deposit)); err != nil {
+			return nil, errors.Wrap(err, "refunding registration deposit")
+		}
+	}
+	k.snapshotRegistration(ctx, namespace)
+	k.DeleteRegistration(ctx, namespace)
+
+	if err := ctx.EventManager().EmitTypedEvent(types.NewReleaseNamespaceEvent(registration.Namespace, registration.Owner)); err != nil {
+		return nil, err
+	}
+	return &types.MsgReleaseNamespaceResponse{}, nil
+}
+
+// UpdateNsregistryParams updates nsregistry module parameters.
+func (k Keeper) UpdateNsregistryParams(goCtx context.Context, msg *types.MsgUpdateNsregistryParams) (*types.MsgUpdateNsregistryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// ensure that the sender has the authority to update the parameters.
+	if msg.Authority != k.GetAuthority() {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority: expected: %s, got: %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid parameters: %s", err)
+	}
+
+	k.SetParams(ctx, msg.Params)
+
+	// Emit an event indicating successful parameter update.
+	if err := ctx.EventManager().EmitTypedEvent(
+		types.NewUpdateNsregistryParamsEvent(msg.Authority, msg.Params),
+	); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateNsregistryParamsResponse{}, nil
+}
+
+// ownedRegistration returns the registration of the namespace if it is owned
+// by owner.
+func (k Keeper) ownedRegistration(ctx sdk.Context, bz []byte, owner string) (share.Namespace, types.Registration, error) {
+	namespace, err := types.ParseNamespace(bz)
+	if err != nil {
+		return share.Namespace{}, types.Registration{}, err
+	}
+	registration, found := k.GetRegistration(ctx, namespace)
+	if !found {
+		return share.Namespace{}, types.Registration{}, errors.Wrapf(types.ErrNamespaceNotRegistered, "namespace %X", bz)
+	}
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return share.Namespace{}, types.Registration{}, err
+	}
+	if registration.Owner != ownerAddr.String() {
+		return share.Namespace{}, types.Registration{}, errors.Wrapf(types.ErrNotNamespaceOwner, "namespace %X is owned by %s", bz, registration.Owner)
+	}
+	return namespace, registration, nil
+}
+
+// normalizeAddresses returns the canonical form of the validated bech32
+// addresses so that they can be compared as strings.
+func normalizeAddresses(addresses []string) []string {
+	out := make([]string, len(addresses))
+	for i, address := range addresses {
+		out[i] = sdk.MustAccAddressFromBech32(address).String()
+	}
+	return out
+}
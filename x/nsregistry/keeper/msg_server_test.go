@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	"github.com/celestiaorg/celestia-app/v4/app"
+	testutil "github.com/celestiaorg/celestia-app/v4/test/util"
+	"github.com/celestiaorg/celestia-app/v4/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v4/x/nsregistry/types"
+)
+
+func TestNamespaceLifecycle(t *testing.T) {
+	testApp, _, kr := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams(), "owner", "newOwner", "signer")
+	ctx := testApp.NewContext(false)
+	k := testApp.NsRegistryKeeper
+	owner := testfactory.GetAddress(kr, "owner")
+	newOwner := testfactory.GetAddress(kr, "newOwner")
+	signer := testfactory.GetAddress(kr, "signer")
+	namespace := share.RandomBlobNamespace()
+	deposit := k.GetParams(ctx).RegistrationDeposit
+
+	balance := testApp.BankKeeper.GetBalance(ctx, owner, deposit.Denom)
+	_, err := k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, namespace, signer))
+	require.NoError(t, err)
+	assert.Equal(t, balance.Sub(deposit), testApp.BankKeeper.GetBalance(ctx, owner, deposit.Denom))
+
+	resp, err := k.Registration(ctx, &types.QueryRegistrationRequest{Namespace: namespace.Bytes()})
+	require.NoError(t, err)
+	assert.Equal(t, owner.String(), resp.Registration.Owner)
+	assert.Equal(t, []string{signer.String()}, resp.Registration.AllowedSigners)
+
+	// a namespace can only be registered once
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(newOwner, namespace))
+	require.ErrorIs(t, err, types.ErrNamespaceRegistered)
+
+	// only the owner can manage the namespace
+	_, err = k.TransferNamespace(ctx, types.NewMsgTransferNamespace(signer, namespace, signer))
+	require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	_, err = k.TransferNamespace(ctx, types.NewMsgTransferNamespace(owner, namespace, newOwner))
+	require.NoError(t, err)
+	_, err = k.UpdateAllowedSigners(ctx, types.NewMsgUpdateAllowedSigners(owner, namespace))
+	require.ErrorIs(t, err, types.ErrNotNamespaceOwner)
+	_, err = k.UpdateAllowedSigners(ctx, types.NewMsgUpdateAllowedSigners(newOwner, namespace, owner))
+	require.NoError(t, err)
+
+	registration, found := k.GetRegistration(ctx, namespace)
+	require.True(t, found)
+	assert.True(t, registration.IsAllowed(newOwner.String()))
+	assert.True(t, registration.IsAllowed(owner.String()))
+	assert.False(t, registration.IsAllowed(signer.String()))
+
+	// the deposit is refunded to the owner at the time of the release
+	newOwnerBalance := testApp.BankKeeper.GetBalance(ctx, newOwner, deposit.Denom)
+	_, err = k.ReleaseNamespace(ctx, types.NewMsgReleaseNamespace(newOwner, namespace))
+	require.NoError(t, err)
+	assert.Equal(t, newOwnerBalance.Add(deposit), testApp.BankKeeper.GetBalance(ctx, newOwner, deposit.Denom))
+	_, found = k.GetRegistration(ctx, namespace)
+	assert.False(t, found)
+	_, err = k.ReleaseNamespace(ctx, types.NewMsgReleaseNamespace(newOwner, namespace))
+	require.ErrorIs(t, err, types.ErrNamespaceNotRegistered)
+}
+
+func TestReserveNamespace(t *testing.T) {
+	testApp, _, kr := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams(), "owner")
+	ctx := testApp.NewContext(false)
+	k := testApp.NsRegistryKeeper
+	owner := testfactory.GetAddress(kr, "owner")
+	namespace := share.RandomBlobNamespace()
+
+	// governance reserves a namespace without a deposit and hands it over
+	authority, err := sdk.AccAddressFromBech32(k.GetAuthority())
+	require.NoError(t, err)
+	_, err = k.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(authority, namespace))
+	require.NoError(t, err)
+	registration, found := k.GetRegistration(ctx, namespace)
+	require.True(t, found)
+	assert.True(t, registration.Deposit.IsZero())
+	_, err = k.TransferNamespace(ctx, types.NewMsgTransferNamespace(authority, namespace, owner))
+	require.NoError(t, err)
+}
@@ -0,0 +1,15 @@
+package types
+
+// DONTCOVER
+
+import (
+	"cosmossdk.io/errors"
+)
+
+var (
+	ErrNamespaceRegistered    = errors.Register(ModuleName, 12110, "namespace is already registered")
+	ErrNamespaceNotRegistered = errors.Register(ModuleName, 12111, "namespace is not registered")
+	ErrNotNamespaceOwner      = errors.Register(ModuleName, 12112, "signer is not the owner of the namespace")
+	ErrTooManyAllowedSigners  = errors.Register(ModuleName, 12113, "too many allowed signers")
+	ErrUnauthorizedBlobSigner = errors.Register(ModuleName, 12114, "signer is not allowed to pay for blobs in the namespace")
+)
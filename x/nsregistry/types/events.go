@@ -0,0 +1,43 @@
+package types
+
+// NewRegisterNamespaceEvent returns a new EventRegisterNamespace
+func NewRegisterNamespaceEvent(registration Registration) *EventRegisterNamespace {
+	return &EventRegisterNamespace{
+		Namespace: registration.Namespace,
+		Owner:     registration.Owner,
+		Deposit:   registration.Deposit,
+	}
+}
+
+// NewTransferNamespaceEvent returns a new EventTransferNamespace
+func NewTransferNamespaceEvent(namespace []byte, owner, newOwner string) *EventTransferNamespace {
+	return &EventTransferNamespace{
+		Namespace: namespace,
+		Owner:     owner,
+		NewOwner:  newOwner,
+	}
+}
+
+// NewUpdateAllowedSignersEvent returns a new EventUpdateAllowedSigners
+func NewUpdateAllowedSignersEvent(namespace []byte, allowedSigners []string) *EventUpdateAllowedSigners {
+	return &EventUpdateAllowedSigners{
+		Namespace:      namespace,
+		AllowedSigners: allowedSigners,
+	}
+}
+
+// NewReleaseNamespaceEvent returns a new EventReleaseNamespace
+func NewReleaseNamespaceEvent(namespace []byte, owner string) *EventReleaseNamespace {
+	return &EventReleaseNamespace{
+		Namespace: namespace,
+		Owner:     owner,
+	}
+}
+
+// NewUpdateNsregistryParamsEvent returns a new EventUpdateNsregistryParams
+func NewUpdateNsregistryParamsEvent(authority string, params Params) *EventUpdateNsregistryParams {
+	return &EventUpdateNsregistryParams{
+		Signer: authority,
+		Params: params,
+	}
+}
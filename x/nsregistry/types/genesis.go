@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(gs.Registrations))
+	for _, registration := range gs.Registrations {
+		if err := registration.Validate(); err != nil {
+			return err
+		}
+		if seen[string(registration.Namespace)] {
+			return fmt.Errorf("duplicate registration of namespace %X", registration.Namespace)
+		}
+		seen[string(registration.Namespace)] = true
+	}
+	return nil
+}
+
+// Validate returns an error if the registration is malformed.
+func (r Registration) Validate() error {
+	if _, err := ParseNamespace(r.Namespace); err != nil {
+		return err
+	}
+	if err := validateAddress(r.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	if err := r.Deposit.Validate(); err != nil {
+		return fmt.Errorf("invalid deposit: %w", err)
+	}
+	return ValidateAllowedSigners(r.AllowedSigners)
+}
+
+// IsAllowed returns true if signer may pay for blobs in the namespace of the
+// registration.
+func (r Registration) IsAllowed(signer string) bool {
+	if len(r.AllowedSigners) == 0 || signer == r.Owner {
+		return true
+	}
+	for _, allowed := range r.AllowedSigners {
+		if allowed == signer {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+package types
+
+import "github.com/celestiaorg/go-square/v2/share"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "nsregistry"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// ParamsKey defines the key used for storing module parameters
+	ParamsKey = "params"
+)
+
+var (
+	// RegistrationPrefix prefixes the keys of the namespace registrations.
+	RegistrationPrefix = []byte{0x01}
+	// BlockStartRegistrationPrefix prefixes the keys of the registrations as
+	// of the beginning of the current block of the namespaces whose
+	// registration changed during the block.
+	BlockStartRegistrationPrefix = []byte{0x02}
+)
+
+// RegistrationKey returns the store key of the registration of a namespace.
+func RegistrationKey(namespace share.Namespace) []byte {
+	return append(append([]byte{}, RegistrationPrefix...), namespace.Bytes()...)
+}
+
+// BlockStartRegistrationKey returns the store key of the registration of a
+// namespace as of the beginning of the current block.
+func BlockStartRegistrationKey(namespace share.Namespace) []byte {
+	return append(append([]byte{}, BlockStartRegistrationPrefix...), namespace.Bytes()...)
+}
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/celestiaorg/go-square/v2/share"
+
+	blobtypes "github.com/celestiaorg/celestia-app/v4/x/blob/types"
+)
+
+// MaxAllowedSigners is the max number of accounts that can be allowed to pay
+// for blobs in a namespace in addition to its owner.
+const MaxAllowedSigners = 100
+
+var (
+	_ sdk.Msg = &MsgRegisterNamespace{}
+	_ sdk.Msg = &MsgTransferNamespace{}
+	_ sdk.Msg = &MsgUpdateAllowedSigners{}
+	_ sdk.Msg = &MsgReleaseNamespace{}
+	_ sdk.Msg = &MsgUpdateNsregistryParams{}
+)
+
+// NewMsgRegisterNamespace returns a MsgRegisterNamespace registering the
+// namespace to owner.
+func NewMsgRegisterNamespace(owner sdk.AccAddress, namespace share.Namespace, allowedSigners ...sdk.AccAddress) *MsgRegisterNamespace {
+	return &MsgRegisterNamespace{
+		Owner:          owner.String(),
+		Namespace:      namespace.Bytes(),
+		AllowedSigners: addressStrings(allowedSigners),
+	}
+}
+
+// ValidateBasic performs stateless validation of the message.
+func (msg *MsgRegisterNamespace) ValidateBasic() error {
+	if err := validateAddress(msg.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner: %s", err)
+	}
+	if _, err := ParseNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateAllowedSigners(msg.AllowedSigners)
+}
+
+// NewMsgTransferNamespace returns a MsgTransferNamespace transferring the
+// namespace from owner to newOwner.
+func NewMsgTransferNamespace(owner sdk.AccAddress, namespace share.Namespace, newOwner sdk.AccAddress) *MsgTransferNamespace {
+	return &MsgTransferNamespace{
+		Owner:     owner.String(),
+		Namespace: namespace.Bytes(),
+		NewOwner:  newOwner.String(),
+	}
+}
+
+// ValidateBasic performs stateless validation of the message.
+func (msg *MsgTransferNamespace) ValidateBasic() error {
+	if err := validateAddress(msg.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner: %s", err)
+	}
+	if err := validateAddress(msg.NewOwner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner: %s", err)
+	}
+	_, err := ParseNamespace(msg.Namespace)
+	return err
+}
+
+// NewMsgUpdateAllowedSigners returns a MsgUpdateAllowedSigners replacing the
+// allowed signers of the namespace.
+func NewMsgUpdateAllowedSigners(owner sdk.AccAddress, namespace share.Namespace, allowedSigners ...sdk.AccAddress) *MsgUpdateAllowedSigners {
+	return &MsgUpdateAllowedSigners{
+		Owner:          owner.String(),
+		Namespace:      namespace.Bytes(),
+		AllowedSigners: addressStrings(allowedSigners),
+	}
+}
+
+// ValidateBasic performs stateless validation of the message.
+func (msg *MsgUpdateAllowedSigners) ValidateBasic() error {
+	if err := validateAddress(msg.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner: %s", err)
+	}
+	if _, err := ParseNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateAllowedSigners(msg.AllowedSigners)
+}
+
+// NewMsgReleaseNamespace returns a MsgReleaseNamespace releasing the
+// namespace.
+func NewMsgReleaseNamespace(owner sdk.AccAddress, namespace share.Namespace) *MsgReleaseNamespace {
+	return &MsgReleaseNamespace{
+		Owner:     owner.String(),
+		Namespace: namespace.Bytes(),
+	}
+}
+
+// ValidateBasic performs stateless validation of the message.
+func (msg *MsgReleaseNamespace) ValidateBasic() error {
+	if err := validateAddress(msg.Owner); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner: %s", err)
+	}
+	_, err := ParseNamespace(msg.Namespace)
+	return err
+}
+
+// ParseNamespace returns the namespace if it can be used by blobs, and hence
+// registered.
+func ParseNamespace(bz []byte) (share.Namespace, error) {
+	ns, err := share.NewNamespaceFromBytes(bz)
+	if err != nil {
+		return share.Namespace{}, errors.Wrap(blobtypes.ErrInvalidNamespace, err.Error())
+	}
+	if err := blobtypes.ValidateBlobNamespace(ns); err != nil {
+		return share.Namespace{}, err
+	}
+	return ns, nil
+}
+
+// ValidateAllowedSigners returns an error if the allowed signers are not
+// unique valid addresses or if there are too many of them.
+func ValidateAllowedSigners(allowedSigners []string) error {
+	if len(allowedSigners) > MaxAllowedSigners {
+		return errors.Wrapf(ErrTooManyAllowedSigners, "%d allowed signers exceed the max of %d", len(allowedSigners), MaxAllowedSigners)
+	}
+	seen := make(map[string]bool, len(allowedSigners))
+	for _, signer := range allowedSigners {
+		addr, err := sdk.AccAddressFromBech32(signer)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid allowed signer %s: %s", signer, err)
+		}
+		if seen[addr.String()] {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate allowed signer %s", signer)
+		}
+		seen[addr.String()] = true
+	}
+	return nil
+}
+
+func validateAddress(address string) error {
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return fmt.Errorf("%s: %w", address, err)
+	}
+	return nil
+}
+
+func addressStrings(addresses []sdk.AccAddress) []string {
+	out := make([]string, len(addresses))
+	for i, address := range addresses {
+		out[i] = address.String()
+	}
+	return out
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/celestiaorg/celestia-app/v4/pkg/appconsts"
+)
+
+// DefaultRegistrationDeposit is the default deposit escrowed when a namespace
+// is registered.
+var DefaultRegistrationDeposit = sdk.NewInt64Coin(appconsts.BondDenom, 100_000_000)
+
+// DefaultParams returns the default parameters for the module.
+func DefaultParams() Params {
+	return NewParams(DefaultRegistrationDeposit)
+}
+
+// NewParams creates a new instance of Params with the provided registration
+// deposit.
+func NewParams(registrationDeposit sdk.Coin) Params {
+	return Params{
+		RegistrationDeposit: registrationDeposit,
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := p.RegistrationDeposit.Validate(); err != nil {
+		return fmt.Errorf("invalid registration deposit: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,497 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/nsregistry/v1/registration.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Registration is the ownership record of a namespace.
+type Registration struct {
+	// namespace is the 29 byte namespace that is registered.
+	Namespace []byte `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// owner is the bech32 encoded address of the account owning the namespace.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// deposit is the deposit escrowed when the namespace was registered.
+	Deposit types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit"`
+	// allowed_signers are the bech32 encoded addresses of the accounts, in
+	// addition to the owner, allowed to pay for blobs in the namespace. If empty,
+	// any account can pay for blobs in the namespace.
+	AllowedSigners []string `protobuf:"bytes,4,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+}
+
+func (m *Registration) Reset()         { *m = Registration{} }
+func (m *Registration) String() string { return proto.CompactTextString(m) }
+func (*Registration) ProtoMessage()    {}
+func (*Registration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_aab78bbc7b33496b, []int{0}
+}
+func (m *Registration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Registration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Registration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Registration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Registration.Merge(m, src)
+}
+func (m *Registration) XXX_Size() int {
+	return m.Size()
+}
+func (m *Registration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Registration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Registration proto.InternalMessageInfo
+
+func (m *Registration) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *Registration) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Registration) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *Registration) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Registration)(nil), "celestia.nsregistry.v1.Registration")
+}
+
+func init() {
+	proto.RegisterFile("celestia/nsregistry/v1/registration.proto", fileDescriptor_aab78bbc7b33496b)
+}
+
+var fileDescriptor_aab78bbc7b33496b = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0x03, 0x31,
+	0x10, 0x86, 0x37, 0xb6, 0x2a, 0x5d, 0x8b, 0xc2, 0x52, 0x64, 0x5b, 0x24, 0x2e, 0x9e, 0xea, 0xa1,
+	0x09, 0xab, 0x20, 0x78, 0x6c, 0x7d, 0x01, 0xd9, 0xde, 0xbc, 0x94, 0xec, 0x6e, 0x88, 0x81, 0x36,
+	0xb3, 0x24, 0xb1, 0xb5, 0x6f, 0xe1, 0xc3, 0xf8, 0x10, 0x3d, 0x16, 0x4f, 0xe2, 0x41, 0xa4, 0x7d,
+	0x11, 0x69, 0xb3, 0x6b, 0x7b, 0xf2, 0xf6, 0x27, 0xf3, 0xcd, 0xcc, 0xcf, 0x3f, 0xfe, 0x75, 0xc6,
+	0xc7, 0xdc, 0x58, 0xc9, 0xa8, 0x32, 0x9a, 0x0b, 0x69, 0xac, 0x9e, 0xd3, 0x69, 0x4c, 0x4b, 0xcd,
+	0xac, 0x04, 0x45, 0x0a, 0x0d, 0x16, 0x82, 0xf3, 0x0a, 0x25, 0x3b, 0x94, 0x4c, 0xe3, 0x4e, 0x4b,
+	0x80, 0x80, 0x2d, 0x42, 0x37, 0xca, 0xd1, 0x1d, 0x9c, 0x81, 0x99, 0x80, 0xa1, 0x29, 0x33, 0x9c,
+	0x4e, 0xe3, 0x94, 0x5b, 0x16, 0xd3, 0x0c, 0x64, 0x39, 0xad, 0xd3, 0x76, 0xf5, 0x91, 0x6b, 0x74,
+	0x0f, 0x57, 0xba, 0xfa, 0x42, 0x7e, 0x33, 0xd9, 0xdb, 0x1f, 0x5c, 0xf8, 0x0d, 0xc5, 0x26, 0xdc,
+	0x14, 0x2c, 0xe3, 0x21, 0x8a, 0x50, 0xb7, 0x99, 0xec, 0x3e, 0x02, 0xe2, 0x1f, 0xc2, 0x4c, 0x71,
+	0x1d, 0x1e, 0x44, 0xa8, 0xdb, 0x18, 0x84, 0x1f, 0xef, 0xbd, 0x56, 0x39, 0xaf, 0x9f, 0xe7, 0x9a,
+	0x1b, 0x33, 0xb4, 0x5a, 0x2a, 0x91, 0x38, 0x2c, 0xb8, 0xf7, 0x8f, 0x73, 0x5e, 0x80, 0x91, 0x36,
+	0xac, 0x45, 0xa8, 0x7b, 0x72, 0xd3, 0x26, 0x25, 0xbe, 0xf1, 0x4a, 0x4a, 0xaf, 0xe4, 0x01, 0xa4,
+	0x1a, 0xd4, 0x17, 0xdf, 0x97, 0x5e, 0x52, 0xf1, 0x41, 0xdf, 0x3f, 0x63, 0xe3, 0x31, 0xcc, 0x78,
+	0x3e, 0x32, 0x52, 0x28, 0xae, 0x4d, 0x58, 0x8f, 0x6a, 0xff, 0x2e, 0x3d, 0x2d, 0x1b, 0x86, 0x8e,
+	0x1f, 0x3c, 0x2e, 0x56, 0x18, 0x2d, 0x57, 0x18, 0xfd, 0xac, 0x30, 0x7a, 0x5b, 0x63, 0x6f, 0xb9,
+	0xc6, 0xde, 0xe7, 0x1a, 0x7b, 0x4f, 0x77, 0x42, 0xda, 0xe7, 0x97, 0x94, 0x64, 0x30, 0xa1, 0x55,
+	0xd4, 0xa0, 0xc5, 0x9f, 0xee, 0xb1, 0xa2, 0xa0, 0xaf, 0xfb, 0x77, 0xb2, 0xf3, 0x82, 0x9b, 0xf4,
+	0x68, 0x9b, 0xda, 0xed, 0xef, 0x00, 0xfe, 0x43, 0x50, 0x34, 0xcb, 0x01, 0x00, 0x00,
+}
+
+func (m *Registration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Registration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Registration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintRegistration(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRegistration(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintRegistration(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintRegistration(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRegistration(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRegistration(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Registration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovRegistration(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovRegistration(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovRegistration(uint64(l))
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovRegistration(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRegistration(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRegistration(x uint64) (n int) {
+	return sovRegistration(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Registration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Registration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Registration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRegistration
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRegistration
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistration
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRegistration(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRegistration
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistration
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRegistration
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRegistration
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRegistration
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRegistration        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRegistration          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRegistration = fmt.Errorf("proto: unexpected end of group")
+)
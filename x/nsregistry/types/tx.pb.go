@@ -0,0 +1,2312 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/nsregistry/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgRegisterNamespace registers a namespace.
+type MsgRegisterNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// allowed_signers are the accounts, in addition to the owner, allowed to pay
+	// for blobs in the namespace. If empty, any account can.
+	AllowedSigners []string `protobuf:"bytes,3,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+}
+
+func (m *MsgRegisterNamespace) Reset()         { *m = MsgRegisterNamespace{} }
+func (m *MsgRegisterNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterNamespace) ProtoMessage()    {}
+func (*MsgRegisterNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d39e549766f5ba6, []int{0}
+}
+func (m *MsgRegisterNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterNamespace.Merge(m, src)
+}
+func (m *MsgRegisterNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterNamespace proto.InternalMessageInfo
+
+func (m *MsgRegisterNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRegisterNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *MsgRegisterNamespace) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+// MsgRegisterNamespaceResponse is the RegisterNamespace response.
+type MsgRegisterNamespaceResponse struct {
+}
+
+func (m *MsgRegisterNamespaceResponse) Reset()         { *m = MsgRegisterNamespaceResponse{} }
+func (m *MsgRegisterNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterNamespaceResponse) ProtoMessage()    {}
+func (*MsgRegisterNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d39e549766f5ba6, []int{1}
+}
+func (m *MsgRegisterNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterNamespaceResponse.Merge(m, src)
+}
+func (m *MsgRegisterNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterNamespaceResponse proto.InternalMessageInfo
+
+// MsgTransferNamespace transfers the ownership of a namespace.
+type MsgTransferNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NewOwner  string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *MsgTransferNamespace) Reset()         { *m = MsgTransferNamespace{} }
+func (m *MsgTransferNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNamespace) ProtoMessage()    {}
+func (*MsgTransferNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d39e549766f5ba6, []int{2}
+}
+func (m *MsgTransferNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNamespace.Merge(m, src)
+}
+func (m *MsgTransferNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferNamespace proto.InternalMessageInfo
+
+func (m *MsgTransferNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgTransferNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *MsgTransferNamespace) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+// MsgTransferNamespaceResponse is the TransferNamespace response.
+type MsgTransferNamespaceResponse struct {
+}
+
+func (m *MsgTransferNamespaceResponse) Reset()         { *m = MsgTransferNamespaceResponse{} }
+func (m *MsgTransferNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferNamespaceResponse) ProtoMessage()    {}
+func (*MsgTransferNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d39e549766f5ba6, []int{3}
+}
+func (m *MsgTransferNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferNamespaceResponse.Merge(m, src)
+}
+func (m *MsgTransferNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferNamespaceResponse proto.InternalMessageInfo
+
+// MsgUpdateAllowedSigners replaces the accounts allowed to pay for blobs in a
+// namespace.
+type MsgUpdateAllowedSigners struct {
+	Owner          string   `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace      []byte   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	AllowedSigners []string `protobuf:"bytes,3,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+}
+
+func (m *MsgUpdateAllowedSigners) Reset()         { *m = MsgUpdateAllowedSigners{} }
+func (m *MsgUpdateAllowedSigners) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowedSigners) ProtoMessage()    {}
+func (*MsgUpdateAllowedSigners) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d39e549766f5ba6, []int{4}
+}
+func (m *MsgUpdateAllowedSigners) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowedSigners) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowedSigners.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowedSigners) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowedSigners.Merge(m, src)
+}
+func (m *MsgUpdateAllowedSigners) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowedSigners) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowedSigners.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowedSigners proto.InternalMessageInfo
+
+func (m *MsgUpdateAllowedSigners) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUpdateAllowedSigners) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+func (m *MsgUpdateAllowedSigners) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+// MsgUpdateAllowedSignersResponse is the UpdateAllowedSigners response.
+type MsgUpdateAllowedSignersResponse struct {
+}
+
+func (m *MsgUpdateAllowedSignersResponse) Reset()         { *m = MsgUpdateAllowedSignersResponse{} }
+func (m *MsgUpdateAllowedSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAllowedSignersResponse) ProtoMessage()    {}
+func (*MsgUpdateAllowedSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d39e549766f5ba6, []int{5}
+}
+func (m *MsgUpdateAllowedSignersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAllowedSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAllowedSignersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAllowedSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAllowedSignersResponse.Merge(m, src)
+}
+func (m *MsgUpdateAllowedSignersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAllowedSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAllowedSignersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAllowedSignersResponse proto.InternalMessageInfo
+
+// MsgReleaseNamespace releases a namespace.
+type MsgReleaseNamespace struct {
+	Owner     string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Namespace []byte `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+}
+
+func (m *MsgReleaseNamespace) Reset()         { *m = MsgReleaseNamespace{} }
+func (m *MsgReleaseNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseNamespace) ProtoMessage()    {}
+func (*MsgReleaseNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d39e549766f5ba6, []int{6}
+}
+func (m *MsgReleaseNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseNamespace.Merge(m, src)
+}
+func (m *MsgReleaseNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseNamespace proto.InternalMessageInfo
+
+func (m *MsgReleaseNamespace) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgReleaseNamespace) GetNamespace() []byte {
+	if m != nil {
+		return m.Namespace
+	}
+	return nil
+}
+
+// MsgReleaseNamespaceResponse is the ReleaseNamespace response.
+type MsgReleaseNamespaceResponse struct {
+}
+
+func (m *MsgReleaseNamespaceResponse) Reset()         { *m = MsgReleaseNamespaceResponse{} }
+func (m *MsgReleaseNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseNamespaceResponse) ProtoMessage()    {}
+func (*MsgReleaseNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d39e549766f5ba6, []int{7}
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseNamespaceResponse.Merge(m, src)
+}
+func (m *MsgReleaseNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseNamespaceResponse proto.InternalMessageInfo
+
+// MsgUpdateNsregistryParams defines a message for updating the nsregistry
+// parameters.
+type MsgUpdateNsregistryParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the nsregistry parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateNsregistryParams) Reset()         { *m = MsgUpdateNsregistryParams{} }
+func (m *MsgUpdateNsregistryParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNsregistryParams) ProtoMessage()    {}
+func (*MsgUpdateNsregistryParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d39e549766f5ba6, []int{8}
+}
+func (m *MsgUpdateNsregistryParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNsregistryParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNsregistryParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateNsregistryParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNsregistryParams.Merge(m, src)
+}
+func (m *MsgUpdateNsregistryParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNsregistryParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNsregistryParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateNsregistryParams proto.InternalMessageInfo
+
+func (m *MsgUpdateNsregistryParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateNsregistryParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateNsregistryParamsResponse is the UpdateNsregistryParams response.
+type MsgUpdateNsregistryParamsResponse struct {
+}
+
+func (m *MsgUpdateNsregistryParamsResponse) Reset()         { *m = MsgUpdateNsregistryParamsResponse{} }
+func (m *MsgUpdateNsregistryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNsregistryParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateNsregistryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d39e549766f5ba6, []int{9}
+}
+func (m *MsgUpdateNsregistryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNsregistryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNsregistryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateNsregistryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNsregistryParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateNsregistryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNsregistryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNsregistryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateNsregistryParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterNamespace)(nil), "celestia.nsregistry.v1.MsgRegisterNamespace")
+	proto.RegisterType((*MsgRegisterNamespaceResponse)(nil), "celestia.nsregistry.v1.MsgRegisterNamespaceResponse")
+	proto.RegisterType((*MsgTransferNamespace)(nil), "celestia.nsregistry.v1.MsgTransferNamespace")
+	proto.RegisterType((*MsgTransferNamespaceResponse)(nil), "celestia.nsregistry.v1.MsgTransferNamespaceResponse")
+	proto.RegisterType((*MsgUpdateAllowedSigners)(nil), "celestia.nsregistry.v1.MsgUpdateAllowedSigners")
+	proto.RegisterType((*MsgUpdateAllowedSignersResponse)(nil), "celestia.nsregistry.v1.MsgUpdateAllowedSignersResponse")
+	proto.RegisterType((*MsgReleaseNamespace)(nil), "celestia.nsregistry.v1.MsgReleaseNamespace")
+	proto.RegisterType((*MsgReleaseNamespaceResponse)(nil), "celestia.nsregistry.v1.MsgReleaseNamespaceResponse")
+	proto.RegisterType((*MsgUpdateNsregistryParams)(nil), "celestia.nsregistry.v1.MsgUpdateNsregistryParams")
+	proto.RegisterType((*MsgUpdateNsregistryParamsResponse)(nil), "celestia.nsregistry.v1.MsgUpdateNsregistryParamsResponse")
+}
+
+func init() { proto.RegisterFile("celestia/nsregistry/v1/tx.proto", fileDescriptor_9d39e549766f5ba6) }
+
+var fileDescriptor_9d39e549766f5ba6 = []byte{
+	// 569 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xb1, 0x6f, 0xd3, 0x4e,
+	0x18, 0xcd, 0xfd, 0xf2, 0x4b, 0x45, 0x0e, 0x54, 0xc0, 0x44, 0x6d, 0x6a, 0x8a, 0x93, 0xa6, 0x4b,
+	0x55, 0xa8, 0xad, 0xb4, 0x05, 0x44, 0xc5, 0x92, 0xec, 0x29, 0x95, 0x0b, 0x0b, 0x4b, 0x74, 0x4d,
+	0x8e, 0xab, 0xa5, 0xd8, 0x67, 0xdd, 0x77, 0x6d, 0x9a, 0x2d, 0x62, 0x80, 0x95, 0xff, 0x82, 0xb5,
+	0x12, 0x88, 0xbf, 0xa1, 0x63, 0xc5, 0xc4, 0x84, 0x50, 0x32, 0xf4, 0xdf, 0x40, 0xf6, 0xc5, 0x0e,
+	0x21, 0x4e, 0x2a, 0x0f, 0x95, 0xd8, 0xce, 0x77, 0xef, 0x7d, 0xef, 0x7d, 0xfe, 0xee, 0xe9, 0x70,
+	0xa9, 0x45, 0x3b, 0x14, 0xa4, 0x43, 0x2c, 0x0f, 0x04, 0x65, 0x0e, 0x48, 0xd1, 0xb3, 0x4e, 0xab,
+	0x96, 0x3c, 0x33, 0x7d, 0xc1, 0x25, 0xd7, 0x96, 0x22, 0x80, 0x39, 0x06, 0x98, 0xa7, 0x55, 0x7d,
+	0x7d, 0x06, 0xd1, 0x27, 0x82, 0xb8, 0xa0, 0xc8, 0x7a, 0x81, 0x71, 0xc6, 0xc3, 0xa5, 0x15, 0xac,
+	0x46, 0xbb, 0xcb, 0x2d, 0x0e, 0x2e, 0x07, 0xcb, 0x05, 0x16, 0x30, 0x5c, 0x60, 0xa3, 0x83, 0x15,
+	0x75, 0xd0, 0x54, 0x0c, 0xf5, 0xa1, 0x8e, 0x2a, 0x5f, 0x10, 0x2e, 0x34, 0x80, 0xd9, 0xa1, 0x12,
+	0x15, 0xfb, 0xc4, 0xa5, 0xe0, 0x93, 0x16, 0xd5, 0x4c, 0x9c, 0xe3, 0x5d, 0x8f, 0x8a, 0x22, 0x2a,
+	0xa3, 0x8d, 0x7c, 0xbd, 0xf8, 0xfd, 0xeb, 0x56, 0x61, 0xc4, 0xac, 0xb5, 0xdb, 0x82, 0x02, 0x1c,
+	0x4a, 0xe1, 0x78, 0xcc, 0x56, 0x30, 0x6d, 0x15, 0xe7, 0xbd, 0x88, 0x5c, 0xfc, 0xaf, 0x8c, 0x36,
+	0xee, 0xd8, 0xe3, 0x0d, 0xad, 0x86, 0xef, 0x92, 0x4e, 0x87, 0x77, 0x69, 0xbb, 0x09, 0x0e, 0xf3,
+	0xa8, 0x80, 0x62, 0xb6, 0x9c, 0x9d, 0x5b, 0x77, 0x71, 0x44, 0x38, 0x54, 0xf8, 0x3d, 0xfc, 0xfe,
+	0xea, 0x7c, 0x53, 0x89, 0x55, 0x0c, 0xbc, 0x9a, 0x64, 0xda, 0xa6, 0xe0, 0x73, 0x0f, 0x68, 0xe5,
+	0xb3, 0xea, 0xea, 0xb5, 0x20, 0x1e, 0xbc, 0xbb, 0xb9, 0xae, 0x9e, 0xe2, 0xbc, 0x47, 0xbb, 0x4d,
+	0x55, 0x31, 0x7b, 0x4d, 0xc5, 0x5b, 0x1e, 0xed, 0xbe, 0x0a, 0x90, 0x09, 0x9d, 0x4c, 0x19, 0x8d,
+	0x3b, 0xf9, 0x86, 0xf0, 0x72, 0x03, 0xd8, 0x1b, 0xbf, 0x4d, 0x24, 0xad, 0x4d, 0xfc, 0x91, 0x7f,
+	0x7b, 0x44, 0x6b, 0xb8, 0x34, 0xc3, 0x77, 0xdc, 0x1b, 0xc7, 0x0f, 0xc2, 0x29, 0x76, 0x28, 0x01,
+	0x7a, 0x43, 0x33, 0x9a, 0xf0, 0xf4, 0x08, 0x3f, 0x4c, 0x10, 0x8c, 0xfd, 0x7c, 0x44, 0x78, 0x25,
+	0xf6, 0xbc, 0x1f, 0xa7, 0xef, 0x20, 0x4c, 0x5e, 0x20, 0x43, 0x4e, 0xe4, 0x31, 0x17, 0x8e, 0xec,
+	0x29, 0x6b, 0xf6, 0x78, 0x43, 0x7b, 0x89, 0x17, 0x54, 0x42, 0x43, 0x07, 0xb7, 0xb7, 0x0d, 0x33,
+	0x39, 0xdf, 0xa6, 0xaa, 0x56, 0xff, 0xff, 0xe2, 0x67, 0x29, 0x63, 0x8f, 0x38, 0x7b, 0x8b, 0x81,
+	0xc9, 0x71, 0xb5, 0xca, 0x3a, 0x5e, 0x9b, 0x69, 0x24, 0xb2, 0xbb, 0xdd, 0xcf, 0xe1, 0x6c, 0x03,
+	0x98, 0xd6, 0xc5, 0xf7, 0xa7, 0xe3, 0xfb, 0x64, 0x96, 0x7e, 0x52, 0x6e, 0xf4, 0xdd, 0x34, 0xe8,
+	0xc8, 0x40, 0x20, 0x3c, 0x9d, 0xb0, 0x79, 0xc2, 0x53, 0x68, 0x7d, 0x37, 0x0d, 0x3a, 0x16, 0xee,
+	0x23, 0x5c, 0x48, 0x4c, 0x84, 0x35, 0xa7, 0x5c, 0x12, 0x41, 0x7f, 0x9e, 0x92, 0x10, 0x5b, 0x90,
+	0xf8, 0xde, 0xd4, 0xc5, 0x7d, 0x3c, 0xf7, 0x2f, 0x4e, 0x82, 0xf5, 0x9d, 0x14, 0xe0, 0x58, 0xf5,
+	0x03, 0xc2, 0x4b, 0x33, 0xae, 0x67, 0xf5, 0xda, 0x4e, 0xfe, 0xa6, 0xe8, 0x2f, 0x52, 0x53, 0x22,
+	0x23, 0x7a, 0xae, 0x7f, 0x75, 0xbe, 0x89, 0xea, 0x07, 0x17, 0x03, 0x03, 0x5d, 0x0e, 0x0c, 0xf4,
+	0x6b, 0x60, 0xa0, 0x4f, 0x43, 0x23, 0x73, 0x39, 0x34, 0x32, 0x3f, 0x86, 0x46, 0xe6, 0xed, 0x33,
+	0xe6, 0xc8, 0xe3, 0x93, 0x23, 0xb3, 0xc5, 0x5d, 0x2b, 0x52, 0xe1, 0x82, 0xc5, 0xeb, 0x2d, 0xe2,
+	0xfb, 0xd6, 0xd9, 0x9f, 0x6f, 0x9c, 0xec, 0xf9, 0x14, 0x8e, 0x16, 0xc2, 0x67, 0x69, 0xe7, 0xf7,
+	0x00, 0x0f, 0x60, 0xe0, 0xcb, 0x40, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterNamespace registers a namespace to the owner in exchange for the
+	// registration deposit.
+	RegisterNamespace(ctx context.Context, in *MsgRegisterNamespace, opts ...grpc.CallOption) (*MsgRegisterNamespaceResponse, error)
+	// TransferNamespace transfers the ownership of a namespace, including its
+	// deposit, to another account.
+	TransferNamespace(ctx context.Context, in *MsgTransferNamespace, opts ...grpc.CallOption) (*MsgTransferNamespaceResponse, error)
+	// UpdateAllowedSigners replaces the accounts allowed to pay for blobs in a
+	// namespace.
+	UpdateAllowedSigners(ctx context.Context, in *MsgUpdateAllowedSigners, opts ...grpc.CallOption) (*MsgUpdateAllowedSignersResponse, error)
+	// ReleaseNamespace deletes the registration of a namespace and refunds the
+	// deposit to the owner.
+	ReleaseNamespace(ctx context.Context, in *MsgReleaseNamespace, opts ...grpc.CallOption) (*MsgReleaseNamespaceResponse, error)
+	// UpdateNsregistryParams defines a rpc handler method for
+	// MsgUpdateNsregistryParams.
+	UpdateNsregistryParams(ctx context.Context, in *MsgUpdateNsregistryParams, opts ...grpc.CallOption) (*MsgUpdateNsregistryParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) RegisterNamespace(ctx context.Context, in *MsgRegisterNamespace, opts ...grpc.CallOption) (*MsgRegisterNamespaceResponse, error) {
+	out := new(MsgRegisterNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.nsregistry.v1.Msg/RegisterNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferNamespace(ctx context.Context, in *MsgTransferNamespace, opts ...grpc.CallOption) (*MsgTransferNamespaceResponse, error) {
+	out := new(MsgTransferNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.nsregistry.v1.Msg/TransferNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAllowedSigners(ctx context.Context, in *MsgUpdateAllowedSigners, opts ...grpc.CallOption) (*MsgUpdateAllowedSignersResponse, error) {
+	out := new(MsgUpdateAllowedSignersResponse)
+	err := c.cc.Invoke(ctx, "/celestia.nsregistry.v1.Msg/UpdateAllowedSigners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseNamespace(ctx context.Context, in *MsgReleaseNamespace, opts ...grpc.CallOption) (*MsgReleaseNamespaceResponse, error) {
+	out := new(MsgReleaseNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/celestia.nsregistry.v1.Msg/ReleaseNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateNsregistryParams(ctx context.Context, in *MsgUpdateNsregistryParams, opts ...grpc.CallOption) (*MsgUpdateNsregistryParamsResponse, error) {
+	out := new(MsgUpdateNsregistryParamsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.nsregistry.v1.Msg/UpdateNsregistryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterNamespace registers a namespace to the owner in exchange for the
+	// registration deposit.
+	RegisterNamespace(context.Context, *MsgRegisterNamespace) (*MsgRegisterNamespaceResponse, error)
+	// TransferNamespace transfers the ownership of a namespace, including its
+	// deposit, to another account.
+	TransferNamespace(context.Context, *MsgTransferNamespace) (*MsgTransferNamespaceResponse, error)
+	// UpdateAllowedSigners replaces the accounts allowed to pay for blobs in a
+	// namespace.
+	UpdateAllowedSigners(context.Context, *MsgUpdateAllowedSigners) (*MsgUpdateAllowedSignersResponse, error)
+	// ReleaseNamespace deletes the registration of a namespace and refunds the
+	// deposit to the owner.
+	ReleaseNamespace(context.Context, *MsgReleaseNamespace) (*MsgReleaseNamespaceResponse, error)
+	// UpdateNsregistryParams defines a rpc handler method for
+	// MsgUpdateNsregistryParams.
+	UpdateNsregistryParams(context.Context, *MsgUpdateNsregistryParams) (*MsgUpdateNsregistryParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterNamespace(ctx context.Context, req *MsgRegisterNamespace) (*MsgRegisterNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterNamespace not implemented")
+}
+func (*UnimplementedMsgServer) TransferNamespace(ctx context.Context, req *MsgTransferNamespace) (*MsgTransferNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNamespace not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAllowedSigners(ctx context.Context, req *MsgUpdateAllowedSigners) (*MsgUpdateAllowedSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAllowedSigners not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseNamespace(ctx context.Context, req *MsgReleaseNamespace) (*MsgReleaseNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseNamespace not implemented")
+}
+func (*UnimplementedMsgServer) UpdateNsregistryParams(ctx context.Context, req *MsgUpdateNsregistryParams) (*MsgUpdateNsregistryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNsregistryParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.nsregistry.v1.Msg/RegisterNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterNamespace(ctx, req.(*MsgRegisterNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.nsregistry.v1.Msg/TransferNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferNamespace(ctx, req.(*MsgTransferNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAllowedSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAllowedSigners)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAllowedSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.nsregistry.v1.Msg/UpdateAllowedSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAllowedSigners(ctx, req.(*MsgUpdateAllowedSigners))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.nsregistry.v1.Msg/ReleaseNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseNamespace(ctx, req.(*MsgReleaseNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateNsregistryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNsregistryParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNsregistryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.nsregistry.v1.Msg/UpdateNsregistryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNsregistryParams(ctx, req.(*MsgUpdateNsregistryParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.nsregistry.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterNamespace",
+			Handler:    _Msg_RegisterNamespace_Handler,
+		},
+		{
+			MethodName: "TransferNamespace",
+			Handler:    _Msg_TransferNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateAllowedSigners",
+			Handler:    _Msg_UpdateAllowedSigners_Handler,
+		},
+		{
+			MethodName: "ReleaseNamespace",
+			Handler:    _Msg_ReleaseNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateNsregistryParams",
+			Handler:    _Msg_UpdateNsregistryParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/nsregistry/v1/tx.proto",
+}
+
+func (m *MsgRegisterNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowedSigners) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowedSigners) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowedSigners) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAllowedSignersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAllowedSignersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAllowedSignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNsregistryParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNsregistryParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNsregistryParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNsregistryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNsregistryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNsregistryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAllowedSigners) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateAllowedSignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNsregistryParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateNsregistryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAllowedSigners) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowedSigners: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowedSigners: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAllowedSignersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAllowedSignersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAllowedSignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = append(m.Namespace[:0], dAtA[iNdEx:postIndex]...)
+			if m.Namespace == nil {
+				m.Namespace = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNsregistryParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNsregistryParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNsregistryParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNsregistryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNsregistryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNsregistryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)